@@ -8,6 +8,12 @@ done
 # Create Kafka topics
 /opt/kafka/bin/kafka-topics.sh --bootstrap-server kafka1:9092 --create --if-not-exists \
   --topic auth.created --partitions 3 --replication-factor 3
+/opt/kafka/bin/kafka-topics.sh --bootstrap-server kafka1:9092 --create --if-not-exists \
+  --topic auth.deleted --partitions 3 --replication-factor 3
+/opt/kafka/bin/kafka-topics.sh --bootstrap-server kafka1:9092 --create --if-not-exists \
+  --topic auth.new_device_sign_in --partitions 3 --replication-factor 3
+/opt/kafka/bin/kafka-topics.sh --bootstrap-server kafka1:9092 --create --if-not-exists \
+  --topic user.data_exported --partitions 3 --replication-factor 3
 
 echo "✅ [BROKER] topics created"
 
@@ -5,12 +5,14 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"github.com/ritchieridanko/pasarly/backend/services/auth/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/di"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/interface/server"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/workers"
 )
 
 func main() {
@@ -35,17 +37,34 @@ func main() {
 		}
 	}(s)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	// Run the workers
+	go func(ctx context.Context, w *workers.AccountWorker) {
+		defer wg.Done()
+		if err := w.Run(ctx); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.AccountWorker())
+
 	// Handle app shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	<-quit
 	log.Printf("🛑 [%s] is shutting down...", cfg.App.Name)
+	cancel()
 
-	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.Timeout.Shutdown)
-	defer cancel()
+	sdCtx, sdCancel := context.WithTimeout(context.Background(), cfg.Server.Timeout.Shutdown)
+	defer sdCancel()
 
-	if err := s.Shutdown(ctx); err != nil {
+	if err := s.Shutdown(sdCtx); err != nil {
 		log.Fatalln("FATAL ->", err.Error())
 	}
+
+	wg.Wait()
 }
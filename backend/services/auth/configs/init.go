@@ -1,6 +1,7 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -13,9 +14,11 @@ type Config struct {
 	App      `mapstructure:"app"`
 	Auth     `mapstructure:"auth"`
 	Server   `mapstructure:"server"`
+	TLS      `mapstructure:"tls"`
 	Database `mapstructure:"database"`
 	Cache    `mapstructure:"cache"`
 	Broker   `mapstructure:"broker"`
+	GeoIP    `mapstructure:"geoip"`
 	Tracer   `mapstructure:"tracer"`
 }
 
@@ -37,10 +40,18 @@ type Auth struct {
 
 	Token struct {
 		Duration struct {
-			Session      time.Duration `mapstructure:"session"`
-			Verification time.Duration `mapstructure:"verification"`
+			Session       time.Duration `mapstructure:"session"`
+			Verification  time.Duration `mapstructure:"verification"`
+			SecureAccount time.Duration `mapstructure:"secure_account"`
+			PasswordReset time.Duration `mapstructure:"password_reset"`
 		} `mapstructure:"duration"`
 	} `mapstructure:"token"`
+
+	Deletion struct {
+		GracePeriod time.Duration `mapstructure:"grace_period"`
+		Interval    time.Duration `mapstructure:"interval"`
+		BatchSize   int           `mapstructure:"batch_size"`
+	} `mapstructure:"deletion"`
 }
 
 type Server struct {
@@ -54,6 +65,17 @@ type Server struct {
 	} `mapstructure:"timeout"`
 }
 
+type TLS struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	CertFile       string        `mapstructure:"cert_file"`
+	KeyFile        string        `mapstructure:"key_file"`
+	CAFile         string        `mapstructure:"ca_file"`
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// Methods each caller identity may call, matched in full, by service ("/auth.v1.AuthService/*"), or all ("*")
+	Allow map[string][]string `mapstructure:"allow"`
+}
+
 type Database struct {
 	Host            string        `mapstructure:"host"`
 	Port            int           `mapstructure:"port"`
@@ -85,6 +107,21 @@ type Broker struct {
 	} `mapstructure:"timeout"`
 }
 
+type GeoIP struct {
+	Driver string `mapstructure:"driver"`
+
+	IPAPI struct {
+		BaseURL string        `mapstructure:"base_url"`
+		Timeout time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"ipapi"`
+
+	Stub struct {
+		City    string `mapstructure:"city"`
+		Region  string `mapstructure:"region"`
+		Country string `mapstructure:"country"`
+	} `mapstructure:"stub"`
+}
+
 type Tracer struct {
 	Host     string `mapstructure:"host"`
 	Port     int    `mapstructure:"port"`
@@ -117,6 +154,10 @@ func Init(path string) (*Config, error) {
 		return nil, fmt.Errorf("failed to initialize config: %w", err)
 	}
 
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("failed to initialize config: %w", err)
+	}
+
 	cfg.App.Env = env
 	cfg.Database.DSN = fmt.Sprintf(
 		"postgresql://%s:%s@%s:%d/%s?sslmode=%s",
@@ -131,3 +172,11 @@ func Init(path string) (*Config, error) {
 
 	return &cfg, nil
 }
+
+// validate rejects settings the services cannot run safely or correctly with
+func (c *Config) validate() error {
+	if c.Auth.Deletion.Interval <= 0 || c.Auth.Deletion.BatchSize <= 0 {
+		return errors.New("deletion interval and batch size must be positive")
+	}
+	return nil
+}
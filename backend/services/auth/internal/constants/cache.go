@@ -2,5 +2,7 @@ package constants
 
 const (
 	CachePrefixEmailReservation string = "emres"
+	CachePrefixPasswordReset    string = "pwres"
+	CachePrefixSecureAccount    string = "secac"
 	CachePrefixVerification     string = "emver"
 )
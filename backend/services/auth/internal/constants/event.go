@@ -1,5 +1,7 @@
 package constants
 
 const (
-	EventTopicAuthCreated string = "auth.created"
+	EventTopicAuthCreated     string = "auth.created"
+	EventTopicAuthDeleted     string = "auth.deleted"
+	EventTopicNewDeviceSignIn string = "auth.new_device_sign_in"
 )
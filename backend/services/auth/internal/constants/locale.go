@@ -0,0 +1,10 @@
+package constants
+
+const (
+	LocaleEN string = "en"
+	LocaleID string = "id"
+)
+
+const LocaleDefault string = LocaleEN
+
+var Locales = []string{LocaleEN, LocaleID}
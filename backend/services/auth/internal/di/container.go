@@ -12,6 +12,7 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/repositories"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/usecases"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/workers"
 )
 
 type Container struct {
@@ -21,6 +22,8 @@ type Container struct {
 	transactor *database.Transactor
 	logger     *logger.Logger
 	acp        *publisher.Publisher
+	adp        *publisher.Publisher
+	ndp        *publisher.Publisher
 	ar         repositories.AuthRepository
 	sr         repositories.SessionRepository
 	tr         repositories.TokenRepository
@@ -30,6 +33,7 @@ type Container struct {
 	au         usecases.AuthUsecase
 	su         usecases.SessionUsecase
 	ah         *handlers.AuthHandler
+	aw         *workers.AccountWorker
 	server     *server.Server
 }
 
@@ -42,6 +46,8 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 
 	// Publishers
 	acp := publisher.NewPublisher(i.PubAuthCreated(), l)
+	adp := publisher.NewPublisher(i.PubAuthDeleted(), l)
+	ndp := publisher.NewPublisher(i.PubNewDeviceSignIn(), l)
 
 	// Repositories
 	ar := repositories.NewAuthRepository(db, c)
@@ -54,14 +60,17 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 	v := utils.NewValidator()
 
 	// Usecases
-	au := usecases.NewAuthUsecase(ar, tr, tx, acp, b, v, l)
-	su := usecases.NewSessionUsecase(cfg.Auth.Token.Duration.Session, sr, tx, j, v)
+	au := usecases.NewAuthUsecase(&cfg.Auth, ar, sr, tr, tx, acp, adp, b, v, l)
+	su := usecases.NewSessionUsecase(cfg.Auth.Token.Duration.Session, sr, tr, tx, ndp, i.GeoIP(), j, v, l)
 
 	// Handlers
 	ah := handlers.NewAuthHandler(au, su, l)
 
+	// Workers
+	aw := workers.NewAccountWorker(cfg.Auth.Deletion.Interval, cfg.Auth.Deletion.BatchSize, au, l)
+
 	// Server
-	s := server.Init(&cfg.Server, ah, l)
+	s := server.Init(&cfg.Server, &cfg.TLS, i.Certs(), ah, l)
 
 	return &Container{
 		config:     cfg,
@@ -70,6 +79,8 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 		transactor: tx,
 		logger:     l,
 		acp:        acp,
+		adp:        adp,
+		ndp:        ndp,
 		ar:         ar,
 		sr:         sr,
 		tr:         tr,
@@ -79,10 +90,15 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 		au:         au,
 		su:         su,
 		ah:         ah,
+		aw:         aw,
 		server:     s,
 	}
 }
 
+func (c *Container) AccountWorker() *workers.AccountWorker {
+	return c.aw
+}
+
 func (c *Container) Server() *server.Server {
 	return c.server
 }
@@ -0,0 +1,9 @@
+package geoip
+
+import "context"
+
+type disabledLocator struct{}
+
+func (l *disabledLocator) Locate(ctx context.Context, ipAddress string) (*Location, error) {
+	return nil, ErrDisabled
+}
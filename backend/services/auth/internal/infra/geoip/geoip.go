@@ -0,0 +1,36 @@
+package geoip
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrDisabled = errors.New("geoip is disabled")
+	ErrNoResult = errors.New("no geoip result")
+)
+
+type Locator interface {
+	Locate(ctx context.Context, ipAddress string) (location *Location, err error)
+}
+
+type Location struct {
+	City    string
+	Region  string
+	Country string
+}
+
+// String joins the known parts from the most to the least specific, e.g. "Jakarta, Indonesia"
+func (l *Location) String() string {
+	parts := make([]string, 0, 2)
+	if l.City != "" {
+		parts = append(parts, l.City)
+	} else if l.Region != "" {
+		parts = append(parts, l.Region)
+	}
+	if l.Country != "" {
+		parts = append(parts, l.Country)
+	}
+	return strings.Join(parts, ", ")
+}
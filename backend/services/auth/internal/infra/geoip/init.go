@@ -0,0 +1,34 @@
+package geoip
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/auth/configs"
+	"go.uber.org/zap"
+)
+
+func Init(cfg *configs.GeoIP, l *zap.Logger) (Locator, error) {
+	var g Locator
+
+	switch cfg.Driver {
+	case "", "none":
+		l.Sugar().Infof("✅ [GEOIP] initialized (driver=none)")
+		return &disabledLocator{}, nil
+	case "stub":
+		g = &stubLocator{
+			location: Location{City: cfg.Stub.City, Region: cfg.Stub.Region, Country: cfg.Stub.Country},
+		}
+	case "ipapi":
+		g = &ipapiLocator{
+			baseURL: strings.TrimRight(cfg.IPAPI.BaseURL, "/"),
+			client:  &http.Client{Timeout: cfg.IPAPI.Timeout},
+		}
+	default:
+		return nil, fmt.Errorf("failed to initialize geoip: unsupported driver %q", cfg.Driver)
+	}
+
+	l.Sugar().Infof("✅ [GEOIP] initialized (driver=%s)", cfg.Driver)
+	return g, nil
+}
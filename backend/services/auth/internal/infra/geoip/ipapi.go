@@ -0,0 +1,60 @@
+package geoip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+type ipapiLocator struct {
+	baseURL string
+	client  *http.Client
+}
+
+type ipapiLocation struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	City    string `json:"city"`
+	Region  string `json:"regionName"`
+	Country string `json:"country"`
+}
+
+func (l *ipapiLocator) Locate(ctx context.Context, ipAddress string) (*Location, error) {
+	// Addresses that never leave the local network cannot be located
+	ip := net.ParseIP(ipAddress)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() {
+		return nil, fmt.Errorf("failed to locate ip address: %w", ErrNoResult)
+	}
+
+	params := url.Values{}
+	params.Set("fields", "status,message,city,regionName,country")
+
+	endpoint := fmt.Sprintf("%s/json/%s?%s", l.baseURL, url.PathEscape(ip.String()), params.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate ip address: %w", err)
+	}
+
+	res, err := l.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate ip address: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to locate ip address: unexpected status %d", res.StatusCode)
+	}
+
+	var result ipapiLocation
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to locate ip address: %w", err)
+	}
+	if result.Status != "success" {
+		return nil, fmt.Errorf("failed to locate ip address: %w (%s)", ErrNoResult, result.Message)
+	}
+
+	return &Location{City: result.City, Region: result.Region, Country: result.Country}, nil
+}
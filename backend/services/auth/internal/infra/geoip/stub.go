@@ -0,0 +1,13 @@
+package geoip
+
+import "context"
+
+// stubLocator resolves every address to a fixed location for local development
+type stubLocator struct {
+	location Location
+}
+
+func (l *stubLocator) Locate(ctx context.Context, ipAddress string) (*Location, error) {
+	location := l.location
+	return &location, nil
+}
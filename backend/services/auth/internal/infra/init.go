@@ -8,22 +8,29 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/auth/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/cache"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/certs"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/geoip"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/publisher"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/tracer"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
 type Infra struct {
+	certs    *mtls.Reloader
 	config   *configs.Config
 	cache    *redis.Client
 	database *pgxpool.Pool
+	geoip    geoip.Locator
 	logger   *zap.Logger
 	tracer   *tracer.Tracer
 
 	acp *kafka.Writer
+	adp *kafka.Writer
+	ndp *kafka.Writer
 }
 
 func Init(cfg *configs.Config) (*Infra, error) {
@@ -32,6 +39,11 @@ func Init(cfg *configs.Config) (*Infra, error) {
 		return nil, err
 	}
 
+	cr, err := certs.Init(&cfg.TLS, l)
+	if err != nil {
+		return nil, err
+	}
+
 	c, err := cache.Init(&cfg.Cache, l)
 	if err != nil {
 		return nil, err
@@ -42,6 +54,11 @@ func Init(cfg *configs.Config) (*Infra, error) {
 		return nil, err
 	}
 
+	g, err := geoip.Init(&cfg.GeoIP, l)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := tracer.Init(cfg.App.Name, cfg.Tracer.Endpoint, l)
 	if err != nil {
 		return nil, err
@@ -49,8 +66,21 @@ func Init(cfg *configs.Config) (*Infra, error) {
 
 	// Publishers
 	acp := publisher.Init(&cfg.Broker, constants.EventTopicAuthCreated, l)
-
-	return &Infra{config: cfg, cache: c, database: db, logger: l, tracer: t, acp: acp}, nil
+	adp := publisher.Init(&cfg.Broker, constants.EventTopicAuthDeleted, l)
+	ndp := publisher.Init(&cfg.Broker, constants.EventTopicNewDeviceSignIn, l)
+
+	return &Infra{
+		certs:    cr,
+		config:   cfg,
+		cache:    c,
+		database: db,
+		geoip:    g,
+		logger:   l,
+		tracer:   t,
+		acp:      acp,
+		adp:      adp,
+		ndp:      ndp,
+	}, nil
 }
 
 func (i *Infra) Cache() *redis.Client {
@@ -61,6 +91,14 @@ func (i *Infra) Database() *pgxpool.Pool {
 	return i.database
 }
 
+func (i *Infra) GeoIP() geoip.Locator {
+	return i.geoip
+}
+
+func (i *Infra) Certs() *mtls.Reloader {
+	return i.certs
+}
+
 func (i *Infra) Logger() *zap.Logger {
 	return i.logger
 }
@@ -69,7 +107,18 @@ func (i *Infra) PubAuthCreated() *kafka.Writer {
 	return i.acp
 }
 
+func (i *Infra) PubAuthDeleted() *kafka.Writer {
+	return i.adp
+}
+
+func (i *Infra) PubNewDeviceSignIn() *kafka.Writer {
+	return i.ndp
+}
+
 func (i *Infra) Close() error {
+	if i.certs != nil {
+		i.certs.Close()
+	}
 	if err := i.cache.Close(); err != nil {
 		return fmt.Errorf("failed to close cache: %w", err)
 	}
@@ -79,6 +128,12 @@ func (i *Infra) Close() error {
 	if err := i.acp.Close(); err != nil {
 		return fmt.Errorf("failed to close publisher (%s): %w", constants.EventTopicAuthCreated, err)
 	}
+	if err := i.adp.Close(); err != nil {
+		return fmt.Errorf("failed to close publisher (%s): %w", constants.EventTopicAuthDeleted, err)
+	}
+	if err := i.ndp.Close(); err != nil {
+		return fmt.Errorf("failed to close publisher (%s): %w", constants.EventTopicNewDeviceSignIn, err)
+	}
 
 	i.database.Close()
 	i.tracer.Cleanup()
@@ -38,12 +38,12 @@ func (h *AuthHandler) SignUp(ctx context.Context, req *apis.SignUpRequest) (*api
 	data := models.CreateAuth{
 		Email:    req.GetEmail(),
 		Password: &pass,
+		Locale:   req.GetLocale(),
 	}
 
 	auth, err := h.au.SignUp(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	resp := apis.SignUpResponse{
@@ -54,6 +54,7 @@ func (h *AuthHandler) SignUp(ctx context.Context, req *apis.SignUpRequest) (*api
 			IsVerified: auth.IsVerified,
 			CreatedAt:  timestamppb.New(auth.CreatedAt),
 			UpdatedAt:  timestamppb.New(auth.UpdatedAt),
+			Locale:     auth.Locale,
 		},
 	}
 
@@ -94,8 +95,7 @@ func (h *AuthHandler) SignIn(ctx context.Context, req *apis.SignInRequest) (*api
 
 	auth, err := h.au.SignIn(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	ua, ip := utils.CtxRequestMeta(ctx)
@@ -112,8 +112,7 @@ func (h *AuthHandler) SignIn(ctx context.Context, req *apis.SignInRequest) (*api
 
 	authToken, err := h.su.CreateSession(ctx, auth, &rm)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.SignInResponse{
@@ -122,12 +121,14 @@ func (h *AuthHandler) SignIn(ctx context.Context, req *apis.SignInRequest) (*api
 			Access:  authToken.Access,
 		},
 		Auth: &apis.Auth{
-			Id:         auth.ID,
-			Email:      auth.Email,
-			Role:       auth.Role,
-			IsVerified: auth.IsVerified,
-			CreatedAt:  timestamppb.New(auth.CreatedAt),
-			UpdatedAt:  timestamppb.New(auth.UpdatedAt),
+			Id:                  auth.ID,
+			Email:               auth.Email,
+			Role:                auth.Role,
+			IsVerified:          auth.IsVerified,
+			CreatedAt:           timestamppb.New(auth.CreatedAt),
+			UpdatedAt:           timestamppb.New(auth.UpdatedAt),
+			DeletionScheduledAt: utils.WrapTime(auth.DeletionScheduledAt),
+			Locale:              auth.Locale,
 		},
 	}, nil
 }
@@ -137,8 +138,7 @@ func (h *AuthHandler) SignOut(ctx context.Context, req *apis.SignOutRequest) (*e
 	defer span.End()
 
 	if err := h.su.RevokeSession(ctx, req.GetSession()); err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -150,9 +150,134 @@ func (h *AuthHandler) IsEmailAvailable(ctx context.Context, req *apis.EmailAvail
 
 	exists, err := h.au.IsEmailAvailable(ctx, req.GetEmail())
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.EmailAvailabilityResponse{IsAvailable: exists}, nil
 }
+
+func (h *AuthHandler) DeleteAccount(ctx context.Context, req *apis.DeleteAccountRequest) (*apis.DeleteAccountResponse, error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "DeleteAccount")
+	defer span.End()
+
+	data := models.DeleteAuth{
+		AuthID:   req.GetAuthId(),
+		Password: req.GetPassword(),
+	}
+
+	scheduledAt, err := h.au.DeleteAccount(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.DeleteAccountResponse{ScheduledAt: timestamppb.New(*scheduledAt)}, nil
+}
+
+func (h *AuthHandler) CancelAccountDeletion(ctx context.Context, req *apis.CancelAccountDeletionRequest) (*emptypb.Empty, error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "CancelAccountDeletion")
+	defer span.End()
+
+	if err := h.au.CancelAccountDeletion(ctx, req.GetAuthId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) UpdateLocale(ctx context.Context, req *apis.UpdateLocaleRequest) (*apis.UpdateLocaleResponse, error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "UpdateLocale")
+	defer span.End()
+
+	data := models.UpdateLocale{
+		AuthID: req.GetAuthId(),
+		Locale: req.GetLocale(),
+	}
+
+	auth, err := h.au.UpdateLocale(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.UpdateLocaleResponse{
+		Auth: &apis.Auth{
+			Id:                  auth.ID,
+			Email:               auth.Email,
+			Role:                auth.Role,
+			IsVerified:          auth.IsVerified,
+			CreatedAt:           timestamppb.New(auth.CreatedAt),
+			UpdatedAt:           timestamppb.New(auth.UpdatedAt),
+			DeletionScheduledAt: utils.WrapTime(auth.DeletionScheduledAt),
+			Locale:              auth.Locale,
+		},
+	}, nil
+}
+
+func (h *AuthHandler) SecureAccount(ctx context.Context, req *apis.SecureAccountRequest) (*apis.SecureAccountResponse, error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "SecureAccount")
+	defer span.End()
+
+	resetToken, err := h.au.SecureAccount(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.SecureAccountResponse{ResetToken: resetToken}, nil
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *apis.ResetPasswordRequest) (*emptypb.Empty, error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "ResetPassword")
+	defer span.End()
+
+	pass := req.GetPassword()
+	data := models.ResetPassword{
+		Token:    req.GetToken(),
+		Password: &pass,
+	}
+
+	if err := h.au.ResetPassword(ctx, &data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) ExportAuthData(ctx context.Context, req *apis.ExportAuthDataRequest) (*apis.ExportAuthDataResponse, error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "ExportAuthData")
+	defer span.End()
+
+	auth, err := h.au.GetAuth(ctx, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.su.GetSessions(ctx, auth.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := make([]*apis.Session, 0, len(sessions))
+	for _, s := range sessions {
+		ss = append(ss, &apis.Session{
+			Id:        s.ID,
+			UserAgent: s.UserAgent,
+			IpAddress: s.IPAddress,
+			CreatedAt: timestamppb.New(s.CreatedAt),
+			ExpiresAt: timestamppb.New(s.ExpiresAt),
+			RevokedAt: utils.WrapTime(s.RevokedAt),
+		})
+	}
+
+	return &apis.ExportAuthDataResponse{
+		Auth: &apis.Auth{
+			Id:                  auth.ID,
+			Email:               auth.Email,
+			Role:                auth.Role,
+			IsVerified:          auth.IsVerified,
+			CreatedAt:           timestamppb.New(auth.CreatedAt),
+			UpdatedAt:           timestamppb.New(auth.UpdatedAt),
+			DeletionScheduledAt: utils.WrapTime(auth.DeletionScheduledAt),
+			Locale:              auth.Locale,
+		},
+		Sessions: ss,
+	}, nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/interface/handlers"
 	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/interceptors"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"google.golang.org/grpc"
 )
 
@@ -18,8 +20,17 @@ type Server struct {
 	logger *logger.Logger
 }
 
-func Init(cfg *configs.Server, ah *handlers.AuthHandler, l *logger.Logger) *Server {
-	s := grpc.NewServer()
+func Init(cfg *configs.Server, tls *configs.TLS, r *mtls.Reloader, ah *handlers.AuthHandler, l *logger.Logger) *Server {
+	opts := []grpc.ServerOption{interceptors.Unary(l.Base())}
+	if r != nil {
+		opts = append(
+			opts,
+			grpc.Creds(mtls.ServerCredentials(r)),
+			grpc.ChainUnaryInterceptor(mtls.AllowInterceptor(tls.Allow)),
+		)
+	}
+
+	s := grpc.NewServer(opts...)
 
 	apis.RegisterAuthServiceServer(s, ah)
 
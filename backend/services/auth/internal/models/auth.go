@@ -3,24 +3,50 @@ package models
 import "time"
 
 type Auth struct {
-	ID                int64
-	Email             string
-	Password          *string
-	Role              string
-	IsVerified        bool
-	EmailChangedAt    *time.Time
-	PasswordChangedAt *time.Time
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
+	ID                    int64
+	Email                 string
+	Password              *string
+	Role                  string
+	IsVerified            bool
+	Locale                string
+	EmailChangedAt        *time.Time
+	PasswordChangedAt     *time.Time
+	DeletionScheduledAt   *time.Time
+	PasswordResetRequired bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
 
 type CreateAuth struct {
 	Email    string
 	Password *string
 	Role     string
+	Locale   string
 }
 
 type GetAuth struct {
 	Email    string
 	Password string
 }
+
+type DeleteAuth struct {
+	AuthID   int64
+	Password string
+}
+
+type UpdateLocale struct {
+	AuthID int64
+	Locale string
+}
+
+type ResetPassword struct {
+	Token    string
+	Password *string
+}
+
+type DeletedAuth struct {
+	ID        int64
+	Email     string
+	Locale    string
+	DeletedAt time.Time
+}
@@ -2,6 +2,15 @@ package models
 
 import "time"
 
+type Session struct {
+	ID        int64
+	UserAgent string
+	IPAddress string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
 type CreateSession struct {
 	ParentID  *int64
 	Token     string
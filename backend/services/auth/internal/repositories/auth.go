@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/cache"
@@ -18,8 +19,15 @@ const authErrTracer string = "repository.auth"
 type AuthRepository interface {
 	CreateAuth(ctx context.Context, data *models.CreateAuth) (auth *models.Auth, err *ce.Error)
 	GetAuthByEmail(ctx context.Context, email string) (auth *models.Auth, err *ce.Error)
+	GetAuthByID(ctx context.Context, authID int64) (auth *models.Auth, err *ce.Error)
 	IsEmailRegistered(ctx context.Context, email string) (exists bool, err *ce.Error)
 	IsEmailReserved(ctx context.Context, email string) (exists bool, err *ce.Error)
+	ScheduleDeletion(ctx context.Context, authID int64, scheduledAt time.Time) (deletionScheduledAt *time.Time, err *ce.Error)
+	CancelDeletion(ctx context.Context, authID int64) (err *ce.Error)
+	UpdateLocale(ctx context.Context, data *models.UpdateLocale) (auth *models.Auth, err *ce.Error)
+	RequirePasswordReset(ctx context.Context, authID int64) (err *ce.Error)
+	ResetPassword(ctx context.Context, authID int64, password string) (err *ce.Error)
+	DeleteScheduledAuths(ctx context.Context, limit int) (auths []models.DeletedAuth, err *ce.Error)
 }
 
 type authRepository struct {
@@ -36,16 +44,16 @@ func (r *authRepository) CreateAuth(ctx context.Context, data *models.CreateAuth
 	defer span.End()
 
 	query := `
-		INSERT INTO auth (email, password, role)
-		VALUES ($1, $2, $3)
-		RETURNING auth_id, email, role, is_verified, created_at, updated_at
+		INSERT INTO auth (email, password, role, locale)
+		VALUES ($1, $2, $3, $4)
+		RETURNING auth_id, email, role, is_verified, locale, created_at, updated_at
 	`
 
-	row := r.database.QueryRow(ctx, query, data.Email, data.Password, data.Role)
+	row := r.database.QueryRow(ctx, query, data.Email, data.Password, data.Role, data.Locale)
 
 	var auth models.Auth
 	err := row.Scan(
-		&auth.ID, &auth.Email, &auth.Role, &auth.IsVerified,
+		&auth.ID, &auth.Email, &auth.Role, &auth.IsVerified, &auth.Locale,
 		&auth.CreatedAt, &auth.UpdatedAt,
 	)
 	if err != nil {
@@ -61,7 +69,9 @@ func (r *authRepository) GetAuthByEmail(ctx context.Context, email string) (*mod
 	defer span.End()
 
 	query := `
-		SELECT auth_id, email, password, role, is_verified, created_at, updated_at
+		SELECT
+			auth_id, email, password, role, is_verified, locale, deletion_scheduled_at,
+			password_reset_required, created_at, updated_at
 		FROM auth
 		WHERE email = $1 AND deleted_at IS NULL
 	`
@@ -74,7 +84,8 @@ func (r *authRepository) GetAuthByEmail(ctx context.Context, email string) (*mod
 	var auth models.Auth
 	err := row.Scan(
 		&auth.ID, &auth.Email, &auth.Password, &auth.Role, &auth.IsVerified,
-		&auth.CreatedAt, &auth.UpdatedAt,
+		&auth.Locale, &auth.DeletionScheduledAt, &auth.PasswordResetRequired, &auth.CreatedAt,
+		&auth.UpdatedAt,
 	)
 	if err != nil {
 		e := fmt.Errorf("failed to fetch auth by email: %w", err)
@@ -88,6 +99,41 @@ func (r *authRepository) GetAuthByEmail(ctx context.Context, email string) (*mod
 	return &auth, nil
 }
 
+func (r *authRepository) GetAuthByID(ctx context.Context, authID int64) (*models.Auth, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "GetAuthByID")
+	defer span.End()
+
+	query := `
+		SELECT
+			auth_id, email, password, role, is_verified, locale, deletion_scheduled_at,
+			password_reset_required, created_at, updated_at
+		FROM auth
+		WHERE auth_id = $1 AND deleted_at IS NULL
+	`
+	if r.database.InTx(ctx) {
+		query += " FOR UPDATE"
+	}
+
+	row := r.database.QueryRow(ctx, query, authID)
+
+	var auth models.Auth
+	err := row.Scan(
+		&auth.ID, &auth.Email, &auth.Password, &auth.Role, &auth.IsVerified,
+		&auth.Locale, &auth.DeletionScheduledAt, &auth.PasswordResetRequired, &auth.CreatedAt,
+		&auth.UpdatedAt,
+	)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch auth by id: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeAuthNotFound, ce.MsgUnauthenticated, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &auth, nil
+}
+
 func (r *authRepository) IsEmailRegistered(ctx context.Context, email string) (bool, *ce.Error) {
 	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "IsEmailRegistered")
 	defer span.End()
@@ -126,3 +172,190 @@ func (r *authRepository) IsEmailReserved(ctx context.Context, email string) (boo
 
 	return exists, nil
 }
+
+func (r *authRepository) ScheduleDeletion(ctx context.Context, authID int64, scheduledAt time.Time) (*time.Time, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "ScheduleDeletion")
+	defer span.End()
+
+	// An existing schedule is kept so that repeated requests do not extend the grace period
+	query := `
+		UPDATE auth
+		SET deletion_scheduled_at = COALESCE(deletion_scheduled_at, $1), updated_at = NOW()
+		WHERE auth_id = $2 AND deleted_at IS NULL
+		RETURNING deletion_scheduled_at
+	`
+
+	row := r.database.QueryRow(ctx, query, scheduledAt, authID)
+
+	var deletionScheduledAt time.Time
+	if err := row.Scan(&deletionScheduledAt); err != nil {
+		e := fmt.Errorf("failed to schedule deletion: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeAuthNotFound, ce.MsgUnauthenticated, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &deletionScheduledAt, nil
+}
+
+func (r *authRepository) CancelDeletion(ctx context.Context, authID int64) *ce.Error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "CancelDeletion")
+	defer span.End()
+
+	query := `
+		UPDATE auth
+		SET deletion_scheduled_at = NULL, updated_at = NOW()
+		WHERE auth_id = $1 AND deletion_scheduled_at IS NOT NULL AND deleted_at IS NULL
+	`
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to cancel deletion: %w", err)
+		return ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *authRepository) UpdateLocale(ctx context.Context, data *models.UpdateLocale) (*models.Auth, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "UpdateLocale")
+	defer span.End()
+
+	query := `
+		UPDATE auth
+		SET locale = $1, updated_at = NOW()
+		WHERE auth_id = $2 AND deleted_at IS NULL
+		RETURNING
+			auth_id, email, role, is_verified, locale, deletion_scheduled_at,
+			created_at, updated_at
+	`
+
+	row := r.database.QueryRow(ctx, query, data.Locale, data.AuthID)
+
+	var auth models.Auth
+	err := row.Scan(
+		&auth.ID, &auth.Email, &auth.Role, &auth.IsVerified, &auth.Locale,
+		&auth.DeletionScheduledAt, &auth.CreatedAt, &auth.UpdatedAt,
+	)
+	if err != nil {
+		e := fmt.Errorf("failed to update locale: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeAuthNotFound, ce.MsgUnauthenticated, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &auth, nil
+}
+
+func (r *authRepository) RequirePasswordReset(ctx context.Context, authID int64) *ce.Error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "RequirePasswordReset")
+	defer span.End()
+
+	query := `
+		UPDATE auth
+		SET password_reset_required = TRUE, updated_at = NOW()
+		WHERE auth_id = $1 AND deleted_at IS NULL
+	`
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		e := fmt.Errorf("failed to require password reset: %w", err)
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return ce.NewError(span, ce.CodeAuthNotFound, ce.MsgUnauthenticated, e)
+		}
+
+		return ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *authRepository) ResetPassword(ctx context.Context, authID int64, password string) *ce.Error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "ResetPassword")
+	defer span.End()
+
+	query := `
+		UPDATE auth
+		SET
+			password = $1, password_reset_required = FALSE, password_changed_at = NOW(),
+			updated_at = NOW()
+		WHERE auth_id = $2 AND deleted_at IS NULL
+	`
+
+	if err := r.database.Execute(ctx, query, password, authID); err != nil {
+		e := fmt.Errorf("failed to reset password: %w", err)
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return ce.NewError(span, ce.CodeAuthNotFound, ce.MsgUnauthenticated, e)
+		}
+
+		return ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *authRepository) DeleteScheduledAuths(ctx context.Context, limit int) ([]models.DeletedAuth, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "DeleteScheduledAuths")
+	defer span.End()
+
+	// Due records are locked with SKIP LOCKED so that concurrent instances split the work,
+	// their sessions are revoked, oauth links are soft-deleted, and credentials are erased
+	query := `
+		WITH due AS (
+			SELECT auth_id, email, locale
+			FROM auth
+			WHERE deletion_scheduled_at <= NOW() AND deleted_at IS NULL
+			ORDER BY deletion_scheduled_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		),
+		revoked_sessions AS (
+			UPDATE sessions
+			SET revoked_at = NOW()
+			WHERE auth_id IN (SELECT auth_id FROM due) AND revoked_at IS NULL
+		),
+		deleted_oauth AS (
+			UPDATE oauth
+			SET deleted_at = NOW(), updated_at = NOW()
+			WHERE auth_id IN (SELECT auth_id FROM due) AND deleted_at IS NULL
+		)
+		UPDATE auth a
+		SET
+			email = CONCAT('deleted_', a.auth_id), password = NULL,
+			deleted_at = NOW(), updated_at = NOW()
+		FROM due
+		WHERE a.auth_id = due.auth_id
+		RETURNING a.auth_id, due.email, due.locale, a.deleted_at
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, limit)
+	if err != nil {
+		e := fmt.Errorf("failed to delete scheduled auths: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	defer rows.Close()
+
+	auths := make([]models.DeletedAuth, 0)
+	for rows.Next() {
+		var auth models.DeletedAuth
+		if err := rows.Scan(&auth.ID, &auth.Email, &auth.Locale, &auth.DeletedAt); err != nil {
+			e := fmt.Errorf("failed to delete scheduled auths: %w", err)
+			return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		auths = append(auths, auth)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to delete scheduled auths: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return auths, nil
+}
@@ -18,6 +18,9 @@ type SessionRepository interface {
 	CreateSession(ctx context.Context, authID int64, data *models.CreateSession) (err *ce.Error)
 	RevokeSessionByToken(ctx context.Context, token string) (err *ce.Error)
 	RevokeActiveSession(ctx context.Context, authID int64, rm *models.RequestMeta) (sessionID int64, err *ce.Error)
+	RevokeSessionsByAuthID(ctx context.Context, authID int64) (err *ce.Error)
+	IsNewDevice(ctx context.Context, authID int64, rm *models.RequestMeta) (isNew bool, err *ce.Error)
+	GetSessionsByAuthID(ctx context.Context, authID int64) (sessions []models.Session, err *ce.Error)
 }
 
 type sessionRepository struct {
@@ -101,3 +104,87 @@ func (r *sessionRepository) RevokeActiveSession(ctx context.Context, authID int6
 
 	return sessionID, nil
 }
+
+func (r *sessionRepository) RevokeSessionsByAuthID(ctx context.Context, authID int64) *ce.Error {
+	ctx, span := otel.Tracer(sessionErrTracer).Start(ctx, "RevokeSessionsByAuthID")
+	defer span.End()
+
+	query := `
+		UPDATE sessions
+		SET revoked_at = NOW()
+		WHERE auth_id = $1 AND revoked_at IS NULL
+	`
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to revoke sessions by auth id: %w", err)
+		return ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) IsNewDevice(ctx context.Context, authID int64, rm *models.RequestMeta) (bool, *ce.Error) {
+	ctx, span := otel.Tracer(sessionErrTracer).Start(ctx, "IsNewDevice")
+	defer span.End()
+
+	// The very first session of an account is not treated as new, since there is nothing to compare it with
+	query := `
+		SELECT
+			EXISTS (SELECT 1 FROM sessions WHERE auth_id = $1) AND
+			NOT EXISTS (
+				SELECT 1 FROM sessions
+				WHERE auth_id = $1 AND user_agent = $2 AND ip_address = $3
+			)
+	`
+
+	row := r.database.QueryRow(ctx, query, authID, rm.UserAgent, rm.IPAddress)
+
+	var isNew bool
+	if err := row.Scan(&isNew); err != nil {
+		e := fmt.Errorf("failed to check if device is new: %w", err)
+		return false, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return isNew, nil
+}
+
+func (r *sessionRepository) GetSessionsByAuthID(ctx context.Context, authID int64) ([]models.Session, *ce.Error) {
+	ctx, span := otel.Tracer(sessionErrTracer).Start(ctx, "GetSessionsByAuthID")
+	defer span.End()
+
+	query := `
+		SELECT session_id, user_agent, ip_address, created_at, expires_at, revoked_at
+		FROM sessions
+		WHERE auth_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, authID)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch sessions by auth id: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	defer rows.Close()
+
+	sessions := make([]models.Session, 0)
+	for rows.Next() {
+		var s models.Session
+		if err := rows.Scan(&s.ID, &s.UserAgent, &s.IPAddress, &s.CreatedAt, &s.ExpiresAt, &s.RevokedAt); err != nil {
+			e := fmt.Errorf("failed to fetch sessions by auth id: %w", err)
+			return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		sessions = append(sessions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to fetch sessions by auth id: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return sessions, nil
+}
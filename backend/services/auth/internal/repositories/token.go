@@ -1,7 +1,9 @@
 package repositories
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/ritchieridanko/pasarly/backend/services/auth/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/constants"
@@ -15,6 +17,10 @@ const tokenErrTracer string = "repository.token"
 
 type TokenRepository interface {
 	CreateVerificationToken(ctx context.Context, authID int64, token string) (err *ce.Error)
+	CreateSecureAccountToken(ctx context.Context, authID int64, token string) (err *ce.Error)
+	ConsumeSecureAccountToken(ctx context.Context, token string) (authID int64, err *ce.Error)
+	CreatePasswordResetToken(ctx context.Context, authID int64, token string) (err *ce.Error)
+	ConsumePasswordResetToken(ctx context.Context, token string) (authID int64, err *ce.Error)
 }
 
 type tokenRepository struct {
@@ -57,3 +63,95 @@ func (r *tokenRepository) CreateVerificationToken(ctx context.Context, authID in
 
 	return nil
 }
+
+func (r *tokenRepository) CreateSecureAccountToken(ctx context.Context, authID int64, token string) *ce.Error {
+	ctx, span := otel.Tracer(tokenErrTracer).Start(ctx, "CreateSecureAccountToken")
+	defer span.End()
+
+	// Every alert carries its own token, so earlier alerts stay actionable until they expire
+	key := fmt.Sprintf("%s:%s", constants.CachePrefixSecureAccount, token)
+	if err := r.cache.Set(ctx, key, authID, r.config.Token.Duration.SecureAccount); err != nil {
+		e := fmt.Errorf("failed to create secure account token: %w", err)
+		return ce.NewError(span, ce.CodeCacheQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *tokenRepository) ConsumeSecureAccountToken(ctx context.Context, token string) (int64, *ce.Error) {
+	ctx, span := otel.Tracer(tokenErrTracer).Start(ctx, "ConsumeSecureAccountToken")
+	defer span.End()
+
+	authID, err := r.consume(ctx, constants.CachePrefixSecureAccount, token)
+	if err != nil {
+		e := fmt.Errorf("failed to consume secure account token: %w", err)
+		if errors.Is(err, ce.ErrCacheNil) || errors.Is(err, ce.ErrInvalidToken) {
+			return 0, ce.NewError(span, ce.CodeInvalidPayload, "Link is invalid or has expired", e)
+		}
+
+		return 0, ce.NewError(span, ce.CodeCacheScriptExec, ce.MsgInternalServer, e)
+	}
+
+	return authID, nil
+}
+
+func (r *tokenRepository) CreatePasswordResetToken(ctx context.Context, authID int64, token string) *ce.Error {
+	ctx, span := otel.Tracer(tokenErrTracer).Start(ctx, "CreatePasswordResetToken")
+	defer span.End()
+
+	key := fmt.Sprintf("%s:%s", constants.CachePrefixPasswordReset, token)
+	if err := r.cache.Set(ctx, key, authID, r.config.Token.Duration.PasswordReset); err != nil {
+		e := fmt.Errorf("failed to create password reset token: %w", err)
+		return ce.NewError(span, ce.CodeCacheQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *tokenRepository) ConsumePasswordResetToken(ctx context.Context, token string) (int64, *ce.Error) {
+	ctx, span := otel.Tracer(tokenErrTracer).Start(ctx, "ConsumePasswordResetToken")
+	defer span.End()
+
+	authID, err := r.consume(ctx, constants.CachePrefixPasswordReset, token)
+	if err != nil {
+		e := fmt.Errorf("failed to consume password reset token: %w", err)
+		if errors.Is(err, ce.ErrCacheNil) || errors.Is(err, ce.ErrInvalidToken) {
+			return 0, ce.NewError(span, ce.CodeInvalidPayload, "Link is invalid or has expired", e)
+		}
+
+		return 0, ce.NewError(span, ce.CodeCacheScriptExec, ce.MsgInternalServer, e)
+	}
+
+	return authID, nil
+}
+
+// consume reads and deletes a single-use token atomically, so that it can never be redeemed twice
+func (r *tokenRepository) consume(ctx context.Context, prefix, token string) (int64, error) {
+	script := `
+		local value = redis.call("GET", KEYS[1])
+		if value then
+			redis.call("DEL", KEYS[1])
+		end
+		return value
+	`
+
+	res, err := r.cache.Evaluate(
+		ctx, "hs:cst", script,
+		[]string{fmt.Sprintf("%s:%s", prefix, token)},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := res.(string)
+	if !ok {
+		return 0, ce.ErrInvalidToken
+	}
+
+	authID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, ce.ErrInvalidToken
+	}
+
+	return authID, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ritchieridanko/pasarly/backend/services/auth/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/database"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/logger"
@@ -25,28 +26,52 @@ type AuthUsecase interface {
 	SignUp(ctx context.Context, data *models.CreateAuth) (auth *models.Auth, err *ce.Error)
 	SignIn(ctx context.Context, data *models.GetAuth) (auth *models.Auth, err *ce.Error)
 	IsEmailAvailable(ctx context.Context, email string) (exists bool, err *ce.Error)
+	GetAuth(ctx context.Context, authID int64) (auth *models.Auth, err *ce.Error)
+	DeleteAccount(ctx context.Context, data *models.DeleteAuth) (scheduledAt *time.Time, err *ce.Error)
+	CancelAccountDeletion(ctx context.Context, authID int64) (err *ce.Error)
+	UpdateLocale(ctx context.Context, data *models.UpdateLocale) (auth *models.Auth, err *ce.Error)
+	SecureAccount(ctx context.Context, token string) (resetToken string, err *ce.Error)
+	ResetPassword(ctx context.Context, data *models.ResetPassword) (err *ce.Error)
+	FinalizeAccountDeletions(ctx context.Context) (count int, err *ce.Error)
 }
 
 type authUsecase struct {
+	cfg        *configs.Auth
 	ar         repositories.AuthRepository
+	sr         repositories.SessionRepository
 	tr         repositories.TokenRepository
 	transactor *database.Transactor
 	acp        *publisher.Publisher
+	adp        *publisher.Publisher
 	bcrypt     *utils.BCrypt
 	validator  *utils.Validator
 	logger     *logger.Logger
 }
 
 func NewAuthUsecase(
+	cfg *configs.Auth,
 	ar repositories.AuthRepository,
+	sr repositories.SessionRepository,
 	tr repositories.TokenRepository,
 	tx *database.Transactor,
 	acp *publisher.Publisher,
+	adp *publisher.Publisher,
 	b *utils.BCrypt,
 	v *utils.Validator,
 	l *logger.Logger,
 ) AuthUsecase {
-	return &authUsecase{ar: ar, tr: tr, transactor: tx, acp: acp, bcrypt: b, validator: v, logger: l}
+	return &authUsecase{
+		cfg:        cfg,
+		ar:         ar,
+		sr:         sr,
+		tr:         tr,
+		transactor: tx,
+		acp:        acp,
+		adp:        adp,
+		bcrypt:     b,
+		validator:  v,
+		logger:     l,
+	}
 }
 
 func (u *authUsecase) SignUp(ctx context.Context, data *models.CreateAuth) (*models.Auth, *ce.Error) {
@@ -56,11 +81,22 @@ func (u *authUsecase) SignUp(ctx context.Context, data *models.CreateAuth) (*mod
 	// Validations
 	if ok, why := u.validator.Email(&data.Email); !ok {
 		err := fmt.Errorf("failed to sign up: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("email", why)
 	}
 	if ok, why := u.validator.Password(data.Password); !ok {
 		err := fmt.Errorf("failed to sign up: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("password", why)
+	}
+	if data.Locale != "" {
+		if ok, why := u.validator.Locale(data.Locale); !ok {
+			err := fmt.Errorf("failed to sign up: %w", errors.New(why))
+			return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("locale", why)
+		}
+	}
+
+	// Normalizations
+	if data.Locale == "" {
+		data.Locale = constants.LocaleDefault
 	}
 
 	var auth *models.Auth
@@ -94,6 +130,7 @@ func (u *authUsecase) SignUp(ctx context.Context, data *models.CreateAuth) (*mod
 			Email:    email,
 			Password: &h,
 			Role:     constants.RoleCustomer,
+			Locale:   data.Locale,
 		}
 
 		auth, err = u.ar.CreateAuth(ctx, &ca)
@@ -118,6 +155,7 @@ func (u *authUsecase) SignUp(ctx context.Context, data *models.CreateAuth) (*mod
 		Email:     auth.Email,
 		Token:     token,
 		CreatedAt: timestamppb.New(time.Now().UTC()),
+		Locale:    auth.Locale,
 	}
 
 	_ = u.acp.Publish(ctx, key, &evt) // failed to publish event does not fail SignUp process
@@ -132,7 +170,7 @@ func (u *authUsecase) SignIn(ctx context.Context, data *models.GetAuth) (*models
 	// Validation
 	if ok, why := u.validator.Email(&data.Email); !ok {
 		err := fmt.Errorf("failed to sign in: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("email", why)
 	}
 
 	email := utils.NormalizeString(data.Email)
@@ -150,6 +188,12 @@ func (u *authUsecase) SignIn(ctx context.Context, data *models.GetAuth) (*models
 		return nil, ce.NewError(span, ce.CodeInvalidCredentials, ce.MsgInvalidCredentials, e)
 	}
 
+	// Checked after the password so that the flag is not disclosed to whoever lacks it
+	if auth.PasswordResetRequired {
+		err := fmt.Errorf("failed to sign in: %w", ce.ErrPasswordResetRequired)
+		return nil, ce.NewError(span, ce.CodePasswordResetRequired, ce.MsgPasswordResetRequired, err)
+	}
+
 	return auth, nil
 }
 
@@ -160,7 +204,7 @@ func (u *authUsecase) IsEmailAvailable(ctx context.Context, email string) (bool,
 	// Validation
 	if ok, why := u.validator.Email(&email); !ok {
 		err := fmt.Errorf("failed to check if email is available: %w", errors.New(why))
-		return false, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return false, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("email", why)
 	}
 
 	email = utils.NormalizeString(email)
@@ -179,3 +223,166 @@ func (u *authUsecase) IsEmailAvailable(ctx context.Context, email string) (bool,
 
 	return !exists, nil
 }
+
+func (u *authUsecase) GetAuth(ctx context.Context, authID int64) (*models.Auth, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "GetAuth")
+	defer span.End()
+
+	return u.ar.GetAuthByID(ctx, authID)
+}
+
+func (u *authUsecase) DeleteAccount(ctx context.Context, data *models.DeleteAuth) (*time.Time, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "DeleteAccount")
+	defer span.End()
+
+	auth, err := u.ar.GetAuthByID(ctx, data.AuthID)
+	if err != nil {
+		return nil, err
+	}
+	if auth.Password == nil {
+		err := fmt.Errorf("failed to delete account: %w", ce.ErrWrongSignInMethod)
+		return nil, ce.NewError(span, ce.CodeWrongSignInMethod, ce.MsgInvalidCredentials, err)
+	}
+
+	// Re-authentication
+	if err := u.bcrypt.Validate(*auth.Password, data.Password); err != nil {
+		e := fmt.Errorf("failed to delete account: %w", err)
+		return nil, ce.NewError(span, ce.CodeInvalidCredentials, ce.MsgInvalidCredentials, e)
+	}
+
+	return u.ar.ScheduleDeletion(ctx, auth.ID, time.Now().UTC().Add(u.cfg.Deletion.GracePeriod))
+}
+
+func (u *authUsecase) CancelAccountDeletion(ctx context.Context, authID int64) *ce.Error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "CancelAccountDeletion")
+	defer span.End()
+
+	return u.ar.CancelDeletion(ctx, authID)
+}
+
+func (u *authUsecase) UpdateLocale(ctx context.Context, data *models.UpdateLocale) (*models.Auth, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "UpdateLocale")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.Locale(data.Locale); !ok {
+		err := fmt.Errorf("failed to update locale: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("locale", why)
+	}
+
+	return u.ar.UpdateLocale(ctx, data)
+}
+
+func (u *authUsecase) SecureAccount(ctx context.Context, token string) (string, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "SecureAccount")
+	defer span.End()
+
+	// Validation
+	if ok, why := u.validator.Token(&token); !ok {
+		err := fmt.Errorf("failed to secure account: %w", errors.New(why))
+		return "", ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("token", why)
+	}
+
+	authID, err := u.tr.ConsumeSecureAccountToken(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	// The reset token is issued before the account is locked, so a lock never leaves the owner without a way back in
+	resetToken := utils.NewUUID().String()
+	if err := u.tr.CreatePasswordResetToken(ctx, authID, resetToken); err != nil {
+		u.restoreSecureAccountToken(ctx, authID, token)
+		return "", err
+	}
+
+	// Every session is revoked, including the suspicious one, and password sign-ins are blocked until a reset
+	err = u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		if err := u.ar.RequirePasswordReset(ctx, authID); err != nil {
+			return err
+		}
+
+		return u.sr.RevokeSessionsByAuthID(ctx, authID)
+	})
+	if err != nil {
+		u.restoreSecureAccountToken(ctx, authID, token)
+		return "", err
+	}
+
+	return resetToken, nil
+}
+
+func (u *authUsecase) ResetPassword(ctx context.Context, data *models.ResetPassword) *ce.Error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "ResetPassword")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.Token(&data.Token); !ok {
+		err := fmt.Errorf("failed to reset password: %w", errors.New(why))
+		return ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("token", why)
+	}
+	if ok, why := u.validator.Password(data.Password); !ok {
+		err := fmt.Errorf("failed to reset password: %w", errors.New(why))
+		return ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("password", why)
+	}
+
+	authID, err := u.tr.ConsumePasswordResetToken(ctx, data.Token)
+	if err != nil {
+		return err
+	}
+
+	h, eh := u.bcrypt.Hash(*data.Password)
+	if eh != nil {
+		e := fmt.Errorf("failed to reset password: %w", eh)
+		return ce.NewError(span, ce.CodeHashingFailed, ce.MsgInternalServer, e)
+	}
+
+	return u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		if err := u.ar.ResetPassword(ctx, authID, h); err != nil {
+			return err
+		}
+
+		return u.sr.RevokeSessionsByAuthID(ctx, authID)
+	})
+}
+
+func (u *authUsecase) FinalizeAccountDeletions(ctx context.Context) (int, *ce.Error) {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "FinalizeAccountDeletions")
+	defer span.End()
+
+	// A failed publish rolls the deletions back to be retried on the next run
+	var count int
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		auths, err := u.ar.DeleteScheduledAuths(ctx, u.cfg.Deletion.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, auth := range auths {
+			key := fmt.Sprintf("auth_%d", auth.ID)
+			evt := events.AuthDeleted{
+				EventId:   utils.NewUUID().String(),
+				AuthId:    auth.ID,
+				Email:     auth.Email,
+				DeletedAt: timestamppb.New(auth.DeletedAt),
+				Locale:    auth.Locale,
+			}
+
+			if err := u.adp.Publish(ctx, key, &evt); err != nil {
+				e := fmt.Errorf("failed to finalize account deletions: %w", err)
+				return ce.NewError(span, ce.CodeInternal, ce.MsgInternalServer, e)
+			}
+		}
+
+		count = len(auths)
+		return nil
+	})
+
+	return count, err
+}
+
+// restoreSecureAccountToken puts a consumed link back after a failed attempt, so the owner can retry from the same alert
+func (u *authUsecase) restoreSecureAccountToken(ctx context.Context, authID int64, token string) {
+	if err := u.tr.CreateSecureAccountToken(ctx, authID, token); err != nil {
+		u.logger.Sugar().Warnln(err.Error())
+	}
+}
@@ -7,11 +7,16 @@ import (
 	"time"
 
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/geoip"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/publisher"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/repositories"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
 	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const sessionErrTracer string = "usecase.session"
@@ -19,24 +24,43 @@ const sessionErrTracer string = "usecase.session"
 type SessionUsecase interface {
 	CreateSession(ctx context.Context, auth *models.Auth, rm *models.RequestMeta) (at *models.AuthToken, err *ce.Error)
 	RevokeSession(ctx context.Context, sessionToken string) (err *ce.Error)
+	GetSessions(ctx context.Context, authID int64) (sessions []models.Session, err *ce.Error)
 }
 
 type sessionUsecase struct {
 	duration   time.Duration
 	sr         repositories.SessionRepository
+	tr         repositories.TokenRepository
 	transactor *database.Transactor
+	ndp        *publisher.Publisher
+	locator    geoip.Locator
 	jwt        *utils.JWT
 	validator  *utils.Validator
+	logger     *logger.Logger
 }
 
 func NewSessionUsecase(
 	d time.Duration,
 	sr repositories.SessionRepository,
+	tr repositories.TokenRepository,
 	tx *database.Transactor,
+	ndp *publisher.Publisher,
+	g geoip.Locator,
 	j *utils.JWT,
 	v *utils.Validator,
+	l *logger.Logger,
 ) SessionUsecase {
-	return &sessionUsecase{duration: d, sr: sr, transactor: tx, jwt: j, validator: v}
+	return &sessionUsecase{
+		duration:   d,
+		sr:         sr,
+		tr:         tr,
+		transactor: tx,
+		ndp:        ndp,
+		locator:    g,
+		jwt:        j,
+		validator:  v,
+		logger:     l,
+	}
 }
 
 func (u *sessionUsecase) CreateSession(ctx context.Context, auth *models.Auth, rm *models.RequestMeta) (*models.AuthToken, *ce.Error) {
@@ -59,7 +83,14 @@ func (u *sessionUsecase) CreateSession(ctx context.Context, auth *models.Auth, r
 		ExpiresAt: now.Add(u.duration),
 	}
 
+	var isNewDevice bool
 	err := u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		var err *ce.Error
+		isNewDevice, err = u.sr.IsNewDevice(ctx, auth.ID, rm)
+		if err != nil {
+			return err
+		}
+
 		sessionID, err := u.sr.RevokeActiveSession(ctx, auth.ID, rm)
 		if err != nil {
 			return err
@@ -70,8 +101,15 @@ func (u *sessionUsecase) CreateSession(ctx context.Context, auth *models.Auth, r
 
 		return u.sr.CreateSession(ctx, auth.ID, &data)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &models.AuthToken{Session: sessionToken, Access: accessToken}, err
+	if isNewDevice {
+		u.alertNewDevice(ctx, auth, rm, now) // failed alert does not fail CreateSession process
+	}
+
+	return &models.AuthToken{Session: sessionToken, Access: accessToken}, nil
 }
 
 func (u *sessionUsecase) RevokeSession(ctx context.Context, sessionToken string) *ce.Error {
@@ -81,8 +119,55 @@ func (u *sessionUsecase) RevokeSession(ctx context.Context, sessionToken string)
 	// Validation
 	if ok, why := u.validator.Token(&sessionToken); !ok {
 		err := fmt.Errorf("failed to revoke session: %w", errors.New(why))
-		return ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("token", why)
 	}
 
 	return u.sr.RevokeSessionByToken(ctx, sessionToken)
 }
+
+func (u *sessionUsecase) GetSessions(ctx context.Context, authID int64) ([]models.Session, *ce.Error) {
+	ctx, span := otel.Tracer(sessionErrTracer).Start(ctx, "GetSessions")
+	defer span.End()
+
+	return u.sr.GetSessionsByAuthID(ctx, authID)
+}
+
+// alertNewDevice tells the owner about a sign-in from an unseen device, along with a link to secure the account
+func (u *sessionUsecase) alertNewDevice(ctx context.Context, auth *models.Auth, rm *models.RequestMeta, signedInAt time.Time) {
+	ctx, span := otel.Tracer(sessionErrTracer).Start(ctx, "alertNewDevice")
+	defer span.End()
+
+	token := utils.NewUUID().String()
+	if err := u.tr.CreateSecureAccountToken(ctx, auth.ID, token); err != nil {
+		u.logger.Sugar().Warnln(err.Error())
+		return
+	}
+
+	browser, os := utils.ParseUserAgent(rm.UserAgent)
+
+	var location string
+	if l, err := u.locator.Locate(ctx, rm.IPAddress); err == nil {
+		location = l.String()
+	} else if !errors.Is(err, geoip.ErrDisabled) {
+		u.logger.Sugar().Warnf("failed to locate new device sign-in: %v", err)
+	}
+
+	key := fmt.Sprintf("auth_%d", auth.ID)
+	evt := events.NewDeviceSignIn{
+		EventId:    utils.NewUUID().String(),
+		AuthId:     auth.ID,
+		Email:      auth.Email,
+		Locale:     auth.Locale,
+		UserAgent:  rm.UserAgent,
+		IpAddress:  rm.IPAddress,
+		Browser:    browser,
+		Os:         os,
+		Location:   location,
+		Token:      token,
+		SignedInAt: timestamppb.New(signedInAt),
+	}
+
+	if err := u.ndp.Publish(ctx, key, &evt); err != nil {
+		u.logger.Sugar().Warnf("failed to publish new device sign-in: %v", err)
+	}
+}
@@ -3,10 +3,12 @@ package utils
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/constants"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func CtxRequestMeta(ctx context.Context) (userAgent, ipAddress string) {
@@ -32,3 +34,10 @@ func NewUUID() uuid.UUID {
 func NormalizeString(s string) string {
 	return strings.ToLower(strings.TrimSpace(s))
 }
+
+func WrapTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,55 @@
+package utils
+
+import "regexp"
+
+// Browser tokens in order of precedence, since most browsers also claim to be Chrome or Safari
+var uaBrowsers = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"Edge", regexp.MustCompile(`Edg(?:e|A|iOS)?/(\d+)`)},
+	{"Opera", regexp.MustCompile(`(?:OPR|Opera)/(\d+)`)},
+	{"Samsung Internet", regexp.MustCompile(`SamsungBrowser/(\d+)`)},
+	{"Firefox", regexp.MustCompile(`(?:Firefox|FxiOS)/(\d+)`)},
+	{"Chrome", regexp.MustCompile(`(?:Chrome|CriOS)/(\d+)`)},
+	{"Safari", regexp.MustCompile(`Version/(\d+)[.\d]* (?:Mobile/\S+ )?Safari/`)},
+}
+
+// Windows and macOS stopped bumping the versions they report, so those are left out
+var uaSystems = []struct {
+	name      string
+	versioned bool
+	pattern   *regexp.Regexp
+}{
+	{"iOS", true, regexp.MustCompile(`(?:iPhone|iPad|iPod).*? OS (\d+)`)},
+	{"Android", true, regexp.MustCompile(`Android (\d+)`)},
+	{"Windows", false, regexp.MustCompile(`Windows NT`)},
+	{"ChromeOS", false, regexp.MustCompile(`CrOS `)},
+	{"macOS", false, regexp.MustCompile(`Mac OS X`)},
+	{"Linux", false, regexp.MustCompile(`Linux`)},
+}
+
+// ParseUserAgent extracts a readable browser and operating system, e.g. "Chrome 120" and "Windows",
+// falling back to "Unknown" for the parts it does not recognize
+func ParseUserAgent(userAgent string) (browser, os string) {
+	browser, os = "Unknown", "Unknown"
+
+	for _, b := range uaBrowsers {
+		if m := b.pattern.FindStringSubmatch(userAgent); m != nil {
+			browser = b.name + " " + m[1]
+			break
+		}
+	}
+
+	for _, s := range uaSystems {
+		if m := s.pattern.FindStringSubmatch(userAgent); m != nil {
+			os = s.name
+			if s.versioned {
+				os = s.name + " " + m[1]
+			}
+			break
+		}
+	}
+
+	return browser, os
+}
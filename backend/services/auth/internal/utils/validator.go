@@ -3,7 +3,10 @@ package utils
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/constants"
 )
 
 const (
@@ -70,6 +73,13 @@ func (u *Validator) Password(value *string) (bool, string) {
 	return true, ""
 }
 
+func (u *Validator) Locale(value string) (bool, string) {
+	if !slices.Contains(constants.Locales, value) {
+		return false, fmt.Sprintf("Locale must be one of: %s", strings.Join(constants.Locales, ", "))
+	}
+	return true, ""
+}
+
 func (u *Validator) Token(value *string) (bool, string) {
 	if value == nil {
 		return false, "Token is not provided"
@@ -0,0 +1,37 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/auth/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/shared/batch"
+)
+
+type AccountWorker struct {
+	interval  time.Duration
+	batchSize int
+	au        usecases.AuthUsecase
+	logger    *logger.Logger
+}
+
+func NewAccountWorker(interval time.Duration, batchSize int, au usecases.AuthUsecase, l *logger.Logger) *AccountWorker {
+	return &AccountWorker{interval: interval, batchSize: batchSize, au: au, logger: l}
+}
+
+// Run finalizes account deletions whose grace period has ended until ctx is cancelled
+func (w *AccountWorker) Run(ctx context.Context) error {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+
+	for {
+		batch.Drain(ctx, w.logger.Base(), w.batchSize, "finalized account deletions", w.au.FinalizeAccountDeletions)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1 @@
+ALTER TABLE auth DROP COLUMN IF EXISTS deletion_scheduled_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE auth ADD COLUMN deletion_scheduled_at TIMESTAMPTZ;
+
+-- Optimize queries of active (not soft-deleted) records that are due for deletion
+CREATE INDEX idx_auth_deletion_scheduled ON auth(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL AND deleted_at IS NULL;
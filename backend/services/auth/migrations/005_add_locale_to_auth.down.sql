@@ -0,0 +1 @@
+ALTER TABLE auth DROP COLUMN IF EXISTS locale;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Preferred language of the emails and notifications sent to the account
+ALTER TABLE auth ADD COLUMN locale VARCHAR NOT NULL DEFAULT 'en';
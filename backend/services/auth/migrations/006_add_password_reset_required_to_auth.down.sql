@@ -0,0 +1 @@
+ALTER TABLE auth DROP COLUMN IF EXISTS password_reset_required;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Set when the owner reports a sign-in as not theirs, blocking password sign-ins until a reset
+ALTER TABLE auth ADD COLUMN password_reset_required BOOLEAN NOT NULL DEFAULT FALSE;
@@ -10,6 +10,7 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/di"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/broadcast"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/server"
 )
 
@@ -35,17 +36,28 @@ func main() {
 		}
 	}(s)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Run the notification hub
+	go func(ctx context.Context, h *broadcast.Hub) {
+		if err := h.Run(ctx); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.Hub())
+
 	// Handle app shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	<-quit
 	log.Printf("🛑 [%s] is shutting down...", cfg.App.Name)
+	cancel()
 
-	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.Timeout.Shutdown)
-	defer cancel()
+	sdCtx, sdCancel := context.WithTimeout(context.Background(), cfg.Server.Timeout.Shutdown)
+	defer sdCancel()
 
-	if err := s.Shutdown(ctx); err != nil {
+	if err := s.Shutdown(sdCtx); err != nil {
 		log.Fatalln("FATAL ->", err.Error())
 	}
 }
@@ -1,6 +1,7 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -12,9 +13,16 @@ import (
 type Config struct {
 	App      `mapstructure:"app"`
 	Server   `mapstructure:"server"`
+	TLS      `mapstructure:"tls"`
 	Service  `mapstructure:"service"`
+	Cache    `mapstructure:"cache"`
+	Stream   `mapstructure:"stream"`
 	JWT      `mapstructure:"jwt"`
+	Identity `mapstructure:"identity"`
 	Duration `mapstructure:"duration"`
+	Upload   `mapstructure:"upload"`
+	Static   `mapstructure:"static"`
+	Webhook  `mapstructure:"webhook"`
 	Tracer   `mapstructure:"tracer"`
 }
 
@@ -34,28 +42,80 @@ type Server struct {
 	} `mapstructure:"timeout"`
 }
 
+type TLS struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	CertFile       string        `mapstructure:"cert_file"`
+	KeyFile        string        `mapstructure:"key_file"`
+	CAFile         string        `mapstructure:"ca_file"`
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
 type Service struct {
-	Auth struct {
-		Addr string
-		Host string `mapstructure:"host"`
-		Port int    `mapstructure:"port"`
-	} `mapstructure:"auth"`
+	Auth         Downstream `mapstructure:"auth"`
+	User         Downstream `mapstructure:"user"`
+	Notification Downstream `mapstructure:"notification"`
+
+	Retry struct {
+		MaxAttempts int `mapstructure:"max_attempts"`
+		BaseDelay   int `mapstructure:"base_delay"`
+	} `mapstructure:"retry"`
+
+	Breaker struct {
+		MaxFailures int           `mapstructure:"max_failures"`
+		OpenTimeout time.Duration `mapstructure:"open_timeout"`
+	} `mapstructure:"breaker"`
+}
+
+type Downstream struct {
+	Addr       string
+	Host       string   `mapstructure:"host"`
+	Port       int      `mapstructure:"port"`
+	Idempotent []string `mapstructure:"idempotent"`
 
-	User struct {
-		Addr string
-		Host string `mapstructure:"host"`
-		Port int    `mapstructure:"port"`
-	} `mapstructure:"user"`
+	Timeout struct {
+		Default time.Duration            `mapstructure:"default"`
+		Methods map[string]time.Duration `mapstructure:"methods"`
+	} `mapstructure:"timeout"`
+}
+
+type Cache struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+	Pass string `mapstructure:"pass"`
+}
+
+type Stream struct {
+	Heartbeat time.Duration `mapstructure:"heartbeat"`
+	Buffer    int           `mapstructure:"buffer"`
 }
 
 type JWT struct {
 	Secret string `mapstructure:"secret"`
 }
 
+// Identity signs the caller identity forwarded to the services in place of trusting request fields
+type Identity struct {
+	Secret string        `mapstructure:"secret"`
+	TTL    time.Duration `mapstructure:"ttl"`
+}
+
 type Duration struct {
 	Session time.Duration `mapstructure:"session"`
 }
 
+type Upload struct {
+	MaxSize int64 `mapstructure:"max_size"`
+}
+
+type Static struct {
+	Dir        string `mapstructure:"dir"`
+	SigningKey string `mapstructure:"signing_key"`
+}
+
+type Webhook struct {
+	SigningKey string `mapstructure:"signing_key"`
+}
+
 type Tracer struct {
 	Host     string `mapstructure:"host"`
 	Port     int    `mapstructure:"port"`
@@ -88,10 +148,23 @@ func Init(path string) (*Config, error) {
 		return nil, fmt.Errorf("failed to initialize config: %w", err)
 	}
 
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("failed to initialize config: %w", err)
+	}
+
 	cfg.App.Env = env
 	cfg.Auth.Addr = fmt.Sprintf("%s:%d", cfg.Auth.Host, cfg.Auth.Port)
 	cfg.User.Addr = fmt.Sprintf("%s:%d", cfg.User.Host, cfg.User.Port)
+	cfg.Notification.Addr = fmt.Sprintf("%s:%d", cfg.Notification.Host, cfg.Notification.Port)
 	cfg.Tracer.Endpoint = fmt.Sprintf("%s:%d", cfg.Tracer.Host, cfg.Tracer.Port)
 
 	return &cfg, nil
 }
+
+// validate rejects settings the services cannot run safely or correctly with
+func (c *Config) validate() error {
+	if c.Identity.Secret == "" {
+		return errors.New("identity secret is not set")
+	}
+	return nil
+}
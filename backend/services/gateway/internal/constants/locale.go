@@ -0,0 +1,4 @@
+package constants
+
+// Locales the downstream services have translations for
+var Locales = []string{"en", "id"}
@@ -0,0 +1,4 @@
+package constants
+
+// Published by the notification service whenever a notification lands in a user's inbox
+const PubSubChannelInbox string = "notification.inbox"
@@ -0,0 +1,17 @@
+package constants
+
+const (
+	UploadFieldImage string = "image"
+)
+
+// Objects under these prefixes are only served through links signed by the user service
+const (
+	StaticPrefixExports string = "exports/"
+)
+
+const (
+	MIMETypeGIF  string = "image/gif"
+	MIMETypeJPEG string = "image/jpeg"
+	MIMETypePNG  string = "image/png"
+	MIMETypeWebP string = "image/webp"
+)
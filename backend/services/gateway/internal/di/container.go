@@ -2,7 +2,9 @@ package di
 
 import (
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/broadcast"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/handlers"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/router"
@@ -13,9 +15,17 @@ import (
 type Container struct {
 	config *configs.Config
 	logger *logger.Logger
+	hub    *broadcast.Hub
 	cookie *utils.Cookie
 	ah     *handlers.AuthHandler
 	uh     *handlers.UserHandler
+	rh     *handlers.RegionHandler
+	eh     *handlers.ExportHandler
+	adh    *handlers.AddressHandler
+	nh     *handlers.NotificationHandler
+	ph     *handlers.PreferenceHandler
+	dh     *handlers.DeliveryHandler
+	sh     *handlers.StaticHandler
 	router *router.Router
 	server *server.Server
 }
@@ -23,16 +33,32 @@ type Container struct {
 func Init(cfg *configs.Config, i *infra.Infra) *Container {
 	// Infra
 	l := logger.NewLogger(i.Logger())
+	hub := broadcast.NewHub(i.Cache(), constants.PubSubChannelInbox, cfg.Stream.Buffer, l)
 
 	// Utils
 	c := utils.NewCookie(cfg.App.Env, cfg.Server.Host, true)
 
 	// Handlers
 	ah := handlers.NewAuthHandler(i.AuthService(), c, cfg.Duration.Session)
-	uh := handlers.NewUserHandler(i.UserService())
+	uh := handlers.NewUserHandler(i.UserService(), cfg.Upload.MaxSize)
+	rh := handlers.NewRegionHandler(i.UserRegionService())
+	eh := handlers.NewExportHandler(i.UserExportService())
+	adh := handlers.NewAddressHandler(i.UserAddressService())
+	nh := handlers.NewNotificationHandler(i.NotificationInboxService(), hub, cfg.Stream.Heartbeat)
+	ph := handlers.NewPreferenceHandler(i.NotificationPreferenceService())
+	dh := handlers.NewDeliveryHandler(i.NotificationDeliveryService())
+
+	var sh *handlers.StaticHandler
+	if cfg.Static.Dir != "" {
+		sh = handlers.NewStaticHandler(cfg.Static.Dir, cfg.Static.SigningKey)
+	}
 
 	// Router
-	r := router.Init(l, cfg.App.Name, cfg.JWT.Secret, ah, uh)
+	r := router.Init(
+		l,
+		cfg.App.Name, cfg.JWT.Secret, cfg.Webhook.SigningKey,
+		ah, uh, rh, eh, adh, nh, ph, dh, sh,
+	)
 
 	// Server
 	s := server.Init(&cfg.Server, r.Router(), l)
@@ -40,14 +66,26 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 	return &Container{
 		config: cfg,
 		logger: l,
+		hub:    hub,
 		cookie: c,
 		ah:     ah,
 		uh:     uh,
+		rh:     rh,
+		eh:     eh,
+		adh:    adh,
+		nh:     nh,
+		ph:     ph,
+		dh:     dh,
+		sh:     sh,
 		router: r,
 		server: s,
 	}
 }
 
+func (c *Container) Hub() *broadcast.Hub {
+	return c.hub
+}
+
 func (c *Container) Server() *server.Server {
 	return c.server
 }
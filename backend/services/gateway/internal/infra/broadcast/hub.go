@@ -0,0 +1,147 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	hubRetryMinDelay time.Duration = time.Second
+	hubRetryMaxDelay time.Duration = 30 * time.Second
+)
+
+type Hub struct {
+	client      *redis.Client
+	channel     string
+	buffer      int
+	logger      *logger.Logger
+	mutex       sync.Mutex
+	subscribers map[int64]map[chan *events.InboxNotificationCreated]struct{}
+}
+
+func NewHub(c *redis.Client, channel string, buffer int, l *logger.Logger) *Hub {
+	return &Hub{
+		client:      c,
+		channel:     channel,
+		buffer:      buffer,
+		logger:      l,
+		subscribers: make(map[int64]map[chan *events.InboxNotificationCreated]struct{}),
+	}
+}
+
+// Run holds a single Redis subscription for the whole instance and hands every message to the local subscribers of
+// its recipient until ctx is cancelled, every subscriber channel is closed once it returns. Failed subscriptions are
+// retried with backoff so that streams opened while Redis is unreachable still get pushes once it is back.
+func (h *Hub) Run(ctx context.Context) error {
+	defer h.closeAll()
+
+	delay := hubRetryMinDelay
+	for {
+		subscribed, err := h.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if subscribed {
+			delay = hubRetryMinDelay
+		}
+
+		h.logger.Sugar().Warnf("⚠️ [HUB] subscription lost, retrying in %s: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, hubRetryMaxDelay)
+	}
+}
+
+func (h *Hub) listen(ctx context.Context) (bool, error) {
+	ps := h.client.Subscribe(ctx, h.channel)
+	defer ps.Close()
+
+	if _, err := ps.Receive(ctx); err != nil {
+		return false, err
+	}
+
+	h.logger.Sugar().Infof("✅ [HUB] subscribed (channel=%s)", h.channel)
+
+	messages := ps.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return true, errors.New("subscription closed")
+			}
+
+			var evt events.InboxNotificationCreated
+			if err := proto.Unmarshal([]byte(msg.Payload), &evt); err != nil {
+				h.logger.Sugar().Warnf("⚠️ [HUB] dropped malformed message: %v", err)
+				continue
+			}
+
+			h.deliver(&evt)
+		}
+	}
+}
+
+// Subscribe registers a stream for the given account, the returned function must be called once the stream ends
+func (h *Hub) Subscribe(authID int64) (<-chan *events.InboxNotificationCreated, func()) {
+	ch := make(chan *events.InboxNotificationCreated, h.buffer)
+
+	h.mutex.Lock()
+	if h.subscribers[authID] == nil {
+		h.subscribers[authID] = make(map[chan *events.InboxNotificationCreated]struct{})
+	}
+	h.subscribers[authID][ch] = struct{}{}
+	h.mutex.Unlock()
+
+	return ch, func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+
+		if _, ok := h.subscribers[authID][ch]; !ok {
+			return
+		}
+
+		delete(h.subscribers[authID], ch)
+		if len(h.subscribers[authID]) == 0 {
+			delete(h.subscribers, authID)
+		}
+		close(ch)
+	}
+}
+
+func (h *Hub) deliver(evt *events.InboxNotificationCreated) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	// A slow client misses the push rather than holding up everyone else, its inbox still has the notification
+	for ch := range h.subscribers[evt.GetAuthId()] {
+		select {
+		case ch <- evt:
+		default:
+		}
+	}
+}
+
+func (h *Hub) closeAll() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for authID, chs := range h.subscribers {
+		for ch := range chs {
+			close(ch)
+		}
+		delete(h.subscribers, authID)
+	}
+}
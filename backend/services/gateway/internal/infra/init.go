@@ -3,20 +3,32 @@ package infra
 import (
 	"fmt"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/cache"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/certs"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/services"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/tracer"
 	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"go.uber.org/zap"
 )
 
 type Infra struct {
+	certs  *mtls.Reloader
 	config *configs.Config
 	logger *zap.Logger
 	tracer *tracer.Tracer
+	cache  *redis.Client
 	as     apis.AuthServiceClient
 	us     apis.UserServiceClient
+	rs     apis.UserRegionServiceClient
+	es     apis.UserExportServiceClient
+	ads    apis.UserAddressServiceClient
+	is     apis.NotificationInboxServiceClient
+	ps     apis.NotificationPreferenceServiceClient
+	ds     apis.NotificationDeliveryServiceClient
 }
 
 func Init(cfg *configs.Config) (*Infra, error) {
@@ -25,28 +37,74 @@ func Init(cfg *configs.Config) (*Infra, error) {
 		return nil, err
 	}
 
+	cr, err := certs.Init(&cfg.TLS, l)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := tracer.Init(cfg.App.Name, cfg.Tracer.Endpoint, l)
 	if err != nil {
 		return nil, err
 	}
 
+	c, err := cache.Init(&cfg.Cache, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// Services
-	as, err := services.NewAuthService(&cfg.Service, l)
+	as, err := services.NewAuthService(&cfg.Service, cr, l)
 	if err != nil {
 		return nil, err
 	}
-	us, err := services.NewUserService(&cfg.Service, l)
+	uc, err := services.NewUserService(&cfg.Service, &cfg.Identity, cr, l)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Infra{config: cfg, logger: l, tracer: t, as: as, us: us}, nil
+	us := apis.NewUserServiceClient(uc)
+	rs := apis.NewUserRegionServiceClient(uc)
+	es := apis.NewUserExportServiceClient(uc)
+	ads := apis.NewUserAddressServiceClient(uc)
+
+	nc, err := services.NewNotificationService(&cfg.Service, cr, l)
+	if err != nil {
+		return nil, err
+	}
+
+	is := apis.NewNotificationInboxServiceClient(nc)
+	ps := apis.NewNotificationPreferenceServiceClient(nc)
+	ds := apis.NewNotificationDeliveryServiceClient(nc)
+
+	return &Infra{
+		certs:  cr,
+		config: cfg,
+		logger: l,
+		tracer: t,
+		cache:  c,
+		as:     as,
+		us:     us,
+		rs:     rs,
+		es:     es,
+		ads:    ads,
+		is:     is,
+		ps:     ps,
+		ds:     ds,
+	}, nil
+}
+
+func (i *Infra) Certs() *mtls.Reloader {
+	return i.certs
 }
 
 func (i *Infra) Logger() *zap.Logger {
 	return i.logger
 }
 
+func (i *Infra) Cache() *redis.Client {
+	return i.cache
+}
+
 func (i *Infra) AuthService() apis.AuthServiceClient {
 	return i.as
 }
@@ -55,10 +113,40 @@ func (i *Infra) UserService() apis.UserServiceClient {
 	return i.us
 }
 
+func (i *Infra) UserRegionService() apis.UserRegionServiceClient {
+	return i.rs
+}
+
+func (i *Infra) UserAddressService() apis.UserAddressServiceClient {
+	return i.ads
+}
+
+func (i *Infra) UserExportService() apis.UserExportServiceClient {
+	return i.es
+}
+
+func (i *Infra) NotificationInboxService() apis.NotificationInboxServiceClient {
+	return i.is
+}
+
+func (i *Infra) NotificationPreferenceService() apis.NotificationPreferenceServiceClient {
+	return i.ps
+}
+
+func (i *Infra) NotificationDeliveryService() apis.NotificationDeliveryServiceClient {
+	return i.ds
+}
+
 func (i *Infra) Close() error {
+	if i.certs != nil {
+		i.certs.Close()
+	}
 	if err := i.logger.Sync(); err != nil {
 		return fmt.Errorf("failed to close logger: %w", err)
 	}
+	if err := i.cache.Close(); err != nil {
+		return fmt.Errorf("failed to close cache: %w", err)
+	}
 
 	i.tracer.Cleanup()
 	return nil
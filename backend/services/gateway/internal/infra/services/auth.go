@@ -5,13 +5,12 @@ import (
 
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
 	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func NewAuthService(cfg *configs.Service, l *zap.Logger) (apis.AuthServiceClient, error) {
-	conn, err := grpc.NewClient(cfg.Auth.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewAuthService(cfg *configs.Service, r *mtls.Reloader, l *zap.Logger) (apis.AuthServiceClient, error) {
+	conn, err := newConn("AUTH-SERVICE", cfg, &cfg.Auth, r, l)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize auth service: %w", err)
 	}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	stateHalfOpen
+)
+
+type breaker struct {
+	name        string
+	maxFailures int
+	openTimeout time.Duration
+	logger      *zap.Logger
+
+	mutex    sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(name string, maxFailures int, openTimeout time.Duration, l *zap.Logger) *breaker {
+	return &breaker{name: name, maxFailures: maxFailures, openTimeout: openTimeout, logger: l}
+}
+
+func (b *breaker) allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return false
+		}
+
+		b.state = stateHalfOpen
+		b.probing = true
+		return true
+	case stateHalfOpen:
+		// Only a single probe is let through until it reports back
+		if b.probing {
+			return false
+		}
+
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *breaker) record(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !isBreakerFailure(err) {
+		if b.state != stateClosed {
+			b.logger.Sugar().Infof("✅ [%s] circuit closed", b.name)
+		}
+
+		b.state = stateClosed
+		b.failures = 0
+		b.probing = false
+		return
+	}
+
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.maxFailures {
+		if b.state != stateOpen {
+			b.logger.Sugar().Warnf("⚠️ [%s] circuit opened (failures=%d)", b.name, b.failures)
+		}
+
+		b.state = stateOpen
+		b.openedAt = time.Now()
+		b.probing = false
+	}
+}
+
+func isBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/interceptors"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const serviceConfig string = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+func newConn(
+	name string,
+	cfg *configs.Service,
+	d *configs.Downstream,
+	r *mtls.Reloader,
+	l *zap.Logger,
+	extra ...grpc.UnaryClientInterceptor,
+) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if r != nil {
+		creds = mtls.ClientCredentials(r, d.Host)
+	}
+
+	b := newBreaker(name, cfg.Breaker.MaxFailures, cfg.Breaker.OpenTimeout, l)
+
+	// Resolve through DNS so every replica behind the host is balanced round-robin
+	return grpc.NewClient(
+		fmt.Sprintf("dns:///%s", d.Addr),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(interceptors.UnaryClientPropagation()),
+		grpc.WithChainUnaryInterceptor(extra...),
+		grpc.WithChainUnaryInterceptor(
+			timeoutInterceptor(d),
+			breakerInterceptor(b),
+			retryInterceptor(cfg, d.Idempotent),
+		),
+	)
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func timeoutInterceptor(cfg *configs.Downstream) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		timeout := cfg.Timeout.Default
+		if t, ok := cfg.Timeout.Methods[methodName(method)]; ok {
+			timeout = t
+		}
+		if timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func breakerInterceptor(b *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.allow() {
+			e := fmt.Errorf("failed to call %s: %w", method, ce.ErrCircuitOpen)
+			return status.Error(codes.Unavailable, e.Error())
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+		return err
+	}
+}
+
+func retryInterceptor(cfg *configs.Service, idempotent []string) grpc.UnaryClientInterceptor {
+	methods := make(map[string]struct{}, len(idempotent))
+	for _, m := range idempotent {
+		methods[strings.ToLower(m)] = struct{}{}
+	}
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := methods[methodName(method)]; !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		// The first attempt is not a retry, it is made whatever the config says
+		attempts := max(1, cfg.Retry.MaxAttempts)
+
+		var e error
+		for attempt := 0; attempt < attempts; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil {
+				return nil
+			}
+
+			e = err
+			if status.Code(err) != codes.Unavailable {
+				break
+			}
+			if attempt == attempts-1 {
+				break
+			}
+			if err := backoffWait(ctx, cfg.Retry.BaseDelay, attempt); err != nil {
+				break
+			}
+		}
+
+		return e
+	}
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// NewNotificationService dials the notification service once, every client of its gRPC services shares the connection
+func NewNotificationService(cfg *configs.Service, r *mtls.Reloader, l *zap.Logger) (*grpc.ClientConn, error) {
+	conn, err := newConn("NOTIFICATION-SERVICE", cfg, &cfg.Notification, r, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize notification service: %w", err)
+	}
+
+	l.Sugar().Infof(
+		"✅ [NOTIFICATION-SERVICE] running on (host=%s, port=%d)",
+		cfg.Notification.Host, cfg.Notification.Port,
+	)
+	return conn, nil
+}
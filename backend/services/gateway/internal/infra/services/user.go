@@ -4,18 +4,20 @@ import (
 	"fmt"
 
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/configs"
-	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/identity"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func NewUserService(cfg *configs.Service, l *zap.Logger) (apis.UserServiceClient, error) {
-	conn, err := grpc.NewClient(cfg.User.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewUserService dials the user service once, every client of its gRPC services shares the connection. Calls
+// carry the signed identity of the authenticated user, which the service trusts over the auth_id of requests.
+func NewUserService(cfg *configs.Service, id *configs.Identity, r *mtls.Reloader, l *zap.Logger) (*grpc.ClientConn, error) {
+	conn, err := newConn("USER-SERVICE", cfg, &cfg.User, r, l, identity.UnaryClientInterceptor(id.Secret, id.TTL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize user service: %w", err)
 	}
 
 	l.Sugar().Infof("✅ [USER-SERVICE] running on (host=%s, port=%d)", cfg.User.Host, cfg.User.Port)
-	return apis.NewUserServiceClient(conn), nil
+	return conn, nil
 }
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+)
+
+func backoffWait(ctx context.Context, baseDelay, attempt int) error {
+	backoff := time.Duration(baseDelay) * time.Millisecond * (1 << attempt)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(backoff):
+		return nil
+	}
+}
+
+func methodName(fullMethod string) string {
+	return strings.ToLower(path.Base(fullMethod))
+}
@@ -0,0 +1,27 @@
+package dtos
+
+import "time"
+
+type Address struct {
+	ID           int64     `json:"id"`
+	Recipient    string    `json:"recipient"`
+	Phone        string    `json:"phone"`
+	Label        string    `json:"label"`
+	Notes        *string   `json:"notes"`
+	IsPrimary    bool      `json:"is_primary"`
+	Country      string    `json:"country"`
+	Subdivision1 *string   `json:"subdivision_1"`
+	Subdivision2 *string   `json:"subdivision_2"`
+	Subdivision3 *string   `json:"subdivision_3"`
+	Subdivision4 *string   `json:"subdivision_4"`
+	Street       string    `json:"street"`
+	Postcode     string    `json:"postcode"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type GetAllAddressesResponse struct {
+	Addresses []Address `json:"addresses"`
+}
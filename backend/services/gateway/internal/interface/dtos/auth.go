@@ -3,17 +3,20 @@ package dtos
 import "time"
 
 type Auth struct {
-	ID         int64     `json:"id"`
-	Email      string    `json:"email"`
-	Role       string    `json:"role"`
-	IsVerified bool      `json:"is_verified"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID                  int64      `json:"id"`
+	Email               string     `json:"email"`
+	Role                string     `json:"role"`
+	IsVerified          bool       `json:"is_verified"`
+	Locale              string     `json:"locale"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
 }
 
 type SignUpRequest struct {
 	Email    string `json:"email" binding:"required"`
 	Password string `json:"password" binding:"required"`
+	Locale   string `json:"locale"`
 }
 
 type SignUpResponse struct {
@@ -31,6 +34,35 @@ type SignInResponse struct {
 	Auth        Auth   `json:"auth"`
 }
 
+type UpdateLocaleRequest struct {
+	Locale string `json:"locale" binding:"required"`
+}
+
+type UpdateLocaleResponse struct {
+	Auth Auth `json:"auth"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+type DeleteAccountResponse struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+type SecureAccountRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type SecureAccountResponse struct {
+	ResetToken string `json:"reset_token"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
 type EmailAvailabilityRequest struct {
 	Email string `form:"email" binding:"required"`
 }
@@ -0,0 +1,33 @@
+package dtos
+
+import "time"
+
+type Delivery struct {
+	ID                int64     `json:"id"`
+	EventID           string    `json:"event_id"`
+	Channel           string    `json:"channel"`
+	Template          string    `json:"template"`
+	Recipient         string    `json:"recipient"`
+	ProviderMessageID *string   `json:"provider_message_id"`
+	Status            string    `json:"status"`
+	Attempts          int32     `json:"attempts"`
+	Error             *string   `json:"error"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type ListDeliveriesResponse struct {
+	Deliveries []Delivery `json:"deliveries"`
+}
+
+// BounceType is required for bounces, either "hard" or "soft"
+type DeliveryEventRequest struct {
+	Type       string `json:"type" binding:"required"`
+	MessageID  string `json:"message_id" binding:"required"`
+	BounceType string `json:"bounce_type"`
+	Reason     string `json:"reason"`
+}
+
+type DeliveryEventResponse struct {
+	Delivery Delivery `json:"delivery"`
+}
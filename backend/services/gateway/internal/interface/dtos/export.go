@@ -0,0 +1,20 @@
+package dtos
+
+import "time"
+
+type DataExport struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	DownloadURL *string    `json:"download_url"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+type ExportMyDataResponse struct {
+	DataExport DataExport `json:"data_export"`
+}
+
+type GetDataExportResponse struct {
+	DataExport DataExport `json:"data_export"`
+}
@@ -0,0 +1,41 @@
+package dtos
+
+import "time"
+
+type InboxNotification struct {
+	ID         int64             `json:"id"`
+	Type       string            `json:"type"`
+	Title      string            `json:"title"`
+	Body       string            `json:"body"`
+	URL        *string           `json:"url"`
+	Data       map[string]string `json:"data"`
+	CreatedAt  time.Time         `json:"created_at"`
+	ReadAt     *time.Time        `json:"read_at"`
+	ArchivedAt *time.Time        `json:"archived_at"`
+}
+
+type ListNotificationsResponse struct {
+	Notifications []InboxNotification `json:"notifications"`
+}
+
+type GetUnreadCountResponse struct {
+	Total  int64            `json:"total"`
+	ByType map[string]int64 `json:"by_type"`
+}
+
+type MarkNotificationReadResponse struct {
+	Notification InboxNotification `json:"notification"`
+}
+
+type MarkAllNotificationsReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+type ArchiveNotificationResponse struct {
+	Notification InboxNotification `json:"notification"`
+}
+
+type NotificationEvent struct {
+	Notification InboxNotification `json:"notification"`
+	UnreadCount  int64             `json:"unread_count"`
+}
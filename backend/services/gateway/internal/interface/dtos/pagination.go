@@ -0,0 +1,8 @@
+package dtos
+
+type PageRequest struct {
+	PageSize int    `form:"page_size"`
+	Page     int    `form:"page"`
+	Cursor   string `form:"cursor"`
+	Sort     string `form:"sort"`
+}
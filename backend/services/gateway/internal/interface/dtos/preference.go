@@ -0,0 +1,55 @@
+package dtos
+
+type NotificationPreference struct {
+	Category  string `json:"category"`
+	Channel   string `json:"channel"`
+	Enabled   bool   `json:"enabled"`
+	Mandatory bool   `json:"mandatory"`
+}
+
+type PreferenceUpdate struct {
+	Category string `json:"category" binding:"required"`
+	Channel  string `json:"channel" binding:"required"`
+	Enabled  *bool  `json:"enabled" binding:"required"`
+}
+
+type GetPreferencesResponse struct {
+	Preferences []NotificationPreference `json:"preferences"`
+}
+
+type UpdatePreferencesRequest struct {
+	Preferences []PreferenceUpdate `json:"preferences" binding:"required,dive"`
+}
+
+type UpdatePreferencesResponse struct {
+	Preferences []NotificationPreference `json:"preferences"`
+}
+
+// Quiet hours are "HH:MM" in the time zone, both empty when there are none
+type NotificationSchedule struct {
+	TimeZone        string `json:"time_zone" binding:"required"`
+	QuietHoursStart string `json:"quiet_hours_start"`
+	QuietHoursEnd   string `json:"quiet_hours_end"`
+	Digest          string `json:"digest" binding:"required"`
+}
+
+type GetScheduleResponse struct {
+	Schedule NotificationSchedule `json:"schedule"`
+}
+
+type UpdateScheduleRequest struct {
+	Schedule NotificationSchedule `json:"schedule" binding:"required"`
+}
+
+type UpdateScheduleResponse struct {
+	Schedule NotificationSchedule `json:"schedule"`
+}
+
+type UnsubscribeRequest struct {
+	Token string `form:"token" binding:"required"`
+}
+
+type UnsubscribeResponse struct {
+	Category string `json:"category"`
+	Channel  string `json:"channel"`
+}
@@ -0,0 +1,16 @@
+package dtos
+
+type Region struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id,omitempty"`
+	Level    int    `json:"level"`
+	Name     string `json:"name"`
+}
+
+type ListRegionsRequest struct {
+	ParentID string `form:"parent_id"`
+}
+
+type ListRegionsResponse struct {
+	Regions []Region `json:"regions"`
+}
@@ -6,13 +6,27 @@ type Response[T any] struct {
 	Status  int    `json:"status"`
 	Message string `json:"message"`
 	Data    T      `json:"data,omitempty"`
+	Error   *Error `json:"error,omitempty"`
 	Meta    *Meta  `json:"meta,omitempty"`
 }
 
+type Error struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Fields  []FieldError `json:"fields"`
+}
+
+type FieldError struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
 type Meta struct {
-	RequestID string    `json:"request_id"`
-	Page      *int      `json:"page,omitempty"`
-	PageSize  *int      `json:"page_size,omitempty"`
-	Total     *int      `json:"total,omitempty"`
-	Timestamp time.Time `json:"timestamp"`
+	RequestID  string    `json:"request_id"`
+	Page       *int      `json:"page,omitempty"`
+	PageSize   *int      `json:"page_size,omitempty"`
+	Total      *int      `json:"total,omitempty"`
+	HasMore    *bool     `json:"has_more,omitempty"`
+	NextCursor *string   `json:"next_cursor,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
 }
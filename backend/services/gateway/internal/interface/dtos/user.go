@@ -42,10 +42,39 @@ type UpdateUserResponse struct {
 	User User `json:"user"`
 }
 
-type UpdateProfilePictureRequest struct {
-	ProfilePicture string `json:"profile_picture"`
+type PublicProfile struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Bio            *string    `json:"bio,omitempty"`
+	ProfilePicture *string    `json:"profile_picture,omitempty"`
+	MemberSince    *time.Time `json:"member_since,omitempty"`
+}
+
+type GetPublicProfileResponse struct {
+	Profile PublicProfile `json:"profile"`
+}
+
+type ProfileVisibility struct {
+	Bio            bool `json:"bio"`
+	ProfilePicture bool `json:"profile_picture"`
+	MemberSince    bool `json:"member_since"`
+}
+
+type GetProfileVisibilityResponse struct {
+	Visibility ProfileVisibility `json:"visibility"`
+}
+
+type UpdateProfileVisibilityRequest struct {
+	Bio            *bool `json:"bio"`
+	ProfilePicture *bool `json:"profile_picture"`
+	MemberSince    *bool `json:"member_since"`
+}
+
+type UpdateProfileVisibilityResponse struct {
+	Visibility ProfileVisibility `json:"visibility"`
 }
 
 type UpdateProfilePictureResponse struct {
-	ProfilePicture string `json:"profile_picture"`
+	ProfilePicture string         `json:"profile_picture"`
+	Thumbnails     map[int]string `json:"thumbnails"`
 }
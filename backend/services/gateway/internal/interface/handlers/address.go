@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const addressErrTracer string = "handler.address"
+
+type AddressHandler struct {
+	as apis.UserAddressServiceClient
+}
+
+func NewAddressHandler(as apis.UserAddressServiceClient) *AddressHandler {
+	return &AddressHandler{as: as}
+}
+
+func (h *AddressHandler) GetAllAddresses(ctx *gin.Context) {
+	c, span := otel.Tracer(addressErrTracer).Start(ctx.Request.Context(), "GetAllAddresses")
+	defer span.End()
+
+	var params dtos.PageRequest
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		e := fmt.Errorf("failed to fetch all addresses: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	page, err := utils.ToPageRequest(&params, ctx.QueryMap("filter"))
+	if err != nil {
+		e := fmt.Errorf("failed to fetch all addresses: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch all addresses: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.as.GetAllAddresses(c, &apis.GetAllUserAddressesRequest{AuthId: authID, Page: page})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	addresses := make([]dtos.Address, 0, len(resp.GetAddresses()))
+	for _, a := range resp.GetAddresses() {
+		addresses = append(addresses, h.toAddress(a))
+	}
+
+	utils.SendPageResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetAllAddressesResponse{Addresses: addresses},
+		resp.GetPage(),
+	)
+}
+
+func (h *AddressHandler) toAddress(a *apis.UserAddress) dtos.Address {
+	return dtos.Address{
+		ID:           a.GetId(),
+		Recipient:    a.GetRecipient(),
+		Phone:        a.GetPhone(),
+		Label:        a.GetLabel(),
+		Notes:        utils.UnwrapString(a.GetNotes()),
+		IsPrimary:    a.GetIsPrimary(),
+		Country:      a.GetCountry(),
+		Subdivision1: utils.UnwrapString(a.GetSubdivision_1()),
+		Subdivision2: utils.UnwrapString(a.GetSubdivision_2()),
+		Subdivision3: utils.UnwrapString(a.GetSubdivision_3()),
+		Subdivision4: utils.UnwrapString(a.GetSubdivision_4()),
+		Street:       a.GetStreet(),
+		Postcode:     a.GetPostcode(),
+		Latitude:     a.GetLatitude(),
+		Longitude:    a.GetLongitude(),
+		CreatedAt:    a.GetCreatedAt().AsTime(),
+		UpdatedAt:    a.GetUpdatedAt().AsTime(),
+	}
+}
@@ -39,9 +39,16 @@ func (h *AuthHandler) SignUp(ctx *gin.Context) {
 		return
 	}
 
+	// An explicit locale wins over the browser language
+	locale := payload.Locale
+	if locale == "" {
+		locale = utils.PreferredLocale(ctx.GetHeader("Accept-Language"))
+	}
+
 	req := apis.SignUpRequest{
 		Email:    payload.Email,
 		Password: payload.Password,
+		Locale:   locale,
 	}
 
 	oc := metadata.NewOutgoingContext(c, metadata.Pairs(
@@ -74,6 +81,7 @@ func (h *AuthHandler) SignUp(ctx *gin.Context) {
 				Email:      resp.GetAuth().GetEmail(),
 				Role:       resp.GetAuth().GetRole(),
 				IsVerified: resp.GetAuth().GetIsVerified(),
+				Locale:     resp.GetAuth().GetLocale(),
 				CreatedAt:  resp.GetAuth().GetCreatedAt().AsTime(),
 				UpdatedAt:  resp.GetAuth().GetUpdatedAt().AsTime(),
 			},
@@ -123,12 +131,14 @@ func (h *AuthHandler) SignIn(ctx *gin.Context) {
 		dtos.SignInResponse{
 			AccessToken: resp.GetToken().GetAccess(),
 			Auth: dtos.Auth{
-				ID:         resp.GetAuth().GetId(),
-				Email:      resp.GetAuth().GetEmail(),
-				Role:       resp.GetAuth().GetRole(),
-				IsVerified: resp.GetAuth().GetIsVerified(),
-				CreatedAt:  resp.GetAuth().GetCreatedAt().AsTime(),
-				UpdatedAt:  resp.GetAuth().GetUpdatedAt().AsTime(),
+				ID:                  resp.GetAuth().GetId(),
+				Email:               resp.GetAuth().GetEmail(),
+				Role:                resp.GetAuth().GetRole(),
+				IsVerified:          resp.GetAuth().GetIsVerified(),
+				Locale:              resp.GetAuth().GetLocale(),
+				DeletionScheduledAt: utils.UnwrapTimestamp(resp.GetAuth().GetDeletionScheduledAt()),
+				CreatedAt:           resp.GetAuth().GetCreatedAt().AsTime(),
+				UpdatedAt:           resp.GetAuth().GetUpdatedAt().AsTime(),
 			},
 		},
 	)
@@ -165,6 +175,108 @@ func (h *AuthHandler) SignOut(ctx *gin.Context) {
 	utils.SendResponse[any](ctx, http.StatusNoContent, "", nil)
 }
 
+func (h *AuthHandler) UpdateLocale(ctx *gin.Context) {
+	c, span := otel.Tracer(authErrTracer).Start(ctx.Request.Context(), "UpdateLocale")
+	defer span.End()
+
+	var payload dtos.UpdateLocaleRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to update locale: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to update locale: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.as.UpdateLocale(c, &apis.UpdateLocaleRequest{AuthId: authID, Locale: payload.Locale})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Locale updated successfully",
+		dtos.UpdateLocaleResponse{
+			Auth: dtos.Auth{
+				ID:                  resp.GetAuth().GetId(),
+				Email:               resp.GetAuth().GetEmail(),
+				Role:                resp.GetAuth().GetRole(),
+				IsVerified:          resp.GetAuth().GetIsVerified(),
+				Locale:              resp.GetAuth().GetLocale(),
+				DeletionScheduledAt: utils.UnwrapTimestamp(resp.GetAuth().GetDeletionScheduledAt()),
+				CreatedAt:           resp.GetAuth().GetCreatedAt().AsTime(),
+				UpdatedAt:           resp.GetAuth().GetUpdatedAt().AsTime(),
+			},
+		},
+	)
+}
+
+func (h *AuthHandler) DeleteAccount(ctx *gin.Context) {
+	c, span := otel.Tracer(authErrTracer).Start(ctx.Request.Context(), "DeleteAccount")
+	defer span.End()
+
+	var payload dtos.DeleteAccountRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to delete account: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to delete account: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.DeleteAccountRequest{
+		AuthId:   authID,
+		Password: payload.Password,
+	}
+
+	resp, err := h.as.DeleteAccount(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusAccepted,
+		"Account scheduled for deletion",
+		dtos.DeleteAccountResponse{
+			ScheduledAt: resp.GetScheduledAt().AsTime(),
+		},
+	)
+}
+
+func (h *AuthHandler) CancelAccountDeletion(ctx *gin.Context) {
+	c, span := otel.Tracer(authErrTracer).Start(ctx.Request.Context(), "CancelAccountDeletion")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to cancel account deletion: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	_, err = h.as.CancelAccountDeletion(c, &apis.CancelAccountDeletionRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse[any](ctx, http.StatusNoContent, "", nil)
+}
+
 func (h *AuthHandler) IsEmailAvailable(ctx *gin.Context) {
 	c, span := otel.Tracer(authErrTracer).Start(ctx.Request.Context(), "IsEmailAvailable")
 	defer span.End()
@@ -189,3 +301,53 @@ func (h *AuthHandler) IsEmailAvailable(ctx *gin.Context) {
 		},
 	)
 }
+
+func (h *AuthHandler) SecureAccount(ctx *gin.Context) {
+	c, span := otel.Tracer(authErrTracer).Start(ctx.Request.Context(), "SecureAccount")
+	defer span.End()
+
+	var payload dtos.SecureAccountRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to secure account: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	resp, err := h.as.SecureAccount(c, &apis.SecureAccountRequest{Token: payload.Token})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Account secured, choose a new password to sign in again",
+		dtos.SecureAccountResponse{ResetToken: resp.GetResetToken()},
+	)
+}
+
+func (h *AuthHandler) ResetPassword(ctx *gin.Context) {
+	c, span := otel.Tracer(authErrTracer).Start(ctx.Request.Context(), "ResetPassword")
+	defer span.End()
+
+	var payload dtos.ResetPasswordRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to reset password: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	req := apis.ResetPasswordRequest{
+		Token:    payload.Token,
+		Password: payload.Password,
+	}
+
+	_, err := h.as.ResetPassword(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse[any](ctx, http.StatusNoContent, "", nil)
+}
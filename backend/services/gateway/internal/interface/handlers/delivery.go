@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const deliveryErrTracer string = "handler.delivery"
+
+type DeliveryHandler struct {
+	ds apis.NotificationDeliveryServiceClient
+}
+
+func NewDeliveryHandler(ds apis.NotificationDeliveryServiceClient) *DeliveryHandler {
+	return &DeliveryHandler{ds: ds}
+}
+
+func (h *DeliveryHandler) ListDeliveries(ctx *gin.Context) {
+	c, span := otel.Tracer(deliveryErrTracer).Start(ctx.Request.Context(), "ListDeliveries")
+	defer span.End()
+
+	authID, err := strconv.ParseInt(ctx.Param("auth_id"), 10, 64)
+	if err != nil {
+		e := fmt.Errorf("failed to list deliveries: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	var params dtos.PageRequest
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		e := fmt.Errorf("failed to list deliveries: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	page, err := utils.ToPageRequest(&params, ctx.QueryMap("filter"))
+	if err != nil {
+		e := fmt.Errorf("failed to list deliveries: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	resp, err := h.ds.ListDeliveries(c, &apis.ListDeliveriesRequest{AuthId: authID, Page: page})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	deliveries := make([]dtos.Delivery, 0, len(resp.GetDeliveries()))
+	for _, d := range resp.GetDeliveries() {
+		deliveries = append(deliveries, h.toDelivery(d))
+	}
+
+	utils.SendPageResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.ListDeliveriesResponse{Deliveries: deliveries},
+		resp.GetPage(),
+	)
+}
+
+// ReportDeliveryEvent receives provider bounce and complaint callbacks, the webhook signature stands in for authentication
+func (h *DeliveryHandler) ReportDeliveryEvent(ctx *gin.Context) {
+	c, span := otel.Tracer(deliveryErrTracer).Start(ctx.Request.Context(), "ReportDeliveryEvent")
+	defer span.End()
+
+	var payload dtos.DeliveryEventRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to report delivery event: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	req := apis.ReportDeliveryEventRequest{
+		ProviderMessageId: payload.MessageID,
+		Type:              payload.Type,
+		BounceType:        payload.BounceType,
+		Reason:            payload.Reason,
+	}
+
+	resp, err := h.ds.ReportDeliveryEvent(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Delivery event recorded",
+		dtos.DeliveryEventResponse{Delivery: h.toDelivery(resp.GetDelivery())},
+	)
+}
+
+func (h *DeliveryHandler) toDelivery(d *apis.Delivery) dtos.Delivery {
+	return dtos.Delivery{
+		ID:                d.GetId(),
+		EventID:           d.GetEventId(),
+		Channel:           d.GetChannel(),
+		Template:          d.GetTemplate(),
+		Recipient:         d.GetRecipient(),
+		ProviderMessageID: utils.UnwrapString(d.GetProviderMessageId()),
+		Status:            d.GetStatus(),
+		Attempts:          d.GetAttempts(),
+		Error:             utils.UnwrapString(d.GetError()),
+		CreatedAt:         d.GetCreatedAt().AsTime(),
+		UpdatedAt:         d.GetUpdatedAt().AsTime(),
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const exportErrTracer string = "handler.export"
+
+type ExportHandler struct {
+	es apis.UserExportServiceClient
+}
+
+func NewExportHandler(es apis.UserExportServiceClient) *ExportHandler {
+	return &ExportHandler{es: es}
+}
+
+func (h *ExportHandler) ExportMyData(ctx *gin.Context) {
+	c, span := otel.Tracer(exportErrTracer).Start(ctx.Request.Context(), "ExportMyData")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to export data: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.es.ExportMyData(c, &apis.ExportMyDataRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusAccepted,
+		"Data export started",
+		dtos.ExportMyDataResponse{DataExport: h.toDataExport(resp.GetDataExport())},
+	)
+}
+
+func (h *ExportHandler) GetDataExport(ctx *gin.Context) {
+	c, span := otel.Tracer(exportErrTracer).Start(ctx.Request.Context(), "GetDataExport")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch data export: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.GetDataExportRequest{
+		AuthId:   authID,
+		ExportId: ctx.Param("export_id"),
+	}
+
+	resp, err := h.es.GetDataExport(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetDataExportResponse{DataExport: h.toDataExport(resp.GetDataExport())},
+	)
+}
+
+func (h *ExportHandler) toDataExport(e *apis.DataExport) dtos.DataExport {
+	return dtos.DataExport{
+		ID:          e.GetId(),
+		Status:      e.GetStatus(),
+		DownloadURL: utils.UnwrapString(e.GetDownloadUrl()),
+		ExpiresAt:   utils.UnwrapTimestamp(e.GetExpiresAt()),
+		CreatedAt:   e.GetCreatedAt().AsTime(),
+		CompletedAt: utils.UnwrapTimestamp(e.GetCompletedAt()),
+	}
+}
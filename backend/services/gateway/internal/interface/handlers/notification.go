@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/broadcast"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"go.opentelemetry.io/otel"
+)
+
+const notificationErrTracer string = "handler.notification"
+
+type NotificationHandler struct {
+	is        apis.NotificationInboxServiceClient
+	hub       *broadcast.Hub
+	heartbeat time.Duration
+}
+
+func NewNotificationHandler(is apis.NotificationInboxServiceClient, hub *broadcast.Hub, heartbeat time.Duration) *NotificationHandler {
+	return &NotificationHandler{is: is, hub: hub, heartbeat: heartbeat}
+}
+
+func (h *NotificationHandler) ListNotifications(ctx *gin.Context) {
+	c, span := otel.Tracer(notificationErrTracer).Start(ctx.Request.Context(), "ListNotifications")
+	defer span.End()
+
+	var params dtos.PageRequest
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		e := fmt.Errorf("failed to list notifications: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	page, err := utils.ToPageRequest(&params, ctx.QueryMap("filter"))
+	if err != nil {
+		e := fmt.Errorf("failed to list notifications: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to list notifications: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.is.ListInbox(c, &apis.ListInboxRequest{AuthId: authID, Page: page})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	notifications := make([]dtos.InboxNotification, 0, len(resp.GetNotifications()))
+	for _, n := range resp.GetNotifications() {
+		notifications = append(notifications, h.toInboxNotification(n))
+	}
+
+	utils.SendPageResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.ListNotificationsResponse{Notifications: notifications},
+		resp.GetPage(),
+	)
+}
+
+func (h *NotificationHandler) GetUnreadCount(ctx *gin.Context) {
+	c, span := otel.Tracer(notificationErrTracer).Start(ctx.Request.Context(), "GetUnreadCount")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch unread count: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.is.GetUnreadCount(c, &apis.GetUnreadCountRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetUnreadCountResponse{Total: resp.GetTotal(), ByType: resp.GetByType()},
+	)
+}
+
+func (h *NotificationHandler) MarkNotificationRead(ctx *gin.Context) {
+	c, span := otel.Tracer(notificationErrTracer).Start(ctx.Request.Context(), "MarkNotificationRead")
+	defer span.End()
+
+	notificationID, err := strconv.ParseInt(ctx.Param("notification_id"), 10, 64)
+	if err != nil {
+		e := fmt.Errorf("failed to mark notification read: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to mark notification read: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.MarkNotificationReadRequest{
+		AuthId:         authID,
+		NotificationId: notificationID,
+	}
+
+	resp, err := h.is.MarkNotificationRead(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Notification marked as read",
+		dtos.MarkNotificationReadResponse{Notification: h.toInboxNotification(resp.GetNotification())},
+	)
+}
+
+func (h *NotificationHandler) MarkAllNotificationsRead(ctx *gin.Context) {
+	c, span := otel.Tracer(notificationErrTracer).Start(ctx.Request.Context(), "MarkAllNotificationsRead")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to mark all notifications read: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.is.MarkAllNotificationsRead(c, &apis.MarkAllNotificationsReadRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"All notifications marked as read",
+		dtos.MarkAllNotificationsReadResponse{Updated: resp.GetUpdated()},
+	)
+}
+
+func (h *NotificationHandler) ArchiveNotification(ctx *gin.Context) {
+	c, span := otel.Tracer(notificationErrTracer).Start(ctx.Request.Context(), "ArchiveNotification")
+	defer span.End()
+
+	notificationID, err := strconv.ParseInt(ctx.Param("notification_id"), 10, 64)
+	if err != nil {
+		e := fmt.Errorf("failed to archive notification: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to archive notification: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.ArchiveNotificationRequest{
+		AuthId:         authID,
+		NotificationId: notificationID,
+	}
+
+	resp, err := h.is.ArchiveNotification(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Notification archived",
+		dtos.ArchiveNotificationResponse{Notification: h.toInboxNotification(resp.GetNotification())},
+	)
+}
+
+// StreamNotifications pushes every new inbox notification of the caller as a Server-Sent Event
+func (h *NotificationHandler) StreamNotifications(ctx *gin.Context) {
+	c, span := otel.Tracer(notificationErrTracer).Start(ctx.Request.Context(), "StreamNotifications")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to stream notifications: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	// The stream outlives the write timeout of the server
+	if err := http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		e := fmt.Errorf("failed to stream notifications: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInternal, ce.MsgInternalServer, e))
+		return
+	}
+
+	stream, unsubscribe := h.hub.Subscribe(authID)
+	defer unsubscribe()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Done():
+			return false
+		case evt, ok := <-stream:
+			if !ok {
+				return false
+			}
+
+			ctx.SSEvent("notification", h.toNotificationEvent(evt))
+			return true
+		case <-heartbeat.C:
+			// Comment lines keep proxies from closing an idle connection and are ignored by clients
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			return err == nil
+		}
+	})
+}
+
+func (h *NotificationHandler) toInboxNotification(n *apis.InboxNotification) dtos.InboxNotification {
+	return dtos.InboxNotification{
+		ID:         n.GetId(),
+		Type:       n.GetType(),
+		Title:      n.GetTitle(),
+		Body:       n.GetBody(),
+		URL:        utils.UnwrapString(n.GetUrl()),
+		Data:       n.GetData(),
+		CreatedAt:  n.GetCreatedAt().AsTime(),
+		ReadAt:     utils.UnwrapTimestamp(n.GetReadAt()),
+		ArchivedAt: utils.UnwrapTimestamp(n.GetArchivedAt()),
+	}
+}
+
+func (h *NotificationHandler) toNotificationEvent(evt *events.InboxNotificationCreated) dtos.NotificationEvent {
+	n := dtos.InboxNotification{
+		ID:        evt.GetNotificationId(),
+		Type:      evt.GetType(),
+		Title:     evt.GetTitle(),
+		Body:      evt.GetBody(),
+		Data:      evt.GetData(),
+		CreatedAt: evt.GetCreatedAt().AsTime(),
+	}
+	if evt.GetUrl() != "" {
+		url := evt.GetUrl()
+		n.URL = &url
+	}
+
+	return dtos.NotificationEvent{Notification: n, UnreadCount: evt.GetUnreadCount()}
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const preferenceErrTracer string = "handler.preference"
+
+type PreferenceHandler struct {
+	ps apis.NotificationPreferenceServiceClient
+}
+
+func NewPreferenceHandler(ps apis.NotificationPreferenceServiceClient) *PreferenceHandler {
+	return &PreferenceHandler{ps: ps}
+}
+
+func (h *PreferenceHandler) GetPreferences(ctx *gin.Context) {
+	c, span := otel.Tracer(preferenceErrTracer).Start(ctx.Request.Context(), "GetPreferences")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch preferences: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.ps.GetPreferences(c, &apis.GetPreferencesRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetPreferencesResponse{Preferences: h.toPreferences(resp.GetPreferences())},
+	)
+}
+
+func (h *PreferenceHandler) UpdatePreferences(ctx *gin.Context) {
+	c, span := otel.Tracer(preferenceErrTracer).Start(ctx.Request.Context(), "UpdatePreferences")
+	defer span.End()
+
+	var payload dtos.UpdatePreferencesRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to update preferences: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to update preferences: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.UpdatePreferencesRequest{
+		AuthId:      authID,
+		Preferences: make([]*apis.PreferenceUpdate, 0, len(payload.Preferences)),
+	}
+	for _, p := range payload.Preferences {
+		req.Preferences = append(req.Preferences, &apis.PreferenceUpdate{
+			Category: p.Category,
+			Channel:  p.Channel,
+			Enabled:  *p.Enabled,
+		})
+	}
+
+	resp, err := h.ps.UpdatePreferences(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Preferences updated successfully",
+		dtos.UpdatePreferencesResponse{Preferences: h.toPreferences(resp.GetPreferences())},
+	)
+}
+
+func (h *PreferenceHandler) GetSchedule(ctx *gin.Context) {
+	c, span := otel.Tracer(preferenceErrTracer).Start(ctx.Request.Context(), "GetSchedule")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch schedule: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.ps.GetSchedule(c, &apis.GetScheduleRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetScheduleResponse{Schedule: h.toSchedule(resp.GetSchedule())},
+	)
+}
+
+func (h *PreferenceHandler) UpdateSchedule(ctx *gin.Context) {
+	c, span := otel.Tracer(preferenceErrTracer).Start(ctx.Request.Context(), "UpdateSchedule")
+	defer span.End()
+
+	var payload dtos.UpdateScheduleRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to update schedule: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to update schedule: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.UpdateScheduleRequest{
+		AuthId: authID,
+		Schedule: &apis.NotificationSchedule{
+			TimeZone:        payload.Schedule.TimeZone,
+			QuietHoursStart: payload.Schedule.QuietHoursStart,
+			QuietHoursEnd:   payload.Schedule.QuietHoursEnd,
+			Digest:          payload.Schedule.Digest,
+		},
+	}
+
+	resp, err := h.ps.UpdateSchedule(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Schedule updated successfully",
+		dtos.UpdateScheduleResponse{Schedule: h.toSchedule(resp.GetSchedule())},
+	)
+}
+
+// Unsubscribe serves RFC 8058 one-click requests, the signed token stands in for authentication
+func (h *PreferenceHandler) Unsubscribe(ctx *gin.Context) {
+	c, span := otel.Tracer(preferenceErrTracer).Start(ctx.Request.Context(), "Unsubscribe")
+	defer span.End()
+
+	var params dtos.UnsubscribeRequest
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		e := fmt.Errorf("failed to unsubscribe: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	resp, err := h.ps.Unsubscribe(c, &apis.UnsubscribeRequest{Token: params.Token})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Unsubscribed successfully",
+		dtos.UnsubscribeResponse{Category: resp.GetCategory(), Channel: resp.GetChannel()},
+	)
+}
+
+func (h *PreferenceHandler) toSchedule(s *apis.NotificationSchedule) dtos.NotificationSchedule {
+	return dtos.NotificationSchedule{
+		TimeZone:        s.GetTimeZone(),
+		QuietHoursStart: s.GetQuietHoursStart(),
+		QuietHoursEnd:   s.GetQuietHoursEnd(),
+		Digest:          s.GetDigest(),
+	}
+}
+
+func (h *PreferenceHandler) toPreferences(preferences []*apis.NotificationPreference) []dtos.NotificationPreference {
+	result := make([]dtos.NotificationPreference, 0, len(preferences))
+	for _, p := range preferences {
+		result = append(result, dtos.NotificationPreference{
+			Category:  p.GetCategory(),
+			Channel:   p.GetChannel(),
+			Enabled:   p.GetEnabled(),
+			Mandatory: p.GetMandatory(),
+		})
+	}
+	return result
+}
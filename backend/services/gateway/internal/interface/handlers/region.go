@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const regionErrTracer string = "handler.region"
+
+type RegionHandler struct {
+	rs apis.UserRegionServiceClient
+}
+
+func NewRegionHandler(rs apis.UserRegionServiceClient) *RegionHandler {
+	return &RegionHandler{rs: rs}
+}
+
+func (h *RegionHandler) ListRegions(ctx *gin.Context) {
+	c, span := otel.Tracer(regionErrTracer).Start(ctx.Request.Context(), "ListRegions")
+	defer span.End()
+
+	var params dtos.ListRegionsRequest
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		e := fmt.Errorf("failed to list regions: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidParams, ce.MsgInvalidParams, e))
+		return
+	}
+
+	resp, err := h.rs.ListRegions(c, &apis.ListRegionsRequest{ParentId: params.ParentID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	regions := make([]dtos.Region, 0, len(resp.GetRegions()))
+	for _, r := range resp.GetRegions() {
+		regions = append(regions, *h.toRegion(r))
+	}
+
+	utils.SendResponse(ctx, http.StatusOK, "OK", dtos.ListRegionsResponse{Regions: regions})
+}
+
+func (h *RegionHandler) toRegion(r *apis.Region) *dtos.Region {
+	if r == nil {
+		return nil
+	}
+
+	return &dtos.Region{
+		ID:       r.GetId(),
+		ParentID: r.GetParentId(),
+		Level:    int(r.GetLevel()),
+		Name:     r.GetName(),
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const staticErrTracer string = "handler.static"
+
+type StaticHandler struct {
+	dir        string
+	signingKey string
+}
+
+func NewStaticHandler(dir, signingKey string) *StaticHandler {
+	return &StaticHandler{dir: dir, signingKey: signingKey}
+}
+
+// ServeObject serves objects written by local storage. Keys are cleaned before anything else so that the
+// signature of guarded objects is checked against the same key that is opened.
+func (h *StaticHandler) ServeObject(ctx *gin.Context) {
+	_, span := otel.Tracer(staticErrTracer).Start(ctx.Request.Context(), "ServeObject")
+	defer span.End()
+
+	key := strings.TrimPrefix(path.Clean("/"+ctx.Param("filepath")), "/")
+	if key == "" || !filepath.IsLocal(filepath.FromSlash(key)) {
+		e := fmt.Errorf("failed to serve object: invalid key %q", ctx.Param("filepath"))
+		ctx.Error(ce.NewError(span, ce.CodeNotFound, ce.MsgNotFound, e))
+		return
+	}
+
+	if strings.HasPrefix(key, constants.StaticPrefixExports) {
+		if err := h.verifySignature(key, ctx.Query("expires"), ctx.Query("signature")); err != nil {
+			e := fmt.Errorf("failed to serve object: %w", err)
+			ctx.Error(ce.NewError(span, ce.CodeUnauthorized, ce.MsgUnauthorized, e))
+			return
+		}
+	}
+
+	f, err := os.Open(filepath.Join(h.dir, filepath.FromSlash(key)))
+	if err != nil {
+		e := fmt.Errorf("failed to serve object: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeNotFound, ce.MsgNotFound, e))
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		e := fmt.Errorf("failed to serve object: %s is not a file", key)
+		ctx.Error(ce.NewError(span, ce.CodeNotFound, ce.MsgNotFound, e))
+		return
+	}
+
+	http.ServeContent(ctx.Writer, ctx.Request, info.Name(), info.ModTime(), f)
+}
+
+func (h *StaticHandler) verifySignature(key, expires, signature string) error {
+	if h.signingKey == "" {
+		return errors.New("signing key is not configured")
+	}
+
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return errors.New("invalid expiry")
+	}
+	if time.Now().UTC().Unix() > exp {
+		return errors.New("link expired")
+	}
+
+	given, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.New("invalid signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.signingKey))
+	mac.Write([]byte(key + "\n" + expires))
+	if !hmac.Equal(mac.Sum(nil), given) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
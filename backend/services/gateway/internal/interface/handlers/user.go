@@ -1,10 +1,13 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
@@ -15,11 +18,12 @@ import (
 const userErrTracer string = "handler.user"
 
 type UserHandler struct {
-	us apis.UserServiceClient
+	us            apis.UserServiceClient
+	maxUploadSize int64
 }
 
-func NewUserHandler(us apis.UserServiceClient) *UserHandler {
-	return &UserHandler{us: us}
+func NewUserHandler(us apis.UserServiceClient, maxUploadSize int64) *UserHandler {
+	return &UserHandler{us: us, maxUploadSize: maxUploadSize}
 }
 
 func (h *UserHandler) UpsertUser(ctx *gin.Context) {
@@ -169,12 +173,51 @@ func (h *UserHandler) UpdateProfilePicture(ctx *gin.Context) {
 	c, span := otel.Tracer(userErrTracer).Start(ctx.Request.Context(), "UpdateProfilePicture")
 	defer span.End()
 
-	var payload dtos.UpdateProfilePictureRequest
-	if err := ctx.ShouldBindJSON(&payload); err != nil {
+	// Leaves room for the multipart envelope around the image itself
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, h.maxUploadSize+(64<<10))
+
+	fh, err := ctx.FormFile(constants.UploadFieldImage)
+	if err != nil {
 		e := fmt.Errorf("failed to update profile picture: %w", err)
+
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			ctx.Error(ce.NewError(span, ce.CodePayloadTooLarge, ce.MsgPayloadTooLarge, e))
+			return
+		}
+
 		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
 		return
 	}
+	if fh.Size > h.maxUploadSize {
+		e := fmt.Errorf("failed to update profile picture: image size %d exceeds %d", fh.Size, h.maxUploadSize)
+		ctx.Error(ce.NewError(span, ce.CodePayloadTooLarge, ce.MsgPayloadTooLarge, e))
+		return
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		e := fmt.Errorf("failed to update profile picture: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+	defer f.Close()
+
+	image, err := io.ReadAll(io.LimitReader(f, h.maxUploadSize))
+	if err != nil {
+		e := fmt.Errorf("failed to update profile picture: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	// The declared content type is ignored, only the sniffed one counts
+	switch t := http.DetectContentType(image); t {
+	case constants.MIMETypeGIF, constants.MIMETypeJPEG, constants.MIMETypePNG, constants.MIMETypeWebP:
+	default:
+		e := fmt.Errorf("failed to update profile picture: unsupported content type %s", t)
+		ctx.Error(ce.NewError(span, ce.CodeUnsupportedMedia, ce.MsgUnsupportedMedia, e))
+		return
+	}
 
 	authID, err := utils.CtxAuthID(c)
 	if err != nil {
@@ -184,8 +227,8 @@ func (h *UserHandler) UpdateProfilePicture(ctx *gin.Context) {
 	}
 
 	req := apis.UpdateProfilePictureRequest{
-		AuthId:         authID,
-		ProfilePicture: payload.ProfilePicture,
+		AuthId: authID,
+		Image:  image,
 	}
 
 	resp, err := h.us.UpdateProfilePicture(c, &req)
@@ -194,12 +237,120 @@ func (h *UserHandler) UpdateProfilePicture(ctx *gin.Context) {
 		return
 	}
 
+	thumbnails := make(map[int]string, len(resp.GetThumbnails()))
+	for size, url := range resp.GetThumbnails() {
+		thumbnails[int(size)] = url
+	}
+
 	utils.SendResponse(
 		ctx,
 		http.StatusOK,
 		"Profile picture updated successfully",
 		dtos.UpdateProfilePictureResponse{
 			ProfilePicture: resp.GetProfilePicture(),
+			Thumbnails:     thumbnails,
+		},
+	)
+}
+
+func (h *UserHandler) GetPublicProfile(ctx *gin.Context) {
+	c, span := otel.Tracer(userErrTracer).Start(ctx.Request.Context(), "GetPublicProfile")
+	defer span.End()
+
+	resp, err := h.us.GetPublicProfile(c, &apis.GetPublicProfileRequest{UserId: ctx.Param("user_id")})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetPublicProfileResponse{
+			Profile: dtos.PublicProfile{
+				ID:             resp.GetProfile().GetId(),
+				Name:           resp.GetProfile().GetName(),
+				Bio:            utils.UnwrapString(resp.GetProfile().GetBio()),
+				ProfilePicture: utils.UnwrapString(resp.GetProfile().GetProfilePicture()),
+				MemberSince:    utils.UnwrapTimestamp(resp.GetProfile().GetMemberSince()),
+			},
+		},
+	)
+}
+
+func (h *UserHandler) GetProfileVisibility(ctx *gin.Context) {
+	c, span := otel.Tracer(userErrTracer).Start(ctx.Request.Context(), "GetProfileVisibility")
+	defer span.End()
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch profile visibility: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	resp, err := h.us.GetProfileVisibility(c, &apis.GetProfileVisibilityRequest{AuthId: authID})
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"OK",
+		dtos.GetProfileVisibilityResponse{
+			Visibility: dtos.ProfileVisibility{
+				Bio:            resp.GetVisibility().GetBio(),
+				ProfilePicture: resp.GetVisibility().GetProfilePicture(),
+				MemberSince:    resp.GetVisibility().GetMemberSince(),
+			},
+		},
+	)
+}
+
+func (h *UserHandler) UpdateProfileVisibility(ctx *gin.Context) {
+	c, span := otel.Tracer(userErrTracer).Start(ctx.Request.Context(), "UpdateProfileVisibility")
+	defer span.End()
+
+	var payload dtos.UpdateProfileVisibilityRequest
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		e := fmt.Errorf("failed to update profile visibility: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+		return
+	}
+
+	authID, err := utils.CtxAuthID(c)
+	if err != nil {
+		e := fmt.Errorf("failed to update profile visibility: %w", err)
+		ctx.Error(ce.NewError(span, ce.CodeCtxValueNotFound, ce.MsgInternalServer, e))
+		return
+	}
+
+	req := apis.UpdateProfileVisibilityRequest{
+		AuthId:         authID,
+		Bio:            utils.WrapBool(payload.Bio),
+		ProfilePicture: utils.WrapBool(payload.ProfilePicture),
+		MemberSince:    utils.WrapBool(payload.MemberSince),
+	}
+
+	resp, err := h.us.UpdateProfileVisibility(c, &req)
+	if err != nil {
+		ctx.Error(ce.FromGRPCErr(span, err))
+		return
+	}
+
+	utils.SendResponse(
+		ctx,
+		http.StatusOK,
+		"Profile visibility updated successfully",
+		dtos.UpdateProfileVisibilityResponse{
+			Visibility: dtos.ProfileVisibility{
+				Bio:            resp.GetVisibility().GetBio(),
+				ProfilePicture: resp.GetVisibility().GetProfilePicture(),
+				MemberSince:    resp.GetVisibility().GetMemberSince(),
+			},
 		},
 	)
 }
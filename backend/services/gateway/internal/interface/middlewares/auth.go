@@ -11,6 +11,7 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/identity"
 	"go.opentelemetry.io/otel"
 )
 
@@ -60,6 +61,7 @@ func Authenticate(secret string) gin.HandlerFunc {
 		c = context.WithValue(c, constants.CtxKeyAuthID, claim.AuthID)
 		c = context.WithValue(c, constants.CtxKeyRole, claim.Role)
 		c = context.WithValue(c, constants.CtxKeyIsVerified, claim.IsVerified)
+		c = identity.NewContext(c, identity.Identity{AuthID: claim.AuthID, Role: claim.Role})
 
 		ctx.Request = ctx.Request.WithContext(c)
 		ctx.Next()
@@ -2,7 +2,6 @@ package middlewares
 
 import (
 	"errors"
-	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -41,29 +40,29 @@ func Logger(l *logger.Logger) gin.HandlerFunc {
 		}
 
 		var e *ce.Error
-		if errors.As(errs[0].Err, &e) {
-			fields = append(
-				fields,
-				zap.Int("status", e.ToHTTPStatus()),
-				zap.String("error_code", string(e.Code)),
-				zap.String("error_message", e.Message),
-				zap.String("error_detail", e.Error()),
-			)
-
-			l.Base().Error("REQUEST_FAILED", fields...)
-			utils.SendResponse[any](ctx, e.ToHTTPStatus(), e.Message, nil)
-			return
+		if !errors.As(errs[0].Err, &e) {
+			e = ce.NewError(nil, ce.CodeUnknown, ce.MsgInternalServer, errs[0].Err)
+		}
+		if len(e.Fields) == 0 && e.Code == ce.CodeInvalidPayload {
+			e.Fields = utils.FieldViolations(e.Err)
 		}
 
 		fields = append(
 			fields,
-			zap.Int("status", http.StatusInternalServerError),
-			zap.String("error_code", string(ce.CodeUnknown)),
-			zap.String("error_message", ce.MsgInternalServer),
-			zap.String("error_detail", errs[0].Err.Error()),
+			zap.Int("status", e.ToHTTPStatus()),
+			zap.String("error_code", string(e.Code)),
+			zap.String("error_message", e.Message),
+			zap.String("error_detail", e.Error()),
 		)
+		if len(e.Fields) > 0 {
+			violations := make([]string, 0, len(e.Fields))
+			for _, f := range e.Fields {
+				violations = append(violations, f.Error())
+			}
+			fields = append(fields, zap.Strings("error_fields", violations))
+		}
 
 		l.Base().Error("REQUEST_FAILED", fields...)
-		utils.SendResponse[any](ctx, http.StatusInternalServerError, ce.MsgInternalServer, nil)
+		utils.SendError(ctx, e)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/interceptors"
 )
 
 func NewRequestID() gin.HandlerFunc {
@@ -17,9 +18,9 @@ func NewRequestID() gin.HandlerFunc {
 		}
 
 		ctx.Writer.Header().Set("X-Request-ID", requestID)
-		ctx.Request = ctx.Request.WithContext(
-			context.WithValue(ctx.Request.Context(), constants.CtxKeyRequestID, requestID),
-		)
+		// Also kept where the gRPC clients forward it from, so the services log the same request ID
+		c := context.WithValue(ctx.Request.Context(), constants.CtxKeyRequestID, requestID)
+		ctx.Request = ctx.Request.WithContext(interceptors.WithRequestID(c, requestID))
 
 		ctx.Next()
 	}
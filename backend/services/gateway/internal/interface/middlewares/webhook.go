@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const webhookErrTracer string = "middleware.webhook"
+
+// SignedWebhook requires the X-Webhook-Signature header to carry the hex HMAC-SHA256 of the raw request body
+func SignedWebhook(secret string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		_, span := otel.Tracer(webhookErrTracer).Start(ctx.Request.Context(), "SignedWebhook")
+		defer span.End()
+
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			e := fmt.Errorf("failed to verify webhook: %w", err)
+			ctx.Error(ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, e))
+			ctx.Abort()
+			return
+		}
+
+		if err := verifyWebhookSignature(secret, body, ctx.GetHeader("X-Webhook-Signature")); err != nil {
+			e := fmt.Errorf("failed to verify webhook: %w", err)
+			ctx.Error(ce.NewError(span, ce.CodeUnauthorized, ce.MsgUnauthorized, e))
+			ctx.Abort()
+			return
+		}
+
+		// The handler binds the body again
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		ctx.Next()
+	}
+}
+
+func verifyWebhookSignature(secret string, body []byte, signature string) error {
+	if secret == "" {
+		return errors.New("signing key is not configured")
+	}
+
+	given, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.New("invalid signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), given) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/handlers"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/middlewares"
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/utils"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
@@ -15,7 +18,23 @@ type Router struct {
 	router *gin.Engine
 }
 
-func Init(l *logger.Logger, appName, jwtSecret string, ah *handlers.AuthHandler, uh *handlers.UserHandler) *Router {
+func Init(
+	l *logger.Logger,
+	appName, jwtSecret, webhookSigningKey string,
+	ah *handlers.AuthHandler,
+	uh *handlers.UserHandler,
+	rh *handlers.RegionHandler,
+	eh *handlers.ExportHandler,
+	adh *handlers.AddressHandler,
+	nh *handlers.NotificationHandler,
+	ph *handlers.PreferenceHandler,
+	dh *handlers.DeliveryHandler,
+	sh *handlers.StaticHandler,
+) *Router {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(utils.FieldName)
+	}
+
 	r := gin.New()
 	r.Use(otelgin.Middleware(appName))
 	r.Use(gin.Recovery())
@@ -30,6 +49,12 @@ func Init(l *logger.Logger, appName, jwtSecret string, ah *handlers.AuthHandler,
 		})
 	})
 
+	// Serves objects written by local storage, object storage serves its own
+	if sh != nil {
+		r.GET("/static/*filepath", sh.ServeObject)
+		r.HEAD("/static/*filepath", sh.ServeObject)
+	}
+
 	v1 := r.Group("/api/v1", middlewares.NewRequestID())
 
 	// Auth
@@ -39,6 +64,11 @@ func Init(l *logger.Logger, appName, jwtSecret string, ah *handlers.AuthHandler,
 		auth.POST("/sign-up", ah.SignUp)
 		auth.POST("/sign-in", ah.SignIn)
 		auth.POST("/sign-out", middlewares.Authenticate(jwtSecret), ah.SignOut)
+		auth.POST("/secure-account", ah.SecureAccount)
+		auth.POST("/reset-password", ah.ResetPassword)
+		auth.PATCH("/locale", middlewares.Authenticate(jwtSecret), ah.UpdateLocale)
+		auth.DELETE("/account", middlewares.Authenticate(jwtSecret), ah.DeleteAccount)
+		auth.POST("/account/cancel-deletion", middlewares.Authenticate(jwtSecret), ah.CancelAccountDeletion)
 	}
 
 	// Users
@@ -71,6 +101,146 @@ func Init(l *logger.Logger, appName, jwtSecret string, ah *handlers.AuthHandler,
 			middlewares.Authorize(constants.RoleCustomer),
 			uh.UpdateProfilePicture,
 		)
+
+		users.GET(
+			"/me/addresses",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			adh.GetAllAddresses,
+		)
+
+		users.GET(
+			"/me/visibility",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			uh.GetProfileVisibility,
+		)
+
+		users.PATCH(
+			"/me/visibility",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			uh.UpdateProfileVisibility,
+		)
+
+		users.POST(
+			"/me/exports",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			eh.ExportMyData,
+		)
+
+		users.GET(
+			"/me/exports/:export_id",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			eh.GetDataExport,
+		)
+
+		users.GET("/:user_id", uh.GetPublicProfile)
+	}
+
+	// Notifications
+	notifications := v1.Group("/notifications")
+	{
+		notifications.GET(
+			"",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			nh.ListNotifications,
+		)
+
+		notifications.GET(
+			"/unread-count",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			nh.GetUnreadCount,
+		)
+
+		notifications.GET(
+			"/stream",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			nh.StreamNotifications,
+		)
+
+		notifications.GET(
+			"/preferences",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			ph.GetPreferences,
+		)
+
+		notifications.PATCH(
+			"/preferences",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			ph.UpdatePreferences,
+		)
+
+		notifications.GET(
+			"/schedule",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			ph.GetSchedule,
+		)
+
+		notifications.PUT(
+			"/schedule",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			ph.UpdateSchedule,
+		)
+
+		notifications.POST("/unsubscribe", ph.Unsubscribe)
+
+		notifications.POST(
+			"/read-all",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			nh.MarkAllNotificationsRead,
+		)
+
+		notifications.PATCH(
+			"/:notification_id/read",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			nh.MarkNotificationRead,
+		)
+
+		notifications.PATCH(
+			"/:notification_id/archive",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleCustomer),
+			nh.ArchiveNotification,
+		)
+	}
+
+	// Regions
+	regions := v1.Group("/regions")
+	{
+		regions.GET("", rh.ListRegions)
+	}
+
+	// Admin
+	admin := v1.Group("/admin")
+	{
+		admin.GET(
+			"/users/:auth_id/deliveries",
+			middlewares.Authenticate(jwtSecret),
+			middlewares.Authorize(constants.RoleAdmin),
+			dh.ListDeliveries,
+		)
+	}
+
+	// Webhooks
+	webhooks := v1.Group("/webhooks")
+	{
+		webhooks.POST(
+			"/notifications/deliveries",
+			middlewares.SignedWebhook(webhookSigningKey),
+			dh.ReportDeliveryEvent,
+		)
 	}
 
 	return &Router{router: r}
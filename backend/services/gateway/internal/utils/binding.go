@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+)
+
+// FieldViolations extracts the offending fields of an error returned by request binding
+func FieldViolations(err error) []ce.FieldViolation {
+	var ves validator.ValidationErrors
+	if errors.As(err, &ves) {
+		fields := make([]ce.FieldViolation, 0, len(ves))
+		for _, fe := range ves {
+			// Namespaces start with the name of the bound struct
+			field := fe.Namespace()
+			if i := strings.IndexByte(field, '.'); i >= 0 {
+				field = field[i+1:]
+			}
+
+			fields = append(fields, ce.FieldViolation{Field: field, Description: describe(fe)})
+		}
+		return fields
+	}
+
+	var ute *json.UnmarshalTypeError
+	if errors.As(err, &ute) && ute.Field != "" {
+		return []ce.FieldViolation{{Field: ute.Field, Description: fmt.Sprintf("must be of type %s", ute.Type)}}
+	}
+
+	return nil
+}
+
+// FieldName names struct fields after their json or form tag in validation errors
+func FieldName(f reflect.StructField) string {
+	for _, key := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(f.Tag.Get(key), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+func describe(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", fe.Param())
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max", "lte":
+		return fmt.Sprintf("must not exceed %s", fe.Param())
+	default:
+		return "is invalid"
+	}
+}
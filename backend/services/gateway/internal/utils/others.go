@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,6 +33,19 @@ func CtxRole(ctx context.Context) (string, error) {
 	return role, nil
 }
 
+// PreferredLocale picks the first supported language of an Accept-Language header, quality values are ignored
+// since clients already list their languages in order of preference
+func PreferredLocale(header string) string {
+	for _, tag := range strings.Split(header, ",") {
+		lang, _, _ := strings.Cut(tag, ";")
+		lang, _, _ = strings.Cut(NormalizeString(lang), "-")
+		if slices.Contains(constants.Locales, lang) {
+			return lang
+		}
+	}
+	return ""
+}
+
 func NewUUID() uuid.UUID {
 	return uuid.New()
 }
@@ -55,6 +69,13 @@ func UnwrapTimestamp(value *timestamp.Timestamp) *time.Time {
 	return nil
 }
 
+func WrapBool(value *bool) *wrappers.BoolValue {
+	if value != nil {
+		return wrapperspb.Bool(*value)
+	}
+	return nil
+}
+
 func WrapString(value *string) *wrappers.StringValue {
 	if value != nil {
 		return wrapperspb.String(*value)
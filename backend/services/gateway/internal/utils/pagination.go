@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+)
+
+// ToPageRequest maps query parameters onto the shared contract, sort takes "field" or "-field" for descending
+func ToPageRequest(q *dtos.PageRequest, filters map[string]string) (*apis.PageRequest, error) {
+	if q.Page != 0 && q.Cursor != "" {
+		return nil, errors.New("page and cursor must not be combined")
+	}
+
+	req := apis.PageRequest{PageSize: int32(q.PageSize)}
+	switch {
+	case q.Page != 0:
+		req.Mode = &apis.PageRequest_Page{Page: int32(q.Page)}
+	case q.Cursor != "":
+		req.Mode = &apis.PageRequest_Cursor{Cursor: q.Cursor}
+	}
+
+	for _, field := range strings.Split(q.Sort, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		direction := apis.SortDirection_SORT_DIRECTION_ASC
+		if strings.HasPrefix(field, "-") {
+			field, direction = field[1:], apis.SortDirection_SORT_DIRECTION_DESC
+		}
+
+		req.Sort = append(req.Sort, &apis.SortField{Field: field, Direction: direction})
+	}
+
+	for field, value := range filters {
+		req.Filters = append(req.Filters, &apis.Filter{Field: field, Value: value})
+	}
+
+	return &req, nil
+}
@@ -1,11 +1,14 @@
 package utils
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/gateway/internal/interface/dtos"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
 )
 
 func SendResponse[T any](ctx *gin.Context, status int, message string, data T) {
@@ -23,3 +26,84 @@ func SendResponse[T any](ctx *gin.Context, status int, message string, data T) {
 
 	ctx.JSON(status, resp)
 }
+
+// SendError responds with the code, message and field violations of the error, server faults only expose
+// the internal error code so clients never depend on codes of infrastructure failures
+func SendError(ctx *gin.Context, e *ce.Error) {
+	requestID, _ := ctx.Value(constants.CtxKeyRequestID).(string)
+	status := e.ToHTTPStatus()
+
+	body := dtos.Error{
+		Code:    publicCode(e, status),
+		Message: e.Message,
+		Fields:  make([]dtos.FieldError, 0, len(e.Fields)),
+	}
+	for _, f := range e.Fields {
+		body.Fields = append(body.Fields, dtos.FieldError{Field: f.Field, Description: f.Description})
+	}
+
+	resp := dtos.Response[any]{
+		Status:  status,
+		Message: e.Message,
+		Error:   &body,
+		Meta: &dtos.Meta{
+			RequestID: requestID,
+			Timestamp: time.Now().UTC(),
+		},
+	}
+
+	ctx.JSON(status, resp)
+}
+
+func SendPageResponse[T any](ctx *gin.Context, status int, message string, data T, page *apis.PageInfo) {
+	requestID, _ := ctx.Value(constants.CtxKeyRequestID).(string)
+
+	meta := dtos.Meta{
+		RequestID: requestID,
+		Timestamp: time.Now().UTC(),
+	}
+	if page != nil {
+		pageSize, hasMore := int(page.GetPageSize()), page.GetHasMore()
+		meta.PageSize, meta.HasMore = &pageSize, &hasMore
+
+		if page.GetPage() > 0 {
+			p := int(page.GetPage())
+			meta.Page = &p
+		}
+		if page.GetTotal() != nil {
+			total := int(page.GetTotal().GetValue())
+			meta.Total = &total
+		}
+		if page.GetNextCursor() != "" {
+			cursor := page.GetNextCursor()
+			meta.NextCursor = &cursor
+		}
+	}
+
+	resp := dtos.Response[T]{
+		Status:  status,
+		Message: message,
+		Data:    data,
+		Meta:    &meta,
+	}
+
+	ctx.JSON(status, resp)
+}
+
+func publicCode(e *ce.Error, status int) string {
+	switch e.Code {
+	case ce.CodeAuthNotFound, ce.CodeSessionNotFound, ce.CodeWrongSignInMethod:
+		// Telling these apart on sign-in would reveal which accounts exist
+		if e.Message == ce.MsgInvalidCredentials {
+			return string(ce.CodeInvalidCredentials)
+		}
+		return string(ce.CodeUnauthenticated)
+	case ce.CodeServiceUnavailable, ce.CodeDeadlineExceeded:
+		return string(e.Code)
+	}
+
+	if status >= http.StatusInternalServerError {
+		return string(ce.CodeInternal)
+	}
+	return string(e.Code)
+}
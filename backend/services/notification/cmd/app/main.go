@@ -7,12 +7,15 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	_ "time/tzdata"
 
 	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/di"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/subscriber"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/interface/server"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/processors"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/workers"
 )
 
 func main() {
@@ -33,11 +36,20 @@ func main() {
 	}
 	defer container.Close()
 
+	s := container.Server()
+
+	// Run the server
+	go func(s *server.Server) {
+		if err := s.Start(); err != nil {
+			log.Fatalln("FATAL ->", err.Error())
+		}
+	}(s)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(5)
 
 	// Run the subscribers
 	go func(ctx context.Context, s *subscriber.Subscriber, p processors.AuthProcessor) {
@@ -47,6 +59,35 @@ func main() {
 		}
 	}(ctx, container.SubAuthCreated(), container.AuthProcessor())
 
+	go func(ctx context.Context, s *subscriber.Subscriber, p processors.AuthProcessor) {
+		defer wg.Done()
+		if err := s.Listen(ctx, p.OnAuthDeleted); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.SubAuthDeleted(), container.AuthProcessor())
+
+	go func(ctx context.Context, s *subscriber.Subscriber, p processors.AuthProcessor) {
+		defer wg.Done()
+		if err := s.Listen(ctx, p.OnNewDeviceSignIn); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.SubNewDeviceSignIn(), container.AuthProcessor())
+
+	go func(ctx context.Context, s *subscriber.Subscriber, p processors.UserProcessor) {
+		defer wg.Done()
+		if err := s.Listen(ctx, p.OnUserDataExported); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.SubUserDataExported(), container.UserProcessor())
+
+	// Run the workers
+	go func(ctx context.Context, w *workers.SchedulerWorker) {
+		defer wg.Done()
+		if err := w.Run(ctx); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.SchedulerWorker())
+
 	// Handle app shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -54,5 +95,13 @@ func main() {
 	<-quit
 	log.Printf("🛑 [%s] is shutting down...", cfg.App.Name)
 	cancel()
+
+	sdCtx, sdCancel := context.WithTimeout(context.Background(), cfg.Server.Timeout.Shutdown)
+	defer sdCancel()
+
+	if err := s.Shutdown(sdCtx); err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+
 	wg.Wait()
 }
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/preview"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/templates"
+)
+
+func main() {
+	fa := flag.String("addr", "localhost:8090", "Address to serve the preview on")
+	fh := flag.String("smtp-host", "localhost", "Host of the SMTP catcher for test sends")
+	fp := flag.Int("smtp-port", 1025, "Port of the SMTP catcher for test sends")
+	ff := flag.String("from", "Pasarly <no-reply@pasarly.local>", "Sender of test sends")
+	flag.Parse()
+
+	if env := os.Getenv("APP_ENV"); env != "" && env != "dev" {
+		log.Fatalf("FATAL -> preview is only available in dev (APP_ENV=%s)", env)
+	}
+
+	r, err := templates.NewRenderer(constants.LocaleDefault)
+	if err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+
+	s := preview.NewServer(r, constants.LocaleDefault, *ff, *fh, *fp)
+
+	log.Printf("✅ [PREVIEW] running on (addr=%s, smtp=%s:%d)", *fa, *fh, *fp)
+	if err := http.ListenAndServe(*fa, s.Handler()); err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+}
@@ -10,12 +10,20 @@ import (
 )
 
 type Config struct {
-	App      `mapstructure:"app"`
-	Client   `mapstructure:"client"`
-	Database `mapstructure:"database"`
-	Broker   `mapstructure:"broker"`
-	Mailer   `mapstructure:"mailer"`
-	Tracer   `mapstructure:"tracer"`
+	App         `mapstructure:"app"`
+	Client      `mapstructure:"client"`
+	Server      `mapstructure:"server"`
+	TLS         `mapstructure:"tls"`
+	Database    `mapstructure:"database"`
+	Cache       `mapstructure:"cache"`
+	Broker      `mapstructure:"broker"`
+	Mailer      `mapstructure:"mailer"`
+	SMS         `mapstructure:"sms"`
+	Push        `mapstructure:"push"`
+	Routing     `mapstructure:"routing"`
+	Unsubscribe `mapstructure:"unsubscribe"`
+	Scheduler   `mapstructure:"scheduler"`
+	Tracer      `mapstructure:"tracer"`
 }
 
 type App struct {
@@ -27,6 +35,26 @@ type Client struct {
 	BaseURL string `mapstructure:"base_url"`
 }
 
+type Server struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+
+	Timeout struct {
+		Shutdown time.Duration `mapstructure:"shutdown"`
+	} `mapstructure:"timeout"`
+}
+
+type TLS struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	CertFile       string        `mapstructure:"cert_file"`
+	KeyFile        string        `mapstructure:"key_file"`
+	CAFile         string        `mapstructure:"ca_file"`
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// Methods each caller identity may call, matched in full, by service ("/auth.v1.AuthService/*"), or all ("*")
+	Allow map[string][]string `mapstructure:"allow"`
+}
+
 type Database struct {
 	Host            string        `mapstructure:"host"`
 	Port            int           `mapstructure:"port"`
@@ -41,11 +69,20 @@ type Database struct {
 	DSN             string
 }
 
+type Cache struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+	Pass string `mapstructure:"pass"`
+}
+
 type Broker struct {
 	Brokers     string `mapstructure:"brokers"`
 	MaxBytes    int    `mapstructure:"max_bytes"`
 	MaxAttempts int    `mapstructure:"max_attempts"`
 	BaseDelay   int    `mapstructure:"base_delay"`
+
+	// Lease is how long a claimed event stays reserved for one instance without a heartbeat
+	Lease time.Duration `mapstructure:"lease"`
 }
 
 type Mailer struct {
@@ -55,6 +92,66 @@ type Mailer struct {
 	Pass    string        `mapstructure:"pass"`
 	From    string        `mapstructure:"from"`
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Pool.Size bounds both the open connections and the sends in flight
+	Pool struct {
+		Size        int           `mapstructure:"size"`
+		IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+	} `mapstructure:"pool"`
+
+	RateLimit RateLimit `mapstructure:"rate_limit"`
+}
+
+type SMS struct {
+	Driver string `mapstructure:"driver"`
+
+	HTTP struct {
+		Endpoint string        `mapstructure:"endpoint"`
+		APIKey   string        `mapstructure:"api_key"`
+		Sender   string        `mapstructure:"sender"`
+		Timeout  time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"http"`
+
+	RateLimit RateLimit `mapstructure:"rate_limit"`
+}
+
+type Push struct {
+	Driver string `mapstructure:"driver"`
+
+	FCM struct {
+		Endpoint    string        `mapstructure:"endpoint"`
+		AccessToken string        `mapstructure:"access_token"`
+		Timeout     time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"fcm"`
+
+	RateLimit RateLimit `mapstructure:"rate_limit"`
+}
+
+// RateLimit caps the sends per second of a provider, allowing bursts of up to Burst sends, a zero rate disables it
+type RateLimit struct {
+	PerSecond float64 `mapstructure:"per_second"`
+	Burst     int     `mapstructure:"burst"`
+}
+
+// Routing maps every notification type to the channels it is delivered through
+type Routing struct {
+	Routes map[string][]string `mapstructure:"routes"`
+}
+
+// Unsubscribe links point at the gateway, which forwards the signed token back to this service
+type Unsubscribe struct {
+	BaseURL    string `mapstructure:"base_url"`
+	SigningKey string `mapstructure:"signing_key"`
+}
+
+// Only the replica holding the advisory lock under LockKey dispatches scheduled notifications
+type Scheduler struct {
+	Interval    time.Duration `mapstructure:"interval"`
+	BatchSize   int           `mapstructure:"batch_size"`
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	RetryDelay  time.Duration `mapstructure:"retry_delay"`
+	DigestHour  int           `mapstructure:"digest_hour"`
+	LockKey     int64         `mapstructure:"lock_key"`
 }
 
 type Tracer struct {
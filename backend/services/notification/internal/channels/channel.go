@@ -0,0 +1,14 @@
+package channels
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+)
+
+// Every recipient of a notification is sent and tracked as its own delivery
+type Channel interface {
+	Name() (name string)
+	Recipients(n *models.Notification) (recipients []string)
+	Send(ctx context.Context, n *models.Notification, recipient string) (messageID string, err error)
+}
@@ -1,13 +1,16 @@
 package channels
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"html/template"
+	"maps"
+	"slices"
+	"strconv"
 	"time"
 
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/mailer"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/templates"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
@@ -18,79 +21,119 @@ import (
 
 const emailErrTracer string = "channel.email"
 
-type EmailChannel interface {
-	SendWelcome(ctx context.Context, email, token string) (err error)
-}
-
 type emailChannel struct {
-	baseURL  string
-	sender   string
-	mailer   *mailer.Mailer
-	template *template.Template
+	sender         string
+	unsubscribeURL string
+	signingKey     string
+	mailer         *mailer.Mailer
+	renderer       *templates.Renderer
 }
 
-func NewEmailChannel(m *mailer.Mailer, baseURL, sender string) (EmailChannel, error) {
-	t, err := template.ParseFS(templates.FS, "*.html.tmpl")
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize email channel: %w", err)
+func NewEmailChannel(m *mailer.Mailer, r *templates.Renderer, sender, unsubscribeURL, signingKey string) Channel {
+	return &emailChannel{
+		sender:         sender,
+		unsubscribeURL: unsubscribeURL,
+		signingKey:     signingKey,
+		mailer:         m,
+		renderer:       r,
 	}
+}
+
+func (c *emailChannel) Name() string {
+	return constants.ChannelEmail
+}
 
-	return &emailChannel{baseURL: baseURL, sender: sender, mailer: m, template: t}, nil
+func (c *emailChannel) Recipients(n *models.Notification) []string {
+	if n.Email == "" {
+		return nil
+	}
+	return []string{n.Email}
 }
 
-func (c *emailChannel) SendWelcome(ctx context.Context, email, token string) error {
-	_, span := otel.Tracer(emailErrTracer).Start(ctx, "SendWelcome")
+// Send renders the templates named after the notification type in the recipient's locale, e.g. "id/welcome"
+func (c *emailChannel) Send(ctx context.Context, n *models.Notification, recipient string) (string, error) {
+	ctx, span := otel.Tracer(emailErrTracer).Start(ctx, "Send")
 	defer span.End()
 
-	url, err := utils.URLWithToken(c.baseURL, "/auth/verify-account/confirm", token)
+	unsubscribeURL, err := c.buildUnsubscribeURL(span, n)
 	if err != nil {
-		e := fmt.Errorf("failed to send email: %w", err)
-		utils.TraceErr(span, e, ce.MsgInternalServer)
-		return e
+		return "", err
 	}
 
-	data := struct {
-		Email string
-		URL   string
-		Year  int
-	}{
-		Email: email,
-		URL:   url,
-		Year:  time.Now().UTC().Year(),
+	data := make(map[string]string, len(n.Data)+4)
+	maps.Copy(data, n.Data)
+	data["Email"] = recipient
+	data["URL"] = n.URL
+	data["UnsubscribeURL"] = unsubscribeURL
+	data["Year"] = strconv.Itoa(time.Now().UTC().Year())
+
+	email, err := c.buildEmail(span, n.Locale, n.Type, data)
+	if err != nil {
+		return "", err
 	}
 
-	body, err := c.buildTemplate(span, "welcome.html.tmpl", data)
+	// The Message-ID is generated here rather than by the server so that bounces can be matched back to the delivery
+	messageID := utils.MessageID(c.sender)
+
+	m := c.buildMessage([]string{recipient}, email, messageID, unsubscribeURL)
+	if err := c.sendEmail(ctx, span, m); err != nil {
+		return "", err
+	}
+
+	return messageID, nil
+}
+
+// buildUnsubscribeURL returns an empty URL for mandatory categories and recipients without an account
+func (c *emailChannel) buildUnsubscribeURL(s trace.Span, n *models.Notification) (string, error) {
+	if n.AuthID == 0 || n.Category == "" || slices.Contains(constants.MandatoryCategories, n.Category) {
+		return "", nil
+	}
+
+	token := utils.SignUnsubscribe(c.signingKey, &models.Unsubscribe{
+		AuthID:   n.AuthID,
+		Category: n.Category,
+		Channel:  constants.ChannelEmail,
+	})
+
+	url, err := utils.URLWithToken(c.unsubscribeURL, "/api/v1/notifications/unsubscribe", token)
 	if err != nil {
-		return err
+		e := fmt.Errorf("failed to send email: %w", err)
+		utils.TraceErr(s, e, ce.MsgInternalServer)
+		return "", e
 	}
 
-	m := c.buildMessage([]string{email}, "Welcome to Pasarly!", body.String())
-	return c.sendEmail(span, m)
+	return url, nil
 }
 
-func (c *emailChannel) buildTemplate(s trace.Span, template string, data any) (bytes.Buffer, error) {
-	var b bytes.Buffer
-	if err := c.template.ExecuteTemplate(&b, template, data); err != nil {
+func (c *emailChannel) buildEmail(s trace.Span, locale, name string, data any) (*templates.Email, error) {
+	email, err := c.renderer.Render(locale, name, data)
+	if err != nil {
 		e := fmt.Errorf("failed to send email: %w", err)
 		utils.TraceErr(s, e, ce.MsgInternalServer)
-		return bytes.Buffer{}, e
+		return nil, e
 	}
 
-	return b, nil
+	return email, nil
 }
 
-func (c *emailChannel) buildMessage(recipients []string, subject, body string) *gomail.Message {
+func (c *emailChannel) buildMessage(recipients []string, email *templates.Email, messageID, unsubscribeURL string) *gomail.Message {
 	m := gomail.NewMessage()
+	m.SetHeader("Message-ID", "<"+messageID+">")
 	m.SetHeader("From", c.sender)
 	m.SetHeader("To", recipients...)
-	m.SetHeader("Subject", utils.MIMEBase64(subject))
-	m.SetBody("text/plain", "Please view this email in an HTML-compatible client!")
-	m.AddAlternative("text/html", body)
+	m.SetHeader("Subject", utils.MIMEBase64(email.Subject))
+	if unsubscribeURL != "" {
+		// RFC 8058 one-click unsubscribe
+		m.SetHeader("List-Unsubscribe", "<"+unsubscribeURL+">")
+		m.SetHeader("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	m.SetBody("text/plain", email.Text)
+	m.AddAlternative("text/html", email.HTML)
 	return m
 }
 
-func (c *emailChannel) sendEmail(s trace.Span, m *gomail.Message) error {
-	if err := c.mailer.Send(m); err != nil {
+func (c *emailChannel) sendEmail(ctx context.Context, s trace.Span, m *gomail.Message) error {
+	if err := c.mailer.Send(ctx, m); err != nil {
 		e := fmt.Errorf("failed to send email: %w", err)
 		utils.TraceErr(s, e, ce.MsgInternalServer)
 		return e
@@ -0,0 +1,97 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/cache"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const inAppErrTracer string = "channel.in_app"
+
+type inAppChannel struct {
+	ir    repositories.InboxRepository
+	cache *cache.Cache
+}
+
+func NewInAppChannel(ir repositories.InboxRepository, c *cache.Cache) Channel {
+	return &inAppChannel{ir: ir, cache: c}
+}
+
+func (c *inAppChannel) Name() string {
+	return constants.ChannelInApp
+}
+
+func (c *inAppChannel) Recipients(n *models.Notification) []string {
+	if n.AuthID == 0 {
+		return nil
+	}
+	return []string{strconv.FormatInt(n.AuthID, 10)}
+}
+
+// Send returns the inbox item ID, or an empty ID when the item already exists from an earlier attempt
+func (c *inAppChannel) Send(ctx context.Context, n *models.Notification, recipient string) (string, error) {
+	ctx, span := otel.Tracer(inAppErrTracer).Start(ctx, "Send")
+	defer span.End()
+
+	data := models.CreateInboxItem{
+		EventID: n.EventID,
+		AuthID:  n.AuthID,
+		Type:    n.Type,
+		Title:   n.Title,
+		Body:    n.Body,
+		URL:     n.URL,
+		Data:    n.Data,
+	}
+
+	item, err := c.ir.CreateInboxItem(ctx, &data)
+	if err != nil || item == nil {
+		return "", err
+	}
+
+	// The inbox is the source of truth, a missed real-time push is caught up on the next listing
+	c.announce(ctx, span, n.AuthID, item)
+	return strconv.FormatInt(item.ID, 10), nil
+}
+
+func (c *inAppChannel) announce(ctx context.Context, s trace.Span, authID int64, item *models.InboxItem) {
+	count, err := c.ir.CountUnread(ctx, authID)
+	if err != nil {
+		return
+	}
+
+	evt := events.InboxNotificationCreated{
+		AuthId:         authID,
+		NotificationId: item.ID,
+		Type:           item.Type,
+		Title:          item.Title,
+		Body:           item.Body,
+		Data:           item.Data,
+		CreatedAt:      timestamppb.New(item.CreatedAt),
+		UnreadCount:    count.Total,
+	}
+	if item.URL != nil {
+		evt.Url = *item.URL
+	}
+
+	payload, err := proto.Marshal(&evt)
+	if err != nil {
+		utils.TraceErr(s, fmt.Errorf("failed to announce inbox item: %w", err), ce.MsgInternalServer)
+		return
+	}
+
+	if err := c.cache.Publish(ctx, constants.PubSubChannelInbox, payload); err != nil {
+		utils.TraceErr(s, fmt.Errorf("failed to announce inbox item: %w", err), ce.MsgInternalServer)
+	}
+}
@@ -0,0 +1,65 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/push"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const pushErrTracer string = "channel.push"
+
+type pushChannel struct {
+	provider push.Provider
+}
+
+func NewPushChannel(p push.Provider) Channel {
+	return &pushChannel{provider: p}
+}
+
+func (c *pushChannel) Name() string {
+	return constants.ChannelPush
+}
+
+func (c *pushChannel) Recipients(n *models.Notification) []string {
+	if push.IsDisabled(c.provider) {
+		return nil
+	}
+	return n.DeviceTokens
+}
+
+func (c *pushChannel) Send(ctx context.Context, n *models.Notification, recipient string) (string, error) {
+	ctx, span := otel.Tracer(pushErrTracer).Start(ctx, "Send")
+	defer span.End()
+
+	data := make(map[string]string, len(n.Data)+2)
+	maps.Copy(data, n.Data)
+	data["type"] = n.Type
+	if n.URL != "" {
+		data["url"] = n.URL
+	}
+
+	msg := push.Message{
+		Token:        recipient,
+		Notification: &push.Notification{Title: n.Title, Body: n.Body},
+		Data:         data,
+	}
+	if n.URL != "" {
+		msg.Webpush = &push.Webpush{FCMOptions: &push.WebpushFCMOptions{Link: n.URL}}
+	}
+
+	messageID, err := c.provider.Send(ctx, &msg)
+	if err != nil {
+		e := fmt.Errorf("failed to send push: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return "", e
+	}
+
+	return messageID, nil
+}
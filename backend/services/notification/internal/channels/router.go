@@ -0,0 +1,127 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const routerErrTracer string = "channel.router"
+
+type Router interface {
+	Dispatch(ctx context.Context, n *models.Notification) (err error)
+}
+
+type router struct {
+	routes map[string][]Channel
+	dr     repositories.DeliveryRepository
+	sr     repositories.SuppressionRepository
+}
+
+// NewRouter resolves the configured channel names of every notification type against the given channels
+func NewRouter(
+	routes map[string][]string,
+	dr repositories.DeliveryRepository,
+	sr repositories.SuppressionRepository,
+	chs ...Channel,
+) (Router, error) {
+	available := make(map[string]Channel, len(chs))
+	for _, c := range chs {
+		available[c.Name()] = c
+	}
+
+	resolved := make(map[string][]Channel, len(routes))
+	for notificationType, names := range routes {
+		for _, name := range names {
+			c, ok := available[name]
+			if !ok {
+				return nil, fmt.Errorf("failed to initialize router: unknown channel %q for %q", name, notificationType)
+			}
+			if !slices.Contains(constants.RoutableChannels, name) {
+				return nil, fmt.Errorf("failed to initialize router: channel %q for %q has no recipients", name, notificationType)
+			}
+
+			resolved[notificationType] = append(resolved[notificationType], c)
+		}
+	}
+
+	return &router{routes: resolved, dr: dr, sr: sr}, nil
+}
+
+func (r *router) Dispatch(ctx context.Context, n *models.Notification) error {
+	ctx, span := otel.Tracer(routerErrTracer).Start(ctx, "Dispatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("notification.type", n.Type))
+
+	chs, ok := r.routes[n.Type]
+	if !ok {
+		e := fmt.Errorf("failed to dispatch notification: no route for %q", n.Type)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	// Every recipient is attempted so one failing provider does not starve the others
+	var errs []error
+	for _, c := range chs {
+		if slices.Contains(n.Muted, c.Name()) {
+			continue
+		}
+		for _, recipient := range c.Recipients(n) {
+			if err := r.deliver(ctx, c, n, recipient); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", c.Name(), err))
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		e := fmt.Errorf("failed to dispatch notification: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+// deliver records the outcome of a single send, a suppressed recipient is recorded as failed without being an error
+func (r *router) deliver(ctx context.Context, c Channel, n *models.Notification, recipient string) error {
+	data := models.CreateDelivery{
+		EventID:   n.EventID,
+		AuthID:    n.AuthID,
+		Channel:   c.Name(),
+		Template:  n.Type,
+		Recipient: recipient,
+	}
+
+	delivery, err := r.dr.ClaimDelivery(ctx, &data)
+	if err != nil || delivery == nil {
+		return err
+	}
+
+	suppressed, err := r.sr.IsSuppressed(ctx, c.Name(), recipient)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		return r.dr.SetFailed(ctx, delivery.ID, "recipient is suppressed")
+	}
+
+	messageID, err := c.Send(ctx, n, recipient)
+	if err != nil {
+		if e := r.dr.SetFailed(ctx, delivery.ID, err.Error()); e != nil {
+			return errors.Join(err, e)
+		}
+		return err
+	}
+
+	return r.dr.SetSent(ctx, delivery.ID, messageID)
+}
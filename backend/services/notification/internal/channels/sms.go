@@ -0,0 +1,53 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/sms"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const smsErrTracer string = "channel.sms"
+
+type smsChannel struct {
+	provider sms.Provider
+}
+
+func NewSMSChannel(p sms.Provider) Channel {
+	return &smsChannel{provider: p}
+}
+
+func (c *smsChannel) Name() string {
+	return constants.ChannelSMS
+}
+
+func (c *smsChannel) Recipients(n *models.Notification) []string {
+	if n.Phone == "" || sms.IsDisabled(c.provider) {
+		return nil
+	}
+	return []string{n.Phone}
+}
+
+func (c *smsChannel) Send(ctx context.Context, n *models.Notification, recipient string) (string, error) {
+	ctx, span := otel.Tracer(smsErrTracer).Start(ctx, "Send")
+	defer span.End()
+
+	text := n.Body
+	if n.URL != "" {
+		text += " " + n.URL
+	}
+
+	messageID, err := c.provider.Send(ctx, &sms.Message{To: recipient, Text: text})
+	if err != nil {
+		e := fmt.Errorf("failed to send sms: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return "", e
+	}
+
+	return messageID, nil
+}
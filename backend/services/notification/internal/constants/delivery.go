@@ -0,0 +1,32 @@
+package constants
+
+const (
+	DeliveryStatusQueued     string = "queued"
+	DeliveryStatusSent       string = "sent"
+	DeliveryStatusFailed     string = "failed"
+	DeliveryStatusBounced    string = "bounced"
+	DeliveryStatusComplained string = "complained"
+)
+
+const (
+	DeliveryEventBounce    string = "bounce"
+	DeliveryEventComplaint string = "complaint"
+)
+
+const (
+	BounceTypeHard string = "hard"
+	BounceTypeSoft string = "soft"
+)
+
+const (
+	SuppressionReasonHardBounce string = "hard_bounce"
+	SuppressionReasonComplaint  string = "complaint"
+)
+
+var DeliveryStatuses = []string{
+	DeliveryStatusQueued,
+	DeliveryStatusSent,
+	DeliveryStatusFailed,
+	DeliveryStatusBounced,
+	DeliveryStatusComplained,
+}
@@ -1,5 +1,9 @@
 package constants
 
 const (
-	EventTopicAuthCreated string = "auth.created"
+	EventTopicAuthCreated     string = "auth.created"
+	EventTopicAuthDeleted     string = "auth.deleted"
+	EventTopicNewDeviceSignIn string = "auth.new_device_sign_in"
+
+	EventTopicUserDataExported string = "user.data_exported"
 )
@@ -0,0 +1,4 @@
+package constants
+
+// Emails of locales without a translation are rendered in this one
+const LocaleDefault string = "en"
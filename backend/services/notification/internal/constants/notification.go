@@ -0,0 +1,80 @@
+package constants
+
+const (
+	ChannelEmail string = "email"
+	ChannelSMS   string = "sms"
+	ChannelPush  string = "push"
+	ChannelInApp string = "in_app"
+)
+
+const (
+	InboxStatusUnread   string = "unread"
+	InboxStatusRead     string = "read"
+	InboxStatusArchived string = "archived"
+)
+
+// Every gateway instance subscribes to this channel and fans new inbox items out to its own connected clients
+const PubSubChannelInbox string = "notification.inbox"
+
+const (
+	CategorySecurity  string = "security"
+	CategoryAccount   string = "account"
+	CategoryMarketing string = "marketing"
+)
+
+const (
+	NotificationTypeWelcome         string = "welcome"
+	NotificationTypeAccountDeleted  string = "account_deleted"
+	NotificationTypeDataExported    string = "data_exported"
+	NotificationTypeNewDeviceSignIn string = "new_device_sign_in"
+
+	// Batches the low priority notifications of a user, it is only ever sent by the scheduler
+	NotificationTypeDigest string = "digest"
+)
+
+const (
+	PriorityHigh   string = "high"   // Sent right away, even during quiet hours
+	PriorityNormal string = "normal" // Held back until quiet hours end
+	PriorityLow    string = "low"    // Batched into the digest when the user has one
+)
+
+const (
+	DigestOff    string = "off"
+	DigestDaily  string = "daily"
+	DigestWeekly string = "weekly"
+)
+
+const (
+	ScheduledStatusPending    string = "pending"
+	ScheduledStatusDispatched string = "dispatched"
+	ScheduledStatusFailed     string = "failed"
+)
+
+const TimeZoneDefault string = "UTC"
+
+var (
+	Categories = []string{CategorySecurity, CategoryAccount, CategoryMarketing}
+	Channels   = []string{ChannelEmail, ChannelSMS, ChannelPush, ChannelInApp}
+
+	// Notifications carry no phone numbers or device tokens yet, so only these channels can be routed to
+	RoutableChannels = []string{ChannelEmail, ChannelInApp}
+
+	// Users cannot opt out of these categories on any channel
+	MandatoryCategories = []string{CategorySecurity}
+
+	Digests = []string{DigestOff, DigestDaily, DigestWeekly}
+
+	CategoryPriorities = map[string]string{
+		CategorySecurity:  PriorityHigh,
+		CategoryAccount:   PriorityNormal,
+		CategoryMarketing: PriorityLow,
+	}
+
+	NotificationCategories = map[string]string{
+		NotificationTypeWelcome:         CategorySecurity,
+		NotificationTypeAccountDeleted:  CategorySecurity,
+		NotificationTypeDataExported:    CategoryAccount,
+		NotificationTypeNewDeviceSignIn: CategorySecurity,
+		NotificationTypeDigest:          CategoryMarketing,
+	}
+)
@@ -0,0 +1,14 @@
+package constants
+
+const (
+	PageSizeDefault int = 20
+	PageSizeMax     int = 100
+)
+
+var (
+	InboxSortFields   = []string{"created_at"}
+	InboxFilterFields = []string{"status", "type"}
+
+	DeliverySortFields   = []string{"created_at"}
+	DeliveryFilterFields = []string{"channel", "status"}
+)
@@ -5,56 +5,162 @@ import (
 
 	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/channels"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/cache"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/mailer"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/subscriber"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/interface/handlers"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/interface/server"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/processors"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/scheduler"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/templates"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/workers"
+	"github.com/ritchieridanko/pasarly/backend/shared/idempotency"
 )
 
 type Container struct {
 	config   *configs.Config
 	database *database.Database
+	cache    *cache.Cache
 	logger   *logger.Logger
 	mailer   *mailer.Mailer
 	acs      *subscriber.Subscriber
-	ec       channels.EmailChannel
+	ads      *subscriber.Subscriber
+	nds      *subscriber.Subscriber
+	uds      *subscriber.Subscriber
 	er       repositories.EventRepository
+	ir       repositories.InboxRepository
+	pr       repositories.PreferenceRepository
+	dr       repositories.DeliveryRepository
+	sr       repositories.SuppressionRepository
+	scr      repositories.ScheduleRepository
+	qr       repositories.QueueRepository
+	rt       channels.Router
+	sch      scheduler.Scheduler
 	ap       processors.AuthProcessor
+	up       processors.UserProcessor
+	nu       usecases.NotificationUsecase
+	iu       usecases.InboxUsecase
+	pu       usecases.PreferenceUsecase
+	du       usecases.DeliveryUsecase
+	nh       *handlers.NotificationHandler
+	ih       *handlers.InboxHandler
+	ph       *handlers.PreferenceHandler
+	dh       *handlers.DeliveryHandler
+	server   *server.Server
+	sw       *workers.SchedulerWorker
 }
 
 func Init(cfg *configs.Config, i *infra.Infra) (*Container, error) {
 	// Infra
 	db := database.NewDatabase(i.Database())
+	tx := database.NewTransactor(i.Database())
+	c := cache.NewCache(i.Cache())
 	l := logger.NewLogger(i.Logger())
-	m := mailer.NewMailer(i.Mailer())
+	m := mailer.NewMailer(i.Mailer(), &cfg.Mailer)
 
 	// Subscribers
 	acs := subscriber.NewSubscriber(&cfg.Broker, i.SubAuthCreated(), l)
+	ads := subscriber.NewSubscriber(&cfg.Broker, i.SubAuthDeleted(), l)
+	nds := subscriber.NewSubscriber(&cfg.Broker, i.SubNewDeviceSignIn(), l)
+	uds := subscriber.NewSubscriber(&cfg.Broker, i.SubUserDataExported(), l)
+
+	// Repositories
+	er := repositories.NewEventRepository(db)
+	ir := repositories.NewInboxRepository(db)
+	pr := repositories.NewPreferenceRepository(db)
+	dr := repositories.NewDeliveryRepository(db)
+	sr := repositories.NewSuppressionRepository(db)
+	scr := repositories.NewScheduleRepository(db)
+	qr := repositories.NewQueueRepository(db)
 
 	// Channels
-	ec, err := channels.NewEmailChannel(m, cfg.Client.BaseURL, cfg.Mailer.From)
+	tr, err := templates.NewRenderer(constants.LocaleDefault)
 	if err != nil {
 		return nil, err
 	}
 
-	// Repositories
-	er := repositories.NewEventRepository(db)
+	ec := channels.NewEmailChannel(m, tr, cfg.Mailer.From, cfg.Unsubscribe.BaseURL, cfg.Unsubscribe.SigningKey)
+
+	sc := channels.NewSMSChannel(i.SMS())
+	pc := channels.NewPushChannel(i.Push())
+	ic := channels.NewInAppChannel(ir, c)
+
+	rt, err := channels.NewRouter(cfg.Routing.Routes, dr, sr, ec, sc, pc, ic)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scheduler
+	sch := scheduler.NewScheduler(&cfg.Scheduler, rt, scr, qr)
 
 	// Processors
-	ap := processors.NewAuthProcessor(er, ec, cfg.Mailer.Timeout)
+	cl, err := idempotency.NewClaimer(i.Database(), cfg.Broker.Lease)
+	if err != nil {
+		return nil, err
+	}
+	ap := processors.NewAuthProcessor(er, ir, pr, dr, scr, qr, sch, cl, cfg.Client.BaseURL)
+	up := processors.NewUserProcessor(pr, sch, cl)
+
+	// Utils
+	v := utils.NewValidator()
+
+	// Usecases
+	nu := usecases.NewNotificationUsecase(er)
+	iu := usecases.NewInboxUsecase(ir, v)
+	pu := usecases.NewPreferenceUsecase(pr, scr, v, cfg.Unsubscribe.SigningKey)
+	du := usecases.NewDeliveryUsecase(dr, sr, tx, v)
+
+	// Handlers
+	nh := handlers.NewNotificationHandler(nu)
+	ih := handlers.NewInboxHandler(iu)
+	ph := handlers.NewPreferenceHandler(pu)
+	dh := handlers.NewDeliveryHandler(du)
+
+	// Server
+	s := server.Init(&cfg.Server, &cfg.TLS, i.Certs(), l, nh, ih, ph, dh)
+
+	// Workers
+	leader := database.NewLeader(i.Database(), cfg.Scheduler.LockKey)
+	sw := workers.NewSchedulerWorker(cfg.Scheduler.Interval, cfg.Scheduler.BatchSize, sch, leader, l)
 
 	return &Container{
 		config:   cfg,
 		database: db,
+		cache:    c,
 		logger:   l,
 		mailer:   m,
 		acs:      acs,
-		ec:       ec,
+		ads:      ads,
+		nds:      nds,
+		uds:      uds,
 		er:       er,
+		ir:       ir,
+		pr:       pr,
+		dr:       dr,
+		sr:       sr,
+		scr:      scr,
+		qr:       qr,
+		rt:       rt,
+		sch:      sch,
 		ap:       ap,
+		up:       up,
+		nu:       nu,
+		iu:       iu,
+		pu:       pu,
+		du:       du,
+		nh:       nh,
+		ih:       ih,
+		ph:       ph,
+		dh:       dh,
+		server:   s,
+		sw:       sw,
 	}, nil
 }
 
@@ -62,10 +168,34 @@ func (c *Container) SubAuthCreated() *subscriber.Subscriber {
 	return c.acs
 }
 
+func (c *Container) SubAuthDeleted() *subscriber.Subscriber {
+	return c.ads
+}
+
+func (c *Container) SubNewDeviceSignIn() *subscriber.Subscriber {
+	return c.nds
+}
+
+func (c *Container) SubUserDataExported() *subscriber.Subscriber {
+	return c.uds
+}
+
 func (c *Container) AuthProcessor() processors.AuthProcessor {
 	return c.ap
 }
 
+func (c *Container) UserProcessor() processors.UserProcessor {
+	return c.up
+}
+
+func (c *Container) SchedulerWorker() *workers.SchedulerWorker {
+	return c.sw
+}
+
+func (c *Container) Server() *server.Server {
+	return c.server
+}
+
 func (c *Container) Close() error {
 	if err := c.mailer.Close(); err != nil {
 		return fmt.Errorf("failed to close mailer: %w", err)
@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type Cache struct {
+	client *redis.Client
+}
+
+func NewCache(c *redis.Client) *Cache {
+	return &Cache{client: c}
+}
+
+func (c *Cache) Publish(ctx context.Context, channel string, payload []byte) error {
+	return c.client.Publish(ctx, channel, payload).Err()
+}
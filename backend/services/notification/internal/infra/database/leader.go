@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Leader holds a session level advisory lock on a dedicated connection, so leadership ends together with the
+// connection when a replica dies. It needs a direct connection, a transaction pooler would not keep the session
+type Leader struct {
+	pool  *pgxpool.Pool
+	key   int64
+	conn  *pgxpool.Conn
+	mutex sync.Mutex
+}
+
+func NewLeader(p *pgxpool.Pool, key int64) *Leader {
+	return &Leader{pool: p, key: key}
+}
+
+// IsLeader checks that held leadership is still alive, or tries to take it over when it is free
+func (l *Leader) IsLeader(ctx context.Context) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err == nil {
+			return true, nil
+		}
+
+		// The lock went away with the connection, which must not go back to the pool
+		_ = l.conn.Conn().Close(ctx)
+		l.conn.Release()
+		l.conn = nil
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Release()
+		return false, err
+	}
+	if !acquired {
+		conn.Release()
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+func (l *Leader) Release(ctx context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	l.conn.Release()
+	l.conn = nil
+
+	return err
+}
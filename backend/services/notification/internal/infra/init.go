@@ -4,26 +4,39 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/cache"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/certs"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/mailer"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/push"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/sms"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/subscriber"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/tracer"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 	"gopkg.in/gomail.v2"
 )
 
 type Infra struct {
+	certs    *mtls.Reloader
 	config   *configs.Config
 	database *pgxpool.Pool
+	cache    *redis.Client
 	logger   *zap.Logger
 	mailer   *gomail.Dialer
+	sms      sms.Provider
+	push     push.Provider
 	tracer   *tracer.Tracer
 
 	acs *kafka.Reader
+	ads *kafka.Reader
+	nds *kafka.Reader
+	uds *kafka.Reader
 }
 
 func Init(cfg *configs.Config) (*Infra, error) {
@@ -32,13 +45,33 @@ func Init(cfg *configs.Config) (*Infra, error) {
 		return nil, err
 	}
 
+	cr, err := certs.Init(&cfg.TLS, l)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := database.Init(&cfg.Database, l)
 	if err != nil {
 		return nil, err
 	}
 
+	c, err := cache.Init(&cfg.Cache, l)
+	if err != nil {
+		return nil, err
+	}
+
 	m := mailer.Init(&cfg.Mailer, l)
 
+	sp, err := sms.Init(&cfg.SMS, l)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, err := push.Init(&cfg.Push, l)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := tracer.Init(cfg.App.Name, cfg.Tracer.Endpoint, l)
 	if err != nil {
 		return nil, err
@@ -46,14 +79,24 @@ func Init(cfg *configs.Config) (*Infra, error) {
 
 	// Subscribers
 	acs := subscriber.Init(&cfg.Broker, constants.EventTopicAuthCreated, l)
+	ads := subscriber.Init(&cfg.Broker, constants.EventTopicAuthDeleted, l)
+	nds := subscriber.Init(&cfg.Broker, constants.EventTopicNewDeviceSignIn, l)
+	uds := subscriber.Init(&cfg.Broker, constants.EventTopicUserDataExported, l)
 
 	return &Infra{
+		certs:    cr,
 		config:   cfg,
 		database: db,
+		cache:    c,
 		logger:   l,
 		mailer:   m,
+		sms:      sp,
+		push:     pp,
 		tracer:   t,
 		acs:      acs,
+		ads:      ads,
+		nds:      nds,
+		uds:      uds,
 	}, nil
 }
 
@@ -61,6 +104,14 @@ func (i *Infra) Database() *pgxpool.Pool {
 	return i.database
 }
 
+func (i *Infra) Cache() *redis.Client {
+	return i.cache
+}
+
+func (i *Infra) Certs() *mtls.Reloader {
+	return i.certs
+}
+
 func (i *Infra) Logger() *zap.Logger {
 	return i.logger
 }
@@ -69,17 +120,52 @@ func (i *Infra) Mailer() *gomail.Dialer {
 	return i.mailer
 }
 
+func (i *Infra) SMS() sms.Provider {
+	return i.sms
+}
+
+func (i *Infra) Push() push.Provider {
+	return i.push
+}
+
 func (i *Infra) SubAuthCreated() *kafka.Reader {
 	return i.acs
 }
 
+func (i *Infra) SubAuthDeleted() *kafka.Reader {
+	return i.ads
+}
+
+func (i *Infra) SubNewDeviceSignIn() *kafka.Reader {
+	return i.nds
+}
+
+func (i *Infra) SubUserDataExported() *kafka.Reader {
+	return i.uds
+}
+
 func (i *Infra) Close() error {
+	if i.certs != nil {
+		i.certs.Close()
+	}
 	if err := i.logger.Sync(); err != nil {
 		return fmt.Errorf("failed to close logger: %w", err)
 	}
+	if err := i.cache.Close(); err != nil {
+		return fmt.Errorf("failed to close cache: %w", err)
+	}
 	if err := i.acs.Close(); err != nil {
 		return fmt.Errorf("failed to close subscriber (%s): %w", constants.EventTopicAuthCreated, err)
 	}
+	if err := i.ads.Close(); err != nil {
+		return fmt.Errorf("failed to close subscriber (%s): %w", constants.EventTopicAuthDeleted, err)
+	}
+	if err := i.nds.Close(); err != nil {
+		return fmt.Errorf("failed to close subscriber (%s): %w", constants.EventTopicNewDeviceSignIn, err)
+	}
+	if err := i.uds.Close(); err != nil {
+		return fmt.Errorf("failed to close subscriber (%s): %w", constants.EventTopicUserDataExported, err)
+	}
 
 	i.database.Close()
 	i.tracer.Cleanup()
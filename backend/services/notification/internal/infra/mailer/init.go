@@ -9,6 +9,9 @@ import (
 func Init(cfg *configs.Mailer, l *zap.Logger) *gomail.Dialer {
 	d := gomail.NewDialer(cfg.Host, cfg.Port, cfg.User, cfg.Pass)
 
-	l.Sugar().Infof("✅ [MAILER] initialized (host=%s, port=%d, from=%s)", cfg.Host, cfg.Port, cfg.From)
+	l.Sugar().Infof(
+		"✅ [MAILER] initialized (host=%s, port=%d, from=%s, pool_size=%d, rate=%.2f/s)",
+		cfg.Host, cfg.Port, cfg.From, cfg.Pool.Size, cfg.RateLimit.PerSecond,
+	)
 	return d
 }
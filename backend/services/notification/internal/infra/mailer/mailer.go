@@ -1,52 +1,194 @@
 package mailer
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/textproto"
 	"sync"
+	"syscall"
+	"time"
 
+	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
 	"gopkg.in/gomail.v2"
 )
 
+var ErrClosed = errors.New("mailer is closed")
+
+// Mailer keeps a small pool of SMTP connections, every send takes one connection for itself
 type Mailer struct {
-	dialer *gomail.Dialer
-	sender gomail.SendCloser
-	mutex  sync.Mutex
+	dialer      *gomail.Dialer
+	idleTimeout time.Duration
+	limiter     *utils.RateLimiter
+	slots       chan struct{}
+	idle        []*conn
+	closed      bool
+	mutex       sync.Mutex
 }
 
-func NewMailer(d *gomail.Dialer) *Mailer {
-	return &Mailer{dialer: d}
+type conn struct {
+	sender   gomail.SendCloser
+	lastUsed time.Time
 }
 
-func (m *Mailer) Send(msg *gomail.Message) error {
-	m.mutex.Lock()
-	defer m.mutex.Unlock()
+func NewMailer(d *gomail.Dialer, cfg *configs.Mailer) *Mailer {
+	size := cfg.Pool.Size
+	if size < 1 {
+		size = 1
+	}
+
+	return &Mailer{
+		dialer:      d,
+		idleTimeout: cfg.Pool.IdleTimeout,
+		limiter:     utils.NewRateLimiter(cfg.RateLimit.PerSecond, cfg.RateLimit.Burst),
+		slots:       make(chan struct{}, size),
+	}
+}
+
+// Send retries once on a fresh connection when a pooled one turns out to be dropped by the server
+func (m *Mailer) Send(ctx context.Context, msg *gomail.Message) error {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case m.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-m.slots }()
+
+	c, pooled, err := m.acquire()
+	if err != nil {
+		return err
+	}
+
+	cause, err := send(c, msg)
+	if err != nil && pooled && isBroken(cause) {
+		discard(c)
 
-	if err := m.ensureConnection(); err != nil {
+		if c, err = m.dial(); err != nil {
+			return err
+		}
+		_, err = send(c, msg)
+	}
+
+	// A failed transaction leaves the session in an unknown state, so the connection is not reused
+	if err != nil {
+		discard(c)
 		return err
 	}
 
-	return gomail.Send(m.sender, msg)
+	m.release(c)
+	return nil
 }
 
 func (m *Mailer) Close() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if m.sender != nil {
-		return m.sender.Close()
+	m.closed = true
+
+	var errs []error
+	for _, c := range m.idle {
+		if err := c.sender.Close(); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	m.idle = nil
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func (m *Mailer) ensureConnection() error {
-	if m.sender == nil {
-		sender, err := m.dialer.Dial()
-		if err != nil {
-			return err
+// acquire prefers the most recently used idle connection and closes those idle for too long
+func (m *Mailer) acquire() (*conn, bool, error) {
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
+		return nil, false, ErrClosed
+	}
+
+	var stale []*conn
+	var c *conn
+	for len(m.idle) > 0 {
+		last := m.idle[len(m.idle)-1]
+		m.idle = m.idle[:len(m.idle)-1]
+
+		if m.idleTimeout > 0 && time.Since(last.lastUsed) > m.idleTimeout {
+			stale = append(stale, last)
+			continue
 		}
 
-		m.sender = sender
+		c = last
+		break
 	}
+	m.mutex.Unlock()
 
-	return nil
+	for _, s := range stale {
+		discard(s)
+	}
+	if c != nil {
+		return c, true, nil
+	}
+
+	c, err := m.dial()
+	return c, false, err
+}
+
+func (m *Mailer) release(c *conn) {
+	c.lastUsed = time.Now()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.closed {
+		discard(c)
+		return
+	}
+
+	m.idle = append(m.idle, c)
+}
+
+func (m *Mailer) dial() (*conn, error) {
+	sender, err := m.dialer.Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return &conn{sender: sender, lastUsed: time.Now()}, nil
+}
+
+// send also returns the error of the SMTP session itself, which gomail only passes on as text
+func send(c *conn, msg *gomail.Message) (cause error, err error) {
+	s := gomail.SendFunc(func(from string, to []string, w io.WriterTo) error {
+		cause = c.sender.Send(from, to, w)
+		return cause
+	})
+
+	err = gomail.Send(s, msg)
+	return cause, err
+}
+
+func discard(c *conn) {
+	_ = c.sender.Close()
+}
+
+// isBroken reports whether the error comes from a connection the server has already closed
+func isBroken(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var te *textproto.Error
+	if errors.As(err, &te) {
+		return te.Code == 421
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ECONNRESET)
 }
@@ -0,0 +1,9 @@
+package push
+
+import "context"
+
+type disabledProvider struct{}
+
+func (p *disabledProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	return "", ErrDisabled
+}
@@ -0,0 +1,56 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// fcmProvider sends messages to an FCM HTTP v1 compatible "messages:send" endpoint
+type fcmProvider struct {
+	endpoint    string
+	accessToken string
+	client      *http.Client
+}
+
+type fcmRequest struct {
+	Message *Message `json:"message"`
+}
+
+// fcmResponse carries the message resource name, e.g. "projects/pasarly/messages/0:1500415314455276%31bd1c96"
+type fcmResponse struct {
+	Name string `json:"name"`
+}
+
+func (p *fcmProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	body, err := json.Marshal(fcmRequest{Message: msg})
+	if err != nil {
+		return "", fmt.Errorf("failed to send push: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to send push: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.accessToken)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send push: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return "", fmt.Errorf("failed to send push: unexpected status %d", res.StatusCode)
+	}
+
+	var out fcmResponse
+	_ = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Name, nil
+}
@@ -0,0 +1,44 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"go.uber.org/zap"
+)
+
+func Init(cfg *configs.Push, l *zap.Logger) (Provider, error) {
+	var p Provider
+
+	switch cfg.Driver {
+	case "", "none":
+		l.Sugar().Infof("✅ [PUSH] initialized (driver=none)")
+		return &disabledProvider{}, nil
+	case "log":
+		p = &logProvider{logger: l}
+	case "fcm":
+		if cfg.FCM.Endpoint == "" {
+			return nil, fmt.Errorf("failed to initialize push: endpoint is required by fcm driver")
+		}
+
+		p = &fcmProvider{
+			endpoint:    cfg.FCM.Endpoint,
+			accessToken: cfg.FCM.AccessToken,
+			client:      &http.Client{Timeout: cfg.FCM.Timeout},
+		}
+	default:
+		return nil, fmt.Errorf("failed to initialize push: unsupported driver %q", cfg.Driver)
+	}
+
+	if cfg.RateLimit.PerSecond > 0 {
+		p = &limitedProvider{
+			provider: p,
+			limiter:  utils.NewRateLimiter(cfg.RateLimit.PerSecond, cfg.RateLimit.Burst),
+		}
+	}
+
+	l.Sugar().Infof("✅ [PUSH] initialized (driver=%s, rate=%.2f/s)", cfg.Driver, cfg.RateLimit.PerSecond)
+	return p, nil
+}
@@ -0,0 +1,21 @@
+package push
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+)
+
+// limitedProvider holds sends back to the rate allowed by the underlying provider
+type limitedProvider struct {
+	provider Provider
+	limiter  *utils.RateLimiter
+}
+
+func (p *limitedProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return "", fmt.Errorf("failed to send push: %w", err)
+	}
+	return p.provider.Send(ctx, msg)
+}
@@ -0,0 +1,22 @@
+package push
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// logProvider writes every message to the log instead of delivering it, for local development
+type logProvider struct {
+	logger *zap.Logger
+}
+
+func (p *logProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	var title string
+	if msg.Notification != nil {
+		title = msg.Notification.Title
+	}
+
+	p.logger.Sugar().Infof("[PUSH] token=%s title=%q data=%v", msg.Token, title, msg.Data)
+	return "", nil
+}
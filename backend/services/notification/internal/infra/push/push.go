@@ -0,0 +1,39 @@
+package push
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrDisabled = errors.New("push provider is disabled")
+
+// Send returns the message ID assigned by the provider, which is empty when the provider does not assign one
+type Provider interface {
+	Send(ctx context.Context, msg *Message) (messageID string, err error)
+}
+
+func IsDisabled(p Provider) bool {
+	_, ok := p.(*disabledProvider)
+	return ok
+}
+
+// Message follows the shape of an FCM HTTP v1 message, targeting a single device token
+type Message struct {
+	Token        string            `json:"token"`
+	Notification *Notification     `json:"notification,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+	Webpush      *Webpush          `json:"webpush,omitempty"`
+}
+
+type Notification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type Webpush struct {
+	FCMOptions *WebpushFCMOptions `json:"fcm_options,omitempty"`
+}
+
+type WebpushFCMOptions struct {
+	Link string `json:"link,omitempty"`
+}
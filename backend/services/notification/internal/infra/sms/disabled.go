@@ -0,0 +1,9 @@
+package sms
+
+import "context"
+
+type disabledProvider struct{}
+
+func (p *disabledProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	return "", ErrDisabled
+}
@@ -0,0 +1,59 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// httpProvider posts messages as JSON to a generic SMS gateway
+type httpProvider struct {
+	endpoint string
+	apiKey   string
+	sender   string
+	client   *http.Client
+}
+
+type httpMessage struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+type httpResponse struct {
+	ID string `json:"id"`
+}
+
+func (p *httpProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	body, err := json.Marshal(httpMessage{From: p.sender, To: msg.To, Text: msg.Text})
+	if err != nil {
+		return "", fmt.Errorf("failed to send sms: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to send sms: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send sms: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return "", fmt.Errorf("failed to send sms: unexpected status %d", res.StatusCode)
+	}
+
+	// Gateways that do not echo an ID are still treated as accepted
+	var out httpResponse
+	_ = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.ID, nil
+}
@@ -0,0 +1,45 @@
+package sms
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"go.uber.org/zap"
+)
+
+func Init(cfg *configs.SMS, l *zap.Logger) (Provider, error) {
+	var p Provider
+
+	switch cfg.Driver {
+	case "", "none":
+		l.Sugar().Infof("✅ [SMS] initialized (driver=none)")
+		return &disabledProvider{}, nil
+	case "log":
+		p = &logProvider{logger: l}
+	case "http":
+		if cfg.HTTP.Endpoint == "" {
+			return nil, fmt.Errorf("failed to initialize sms: endpoint is required by http driver")
+		}
+
+		p = &httpProvider{
+			endpoint: cfg.HTTP.Endpoint,
+			apiKey:   cfg.HTTP.APIKey,
+			sender:   cfg.HTTP.Sender,
+			client:   &http.Client{Timeout: cfg.HTTP.Timeout},
+		}
+	default:
+		return nil, fmt.Errorf("failed to initialize sms: unsupported driver %q", cfg.Driver)
+	}
+
+	if cfg.RateLimit.PerSecond > 0 {
+		p = &limitedProvider{
+			provider: p,
+			limiter:  utils.NewRateLimiter(cfg.RateLimit.PerSecond, cfg.RateLimit.Burst),
+		}
+	}
+
+	l.Sugar().Infof("✅ [SMS] initialized (driver=%s, rate=%.2f/s)", cfg.Driver, cfg.RateLimit.PerSecond)
+	return p, nil
+}
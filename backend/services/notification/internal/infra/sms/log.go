@@ -0,0 +1,17 @@
+package sms
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// logProvider writes every message to the log instead of delivering it, for local development
+type logProvider struct {
+	logger *zap.Logger
+}
+
+func (p *logProvider) Send(ctx context.Context, msg *Message) (string, error) {
+	p.logger.Sugar().Infof("[SMS] to=%s text=%q", msg.To, msg.Text)
+	return "", nil
+}
@@ -0,0 +1,23 @@
+package sms
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrDisabled = errors.New("sms provider is disabled")
+
+// Send returns the message ID assigned by the provider, which is empty when the provider does not assign one
+type Provider interface {
+	Send(ctx context.Context, msg *Message) (messageID string, err error)
+}
+
+func IsDisabled(p Provider) bool {
+	_, ok := p.(*disabledProvider)
+	return ok
+}
+
+type Message struct {
+	To   string
+	Text string
+}
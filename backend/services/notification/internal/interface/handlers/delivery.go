@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const deliveryErrTracer string = "handler.delivery"
+
+type DeliveryHandler struct {
+	apis.UnimplementedNotificationDeliveryServiceServer
+	du usecases.DeliveryUsecase
+}
+
+func NewDeliveryHandler(du usecases.DeliveryUsecase) *DeliveryHandler {
+	return &DeliveryHandler{du: du}
+}
+
+func (h *DeliveryHandler) ListDeliveries(ctx context.Context, req *apis.ListDeliveriesRequest) (*apis.ListDeliveriesResponse, error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "ListDeliveries")
+	defer span.End()
+
+	data := models.ListDeliveries{
+		AuthID: req.GetAuthId(),
+		Page:   utils.UnwrapPageRequest(req.GetPage()),
+	}
+
+	deliveries, page, err := h.du.ListDeliveries(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := make([]*apis.Delivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		ds = append(ds, h.toDelivery(&d))
+	}
+
+	return &apis.ListDeliveriesResponse{Deliveries: ds, Page: utils.WrapPageInfo(page)}, nil
+}
+
+func (h *DeliveryHandler) ReportDeliveryEvent(ctx context.Context, req *apis.ReportDeliveryEventRequest) (*apis.ReportDeliveryEventResponse, error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "ReportDeliveryEvent")
+	defer span.End()
+
+	data := models.DeliveryEvent{
+		ProviderMessageID: req.GetProviderMessageId(),
+		Type:              req.GetType(),
+		BounceType:        req.GetBounceType(),
+		Reason:            req.GetReason(),
+	}
+
+	delivery, err := h.du.ReportDeliveryEvent(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.ReportDeliveryEventResponse{Delivery: h.toDelivery(delivery)}, nil
+}
+
+func (h *DeliveryHandler) toDelivery(d *models.Delivery) *apis.Delivery {
+	return &apis.Delivery{
+		Id:                d.ID,
+		EventId:           d.EventID,
+		Channel:           d.Channel,
+		Template:          d.Template,
+		Recipient:         d.Recipient,
+		ProviderMessageId: utils.WrapString(d.ProviderMessageID),
+		Status:            d.Status,
+		Attempts:          int32(d.Attempts),
+		Error:             utils.WrapString(d.Error),
+		CreatedAt:         timestamppb.New(d.CreatedAt),
+		UpdatedAt:         timestamppb.New(d.UpdatedAt),
+	}
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const inboxErrTracer string = "handler.inbox"
+
+type InboxHandler struct {
+	apis.UnimplementedNotificationInboxServiceServer
+	iu usecases.InboxUsecase
+}
+
+func NewInboxHandler(iu usecases.InboxUsecase) *InboxHandler {
+	return &InboxHandler{iu: iu}
+}
+
+func (h *InboxHandler) ListInbox(ctx context.Context, req *apis.ListInboxRequest) (*apis.ListInboxResponse, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "ListInbox")
+	defer span.End()
+
+	data := models.ListInboxItems{
+		AuthID: req.GetAuthId(),
+		Page:   utils.UnwrapPageRequest(req.GetPage()),
+	}
+
+	items, page, err := h.iu.ListInbox(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*apis.InboxNotification, 0, len(items))
+	for _, item := range items {
+		notifications = append(notifications, h.toInboxNotification(&item))
+	}
+
+	return &apis.ListInboxResponse{Notifications: notifications, Page: utils.WrapPageInfo(page)}, nil
+}
+
+func (h *InboxHandler) GetUnreadCount(ctx context.Context, req *apis.GetUnreadCountRequest) (*apis.GetUnreadCountResponse, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "GetUnreadCount")
+	defer span.End()
+
+	count, err := h.iu.GetUnreadCount(ctx, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetUnreadCountResponse{Total: count.Total, ByType: count.ByType}, nil
+}
+
+func (h *InboxHandler) MarkNotificationRead(ctx context.Context, req *apis.MarkNotificationReadRequest) (*apis.MarkNotificationReadResponse, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "MarkNotificationRead")
+	defer span.End()
+
+	item, err := h.iu.MarkNotificationRead(ctx, req.GetAuthId(), req.GetNotificationId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.MarkNotificationReadResponse{Notification: h.toInboxNotification(item)}, nil
+}
+
+func (h *InboxHandler) MarkAllNotificationsRead(ctx context.Context, req *apis.MarkAllNotificationsReadRequest) (*apis.MarkAllNotificationsReadResponse, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "MarkAllNotificationsRead")
+	defer span.End()
+
+	updated, err := h.iu.MarkAllNotificationsRead(ctx, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.MarkAllNotificationsReadResponse{Updated: updated}, nil
+}
+
+func (h *InboxHandler) ArchiveNotification(ctx context.Context, req *apis.ArchiveNotificationRequest) (*apis.ArchiveNotificationResponse, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "ArchiveNotification")
+	defer span.End()
+
+	item, err := h.iu.ArchiveNotification(ctx, req.GetAuthId(), req.GetNotificationId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.ArchiveNotificationResponse{Notification: h.toInboxNotification(item)}, nil
+}
+
+func (h *InboxHandler) toInboxNotification(item *models.InboxItem) *apis.InboxNotification {
+	return &apis.InboxNotification{
+		Id:         item.ID,
+		Type:       item.Type,
+		Title:      item.Title,
+		Body:       item.Body,
+		Url:        utils.WrapString(item.URL),
+		Data:       item.Data,
+		CreatedAt:  timestamppb.New(item.CreatedAt),
+		ReadAt:     utils.WrapTime(item.ReadAt),
+		ArchivedAt: utils.WrapTime(item.ArchivedAt),
+	}
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const notificationErrTracer string = "handler.notification"
+
+type NotificationHandler struct {
+	apis.UnimplementedNotificationServiceServer
+	nu usecases.NotificationUsecase
+}
+
+func NewNotificationHandler(nu usecases.NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{nu: nu}
+}
+
+func (h *NotificationHandler) ListNotificationHistory(ctx context.Context, req *apis.ListNotificationHistoryRequest) (*apis.ListNotificationHistoryResponse, error) {
+	ctx, span := otel.Tracer(notificationErrTracer).Start(ctx, "ListNotificationHistory")
+	defer span.End()
+
+	events, err := h.nu.ListNotificationHistory(ctx, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*apis.NotificationRecord, 0, len(events))
+	for _, e := range events {
+		records = append(records, &apis.NotificationRecord{
+			Id:          e.ID,
+			Type:        e.Type,
+			ProcessedAt: timestamppb.New(e.ProcessedAt),
+			CompletedAt: utils.WrapTime(e.CompletedAt),
+		})
+	}
+
+	return &apis.ListNotificationHistoryResponse{Records: records}, nil
+}
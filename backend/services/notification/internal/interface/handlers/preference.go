@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"go.opentelemetry.io/otel"
+)
+
+const preferenceErrTracer string = "handler.preference"
+
+type PreferenceHandler struct {
+	apis.UnimplementedNotificationPreferenceServiceServer
+	pu usecases.PreferenceUsecase
+}
+
+func NewPreferenceHandler(pu usecases.PreferenceUsecase) *PreferenceHandler {
+	return &PreferenceHandler{pu: pu}
+}
+
+func (h *PreferenceHandler) GetPreferences(ctx context.Context, req *apis.GetPreferencesRequest) (*apis.GetPreferencesResponse, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "GetPreferences")
+	defer span.End()
+
+	preferences, err := h.pu.GetPreferences(ctx, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetPreferencesResponse{Preferences: h.toPreferences(preferences)}, nil
+}
+
+func (h *PreferenceHandler) UpdatePreferences(ctx context.Context, req *apis.UpdatePreferencesRequest) (*apis.UpdatePreferencesResponse, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "UpdatePreferences")
+	defer span.End()
+
+	data := models.UpdatePreferences{
+		AuthID:      req.GetAuthId(),
+		Preferences: make([]models.UpdatePreference, 0, len(req.GetPreferences())),
+	}
+	for _, p := range req.GetPreferences() {
+		data.Preferences = append(data.Preferences, models.UpdatePreference{
+			Category: p.GetCategory(),
+			Channel:  p.GetChannel(),
+			Enabled:  p.GetEnabled(),
+		})
+	}
+
+	preferences, err := h.pu.UpdatePreferences(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.UpdatePreferencesResponse{Preferences: h.toPreferences(preferences)}, nil
+}
+
+func (h *PreferenceHandler) Unsubscribe(ctx context.Context, req *apis.UnsubscribeRequest) (*apis.UnsubscribeResponse, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "Unsubscribe")
+	defer span.End()
+
+	unsubscribed, err := h.pu.Unsubscribe(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.UnsubscribeResponse{Category: unsubscribed.Category, Channel: unsubscribed.Channel}, nil
+}
+
+func (h *PreferenceHandler) GetSchedule(ctx context.Context, req *apis.GetScheduleRequest) (*apis.GetScheduleResponse, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "GetSchedule")
+	defer span.End()
+
+	schedule, err := h.pu.GetSchedule(ctx, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetScheduleResponse{Schedule: h.toSchedule(schedule)}, nil
+}
+
+func (h *PreferenceHandler) UpdateSchedule(ctx context.Context, req *apis.UpdateScheduleRequest) (*apis.UpdateScheduleResponse, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "UpdateSchedule")
+	defer span.End()
+
+	data := models.UpdateSchedule{
+		AuthID:     req.GetAuthId(),
+		TimeZone:   req.GetSchedule().GetTimeZone(),
+		QuietStart: req.GetSchedule().GetQuietHoursStart(),
+		QuietEnd:   req.GetSchedule().GetQuietHoursEnd(),
+		Digest:     req.GetSchedule().GetDigest(),
+	}
+
+	schedule, err := h.pu.UpdateSchedule(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.UpdateScheduleResponse{Schedule: h.toSchedule(schedule)}, nil
+}
+
+func (h *PreferenceHandler) toSchedule(s *models.Schedule) *apis.NotificationSchedule {
+	return &apis.NotificationSchedule{
+		TimeZone:        s.TimeZone,
+		QuietHoursStart: utils.FormatClock(s.QuietStart),
+		QuietHoursEnd:   utils.FormatClock(s.QuietEnd),
+		Digest:          s.Digest,
+	}
+}
+
+func (h *PreferenceHandler) toPreferences(preferences []models.Preference) []*apis.NotificationPreference {
+	result := make([]*apis.NotificationPreference, 0, len(preferences))
+	for _, p := range preferences {
+		result = append(result, &apis.NotificationPreference{
+			Category:  p.Category,
+			Channel:   p.Channel,
+			Enabled:   p.Enabled,
+			Mandatory: p.Mandatory,
+		})
+	}
+	return result
+}
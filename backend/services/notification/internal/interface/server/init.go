@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/interface/handlers"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/interceptors"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"google.golang.org/grpc"
+)
+
+type Server struct {
+	config *configs.Server
+	server *grpc.Server
+	logger *logger.Logger
+}
+
+func Init(
+	cfg *configs.Server,
+	tls *configs.TLS,
+	r *mtls.Reloader,
+	l *logger.Logger,
+	nh *handlers.NotificationHandler,
+	ih *handlers.InboxHandler,
+	ph *handlers.PreferenceHandler,
+	dh *handlers.DeliveryHandler,
+) *Server {
+	opts := []grpc.ServerOption{interceptors.Unary(l.Base())}
+	if r != nil {
+		opts = append(
+			opts,
+			grpc.Creds(mtls.ServerCredentials(r)),
+			grpc.ChainUnaryInterceptor(mtls.AllowInterceptor(tls.Allow)),
+		)
+	}
+
+	s := grpc.NewServer(opts...)
+
+	apis.RegisterNotificationServiceServer(s, nh)
+	apis.RegisterNotificationInboxServiceServer(s, ih)
+	apis.RegisterNotificationPreferenceServiceServer(s, ph)
+	apis.RegisterNotificationDeliveryServiceServer(s, dh)
+
+	return &Server{config: cfg, server: s, logger: l}
+}
+
+func (s *Server) Start() error {
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", s.config.Host, s.config.Port))
+	if err != nil {
+		return fmt.Errorf("failed to initialize server: %w", err)
+	}
+
+	if err := s.server.Serve(l); err != nil {
+		return fmt.Errorf("failed to initialize server: %w", err)
+	}
+
+	s.logger.Sugar().Infof("✅ [SERVER] running on (host=%s, port=%d)", s.config.Host, s.config.Port)
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-ctx.Done():
+		s.server.Stop()
+		return fmt.Errorf("failed to shutdown server: %w", ctx.Err())
+	case <-stopped:
+		return nil
+	}
+}
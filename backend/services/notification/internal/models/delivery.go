@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+type Delivery struct {
+	ID                int64
+	EventID           string
+	Channel           string
+	Template          string
+	Recipient         string
+	ProviderMessageID *string
+	Status            string
+	Attempts          int
+	Error             *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type CreateDelivery struct {
+	EventID   string
+	AuthID    int64
+	Channel   string
+	Template  string
+	Recipient string
+}
+
+type ListDeliveries struct {
+	AuthID int64
+	Page   PageRequest
+}
+
+type UpdateDeliveryStatus struct {
+	ProviderMessageID string
+	Status            string
+	Error             string
+}
+
+// DeliveryEvent is a bounce or complaint reported back by a provider
+type DeliveryEvent struct {
+	ProviderMessageID string
+	Type              string
+	BounceType        string
+	Reason            string
+}
+
+type CreateSuppression struct {
+	Channel   string
+	Recipient string
+	Reason    string
+}
@@ -10,6 +10,7 @@ type Event struct {
 }
 
 type CreateEvent struct {
-	ID   string
-	Type string
+	ID     string
+	Type   string
+	AuthID int64
 }
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// Notification is a single message handed to the router, which fans it out to every channel routed for its type
+type Notification struct {
+	EventID      string
+	Type         string
+	Category     string
+	Locale       string
+	AuthID       int64
+	Email        string
+	Phone        string
+	DeviceTokens []string
+	Title        string
+	Body         string
+	URL          string
+	Data         map[string]string
+	Muted        []string
+	SendAt       time.Time
+}
+
+type InboxItem struct {
+	ID         int64
+	Type       string
+	Title      string
+	Body       string
+	URL        *string
+	Data       map[string]string
+	CreatedAt  time.Time
+	ReadAt     *time.Time
+	ArchivedAt *time.Time
+}
+
+type ListInboxItems struct {
+	AuthID int64
+	Page   PageRequest
+}
+
+type UnreadCount struct {
+	Total  int64
+	ByType map[string]int64
+}
+
+type CreateInboxItem struct {
+	EventID string
+	AuthID  int64
+	Type    string
+	Title   string
+	Body    string
+	URL     string
+	Data    map[string]string
+}
@@ -0,0 +1,22 @@
+package models
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+type PageRequest struct {
+	PageSize int
+	Cursor   string
+	Page     int
+	Sort     []SortField
+	Filters  map[string]string
+}
+
+type PageInfo struct {
+	PageSize   int
+	HasMore    bool
+	NextCursor string
+	Page       int
+	Total      *int
+}
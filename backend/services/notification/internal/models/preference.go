@@ -0,0 +1,25 @@
+package models
+
+type Preference struct {
+	Category  string
+	Channel   string
+	Enabled   bool
+	Mandatory bool
+}
+
+type UpdatePreference struct {
+	Category string
+	Channel  string
+	Enabled  bool
+}
+
+type UpdatePreferences struct {
+	AuthID      int64
+	Preferences []UpdatePreference
+}
+
+type Unsubscribe struct {
+	AuthID   int64
+	Category string
+	Channel  string
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Schedule holds the delivery timing of a user, quiet hours are minutes since local midnight
+type Schedule struct {
+	TimeZone   string
+	QuietStart *int
+	QuietEnd   *int
+	Digest     string
+}
+
+// UpdateSchedule takes quiet hours as "HH:MM", both empty to clear them
+type UpdateSchedule struct {
+	AuthID     int64
+	TimeZone   string
+	QuietStart string
+	QuietEnd   string
+	Digest     string
+}
+
+type UpsertSchedule struct {
+	AuthID   int64
+	Schedule Schedule
+}
+
+type ScheduledNotification struct {
+	ID           int64
+	Notification Notification
+	SendAt       time.Time
+	Attempts     int
+}
+
+type CreateScheduledNotification struct {
+	Notification *Notification
+	SendAt       time.Time
+	IsDigest     bool
+}
+
+type RetryScheduledNotifications struct {
+	IDs         []int64
+	Error       string
+	RetryAt     time.Time
+	MaxAttempts int
+}
@@ -0,0 +1,51 @@
+package preview
+
+import (
+	"maps"
+	"strconv"
+	"time"
+)
+
+// fixtures holds the variables every email is rendered with, keyed by notification type. The base keys mirror
+// the ones the email channel always supplies, the rest mirror the notification data of the type.
+var fixtures = map[string]map[string]string{
+	"welcome": {
+		"URL": "http://localhost:3000/auth/verify-account/confirm?token=preview",
+	},
+	"account_deleted": {},
+	"data_exported": {
+		"URL":            "http://localhost:8080/static/exports/preview.zip",
+		"UnsubscribeURL": "http://localhost:8080/api/v1/notifications/unsubscribe?token=preview",
+		"ExpiresAt":      time.Now().UTC().Add(7 * 24 * time.Hour).Format(time.RFC3339),
+	},
+	"new_device_sign_in": {
+		"URL":        "http://localhost:3000/auth/secure-account?token=preview",
+		"Browser":    "Chrome 120",
+		"OS":         "Windows",
+		"Location":   "Jakarta, Indonesia",
+		"IPAddress":  "203.0.113.42",
+		"SignedInAt": time.Now().UTC().Format(time.RFC3339),
+	},
+	"digest": {
+		"UnsubscribeURL": "http://localhost:8080/api/v1/notifications/unsubscribe?token=preview",
+		"Count":          "2",
+		"Items":          "Flash sale: Up to 50% off this weekend.\nNew arrivals: Fresh picks from sellers you follow.",
+	},
+}
+
+func fixture(name string) (map[string]string, bool) {
+	f, ok := fixtures[name]
+	if !ok {
+		return nil, false
+	}
+
+	data := map[string]string{
+		"Email":          "jane.doe@example.com",
+		"URL":            "",
+		"UnsubscribeURL": "",
+		"Year":           strconv.Itoa(time.Now().UTC().Year()),
+	}
+	maps.Copy(data, f)
+
+	return data, true
+}
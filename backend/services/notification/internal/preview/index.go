@@ -0,0 +1,63 @@
+package preview
+
+import "html/template"
+
+type row struct {
+	Locale     string
+	Name       string
+	Subject    string
+	Error      string
+	Translated bool
+}
+
+type page struct {
+	Fallback string
+	Message  string
+	Rows     []row
+}
+
+var index = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="UTF-8" />
+    <title>Pasarly Email Preview</title>
+    <style>
+      body { margin: 40px; font-family: Arial, sans-serif; color: #000000; }
+      table { border-collapse: collapse; width: 100%; }
+      th, td { padding: 8px 12px; border-bottom: 1px solid #e7f0fa; text-align: left; vertical-align: top; }
+      .ok { color: #1e7b34; }
+      .error { color: #b3261e; white-space: pre-wrap; }
+      .muted { color: #999999; }
+      .message { margin: 0 0 20px; padding: 10px 12px; background: #e7f0fa; }
+    </style>
+  </head>
+  <body>
+    <h1>Email Preview</h1>
+    {{if .Message}}<p class="message">{{.Message}}</p>{{end}}
+    <table>
+      <tr><th>Template</th><th>Locale</th><th>Subject</th><th>Status</th><th>Preview</th><th>Test Send</th></tr>
+      {{range .Rows}}
+      <tr>
+        <td>{{.Name}}</td>
+        <td>{{.Locale}}</td>
+        <td>{{.Subject}}</td>
+        <td>
+          {{if .Error}}<span class="error">{{.Error}}</span>{{else}}<span class="ok">OK</span>{{end}}
+          {{if not .Translated}}<br /><span class="muted">Not translated, falls back to {{$.Fallback}}</span>{{end}}
+        </td>
+        <td>
+          <a href="/preview/{{.Locale}}/{{.Name}}" target="_blank">HTML</a> &middot;
+          <a href="/preview/{{.Locale}}/{{.Name}}/text" target="_blank">Text</a>
+        </td>
+        <td>
+          <form method="POST" action="/send/{{.Locale}}/{{.Name}}">
+            <input type="email" name="to" value="dev@pasarly.local" required />
+            <button type="submit">Send</button>
+          </form>
+        </td>
+      </tr>
+      {{end}}
+    </table>
+  </body>
+</html>
+`))
@@ -0,0 +1,124 @@
+package preview
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/templates"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"gopkg.in/gomail.v2"
+)
+
+// Server renders every email with fixture data for designing templates without triggering real events,
+// it is meant for local development only and has no authentication
+type Server struct {
+	fallback string
+	from     string
+	renderer *templates.Renderer
+	dialer   *gomail.Dialer
+}
+
+// NewServer sends test messages through the SMTP server at host:port without credentials, e.g. a local Mailpit
+func NewServer(r *templates.Renderer, fallback, from, host string, port int) *Server {
+	return &Server{
+		fallback: fallback,
+		from:     from,
+		renderer: r,
+		dialer:   gomail.NewDialer(host, port, "", ""),
+	}
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.index)
+	mux.HandleFunc("GET /preview/{locale}/{name}", s.previewHTML)
+	mux.HandleFunc("GET /preview/{locale}/{name}/text", s.previewText)
+	mux.HandleFunc("POST /send/{locale}/{name}", s.send)
+	return mux
+}
+
+func (s *Server) index(w http.ResponseWriter, r *http.Request) {
+	p := page{Fallback: s.fallback, Message: r.URL.Query().Get("message")}
+
+	// Every email is listed in every locale so that missing translations stand out
+	for _, name := range s.renderer.Names() {
+		for _, locale := range s.renderer.Locales() {
+			rw := row{Locale: locale, Name: name, Translated: s.renderer.Translated(locale, name)}
+
+			email, err := s.render(locale, name)
+			if err != nil {
+				rw.Error = err.Error()
+			} else {
+				rw.Subject = email.Subject
+			}
+
+			p.Rows = append(p.Rows, rw)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := index.Execute(w, p); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) previewHTML(w http.ResponseWriter, r *http.Request) {
+	email, err := s.render(r.PathValue("locale"), r.PathValue("name"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, email.HTML)
+}
+
+func (s *Server) previewText(w http.ResponseWriter, r *http.Request) {
+	email, err := s.render(r.PathValue("locale"), r.PathValue("name"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "Subject: %s\n\n%s\n", email.Subject, email.Text)
+}
+
+func (s *Server) send(w http.ResponseWriter, r *http.Request) {
+	locale, name := r.PathValue("locale"), r.PathValue("name")
+
+	to := r.FormValue("to")
+	if to == "" {
+		http.Error(w, "recipient is not provided", http.StatusBadRequest)
+		return
+	}
+
+	message := fmt.Sprintf("Sent %s/%s to %s", locale, name, to)
+
+	email, err := s.render(locale, name)
+	if err == nil {
+		m := gomail.NewMessage()
+		m.SetHeader("From", s.from)
+		m.SetHeader("To", to)
+		m.SetHeader("Subject", utils.MIMEBase64(email.Subject))
+		m.SetBody("text/plain", email.Text)
+		m.AddAlternative("text/html", email.HTML)
+
+		err = s.dialer.DialAndSend(m)
+	}
+	if err != nil {
+		message = fmt.Sprintf("Failed to send %s/%s: %v", locale, name, err)
+	}
+
+	http.Redirect(w, r, "/?message="+url.QueryEscape(message), http.StatusSeeOther)
+}
+
+func (s *Server) render(locale, name string) (*templates.Email, error) {
+	data, ok := fixture(name)
+	if !ok {
+		return nil, fmt.Errorf("no fixture for %q, add one to the preview fixtures", name)
+	}
+
+	return s.renderer.Render(locale, name, data)
+}
@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/channels"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/scheduler"
 	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
 	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/idempotency"
 	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/protobuf/proto"
@@ -21,20 +22,34 @@ const authErrTracer string = "processor.auth"
 
 type AuthProcessor interface {
 	OnAuthCreated(ctx context.Context, m kafka.Message) (err error)
+	OnAuthDeleted(ctx context.Context, m kafka.Message) (err error)
+	OnNewDeviceSignIn(ctx context.Context, m kafka.Message) (err error)
 }
 
 type authProcessor struct {
-	timeout time.Duration
+	baseURL string
+	claimer *idempotency.Claimer
 	er      repositories.EventRepository
-	ec      channels.EmailChannel
+	ir      repositories.InboxRepository
+	pr      repositories.PreferenceRepository
+	dr      repositories.DeliveryRepository
+	sr      repositories.ScheduleRepository
+	qr      repositories.QueueRepository
+	sc      scheduler.Scheduler
 }
 
 func NewAuthProcessor(
 	er repositories.EventRepository,
-	ec channels.EmailChannel,
-	timeout time.Duration,
+	ir repositories.InboxRepository,
+	pr repositories.PreferenceRepository,
+	dr repositories.DeliveryRepository,
+	sr repositories.ScheduleRepository,
+	qr repositories.QueueRepository,
+	sc scheduler.Scheduler,
+	c *idempotency.Claimer,
+	baseURL string,
 ) AuthProcessor {
-	return &authProcessor{er: er, ec: ec, timeout: timeout}
+	return &authProcessor{er: er, ir: ir, pr: pr, dr: dr, sr: sr, qr: qr, sc: sc, claimer: c, baseURL: baseURL}
 }
 
 func (h *authProcessor) OnAuthCreated(ctx context.Context, m kafka.Message) error {
@@ -48,37 +63,156 @@ func (h *authProcessor) OnAuthCreated(ctx context.Context, m kafka.Message) erro
 		return e
 	}
 
-	event, err := h.er.GetEventByID(ctx, evt.GetEventId())
+	data := models.CreateEvent{
+		ID:     evt.GetEventId(),
+		Type:   constants.EventTopicAuthCreated,
+		AuthID: evt.GetAuthId(),
+	}
+
+	ctx, lease, err := claimEvent(ctx, span, h.claimer, &data)
+	if err != nil || lease == nil {
+		return err
+	}
+	defer lease.Release(ctx)
+
+	url, err := utils.URLWithToken(h.baseURL, "/auth/verify-account/confirm", evt.GetToken())
 	if err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	n := models.Notification{
+		EventID: evt.GetEventId(),
+		Type:    constants.NotificationTypeWelcome,
+		Locale:  evt.GetLocale(),
+		AuthID:  evt.GetAuthId(),
+		Email:   evt.GetEmail(),
+		Title:   "Welcome to Pasarly!",
+		Body:    "Verify your email address to start using your Pasarly account.",
+		URL:     url,
+	}
+	if err := dispatch(ctx, h.pr, h.sc, &n); err != nil {
 		return err
 	}
 
-	// Idempotency check
-	if event == nil {
-		data := models.CreateEvent{
-			ID:   evt.GetEventId(),
-			Type: constants.EventTopicAuthCreated,
-		}
+	return lease.Complete(ctx)
+}
+
+func (h *authProcessor) OnAuthDeleted(ctx context.Context, m kafka.Message) error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "OnAuthDeleted")
+	defer span.End()
 
-		if err := h.er.CreateEvent(ctx, &data); err != nil {
-			return err
-		}
+	var evt events.AuthDeleted
+	if err := proto.Unmarshal(m.Value, &evt); err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	data := models.CreateEvent{
+		ID:     evt.GetEventId(),
+		Type:   constants.EventTopicAuthDeleted,
+		AuthID: evt.GetAuthId(),
+	}
+
+	ctx, lease, err := claimEvent(ctx, span, h.claimer, &data)
+	if err != nil || lease == nil {
+		return err
+	}
+	defer lease.Release(ctx)
+
+	// Every record tied to the account is purged, except the one tracking this event
+	if err := h.er.DeleteEventsByAuthID(ctx, evt.GetAuthId(), evt.GetEventId()); err != nil {
+		return err
+	}
+
+	if err := h.ir.DeleteInboxItemsByAuthID(ctx, evt.GetAuthId()); err != nil {
+		return err
 	}
-	if event != nil {
-		if event.CompletedAt != nil {
-			return nil
-		}
 
-		if time.Since(event.ProcessedAt).Seconds() < h.timeout.Seconds() {
-			e := fmt.Errorf("failed to process message: %w", ce.ErrEventOnProcess)
-			utils.TraceErr(span, e, ce.MsgInternalServer)
-			return e
-		}
+	if err := h.pr.DeletePreferencesByAuthID(ctx, evt.GetAuthId()); err != nil {
+		return err
+	}
+
+	if err := h.dr.DeleteDeliveriesByAuthID(ctx, evt.GetAuthId()); err != nil {
+		return err
 	}
 
-	if err := h.ec.SendWelcome(ctx, evt.GetEmail(), evt.GetToken()); err != nil {
+	if err := h.sr.DeleteScheduleByAuthID(ctx, evt.GetAuthId()); err != nil {
+		return err
+	}
+
+	if err := h.qr.DeleteQueuedByAuthID(ctx, evt.GetAuthId()); err != nil {
+		return err
+	}
+
+	n := models.Notification{
+		EventID: evt.GetEventId(),
+		Type:    constants.NotificationTypeAccountDeleted,
+		Locale:  evt.GetLocale(),
+		Email:   evt.GetEmail(),
+		Title:   "Your Pasarly account has been deleted",
+		Body:    "Your Pasarly account and the personal data tied to it have been deleted.",
+	}
+	if err := dispatch(ctx, h.pr, h.sc, &n); err != nil {
+		return err
+	}
+
+	return lease.Complete(ctx)
+}
+
+func (h *authProcessor) OnNewDeviceSignIn(ctx context.Context, m kafka.Message) error {
+	ctx, span := otel.Tracer(authErrTracer).Start(ctx, "OnNewDeviceSignIn")
+	defer span.End()
+
+	var evt events.NewDeviceSignIn
+	if err := proto.Unmarshal(m.Value, &evt); err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	data := models.CreateEvent{
+		ID:     evt.GetEventId(),
+		Type:   constants.EventTopicNewDeviceSignIn,
+		AuthID: evt.GetAuthId(),
+	}
+
+	ctx, lease, err := claimEvent(ctx, span, h.claimer, &data)
+	if err != nil || lease == nil {
+		return err
+	}
+	defer lease.Release(ctx)
+
+	url, err := utils.URLWithToken(h.baseURL, "/auth/secure-account", evt.GetToken())
+	if err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	device := fmt.Sprintf("%s on %s", evt.GetBrowser(), evt.GetOs())
+	n := models.Notification{
+		EventID: evt.GetEventId(),
+		Type:    constants.NotificationTypeNewDeviceSignIn,
+		Locale:  evt.GetLocale(),
+		AuthID:  evt.GetAuthId(),
+		Email:   evt.GetEmail(),
+		Title:   "New sign-in to your Pasarly account",
+		Body:    fmt.Sprintf("Your account was signed in from %s. If this wasn't you, secure your account right away.", device),
+		URL:     url,
+		Data: map[string]string{
+			"Browser":    evt.GetBrowser(),
+			"OS":         evt.GetOs(),
+			"Location":   evt.GetLocation(),
+			"IPAddress":  evt.GetIpAddress(),
+			"SignedInAt": evt.GetSignedInAt().AsTime().UTC().Format(time.RFC3339),
+		},
+	}
+	if err := dispatch(ctx, h.pr, h.sc, &n); err != nil {
 		return err
 	}
 
-	return h.er.SetCompleted(ctx, evt.GetEventId())
+	return lease.Complete(ctx)
 }
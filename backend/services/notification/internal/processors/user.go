@@ -0,0 +1,82 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/scheduler"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/idempotency"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/proto"
+)
+
+const userErrTracer string = "processor.user"
+
+type UserProcessor interface {
+	OnUserDataExported(ctx context.Context, m kafka.Message) (err error)
+}
+
+type userProcessor struct {
+	claimer *idempotency.Claimer
+	pr      repositories.PreferenceRepository
+	sc      scheduler.Scheduler
+}
+
+func NewUserProcessor(
+	pr repositories.PreferenceRepository,
+	sc scheduler.Scheduler,
+	c *idempotency.Claimer,
+) UserProcessor {
+	return &userProcessor{pr: pr, sc: sc, claimer: c}
+}
+
+func (h *userProcessor) OnUserDataExported(ctx context.Context, m kafka.Message) error {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "OnUserDataExported")
+	defer span.End()
+
+	var evt events.UserDataExported
+	if err := proto.Unmarshal(m.Value, &evt); err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	data := models.CreateEvent{
+		ID:     evt.GetEventId(),
+		Type:   constants.EventTopicUserDataExported,
+		AuthID: evt.GetAuthId(),
+	}
+
+	ctx, lease, err := claimEvent(ctx, span, h.claimer, &data)
+	if err != nil || lease == nil {
+		return err
+	}
+	defer lease.Release(ctx)
+
+	expiresAt := evt.GetExpiresAt().AsTime().UTC()
+
+	n := models.Notification{
+		EventID: evt.GetEventId(),
+		Type:    constants.NotificationTypeDataExported,
+		Locale:  evt.GetLocale(),
+		AuthID:  evt.GetAuthId(),
+		Email:   evt.GetEmail(),
+		Title:   "Your Pasarly data export is ready",
+		Body:    fmt.Sprintf("Your data export is ready to download until %s.", expiresAt.Format("January 2, 2006 at 15:04 UTC")),
+		URL:     evt.GetDownloadUrl(),
+		Data:    map[string]string{"ExpiresAt": expiresAt.Format(time.RFC3339)},
+	}
+	if err := dispatch(ctx, h.pr, h.sc, &n); err != nil {
+		return err
+	}
+
+	return lease.Complete(ctx)
+}
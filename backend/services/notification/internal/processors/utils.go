@@ -0,0 +1,54 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/scheduler"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/idempotency"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// claimEvent leases the event to this instance, a nil lease means it has already been completed
+func claimEvent(
+	ctx context.Context,
+	s trace.Span,
+	c *idempotency.Claimer,
+	data *models.CreateEvent,
+) (context.Context, *idempotency.Lease, error) {
+	ctx, lease, err := c.Claim(ctx, data.ID, data.Type, data.AuthID)
+	if err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(s, e, ce.MsgInternalServer)
+		return ctx, nil, e
+	}
+
+	return ctx, lease, nil
+}
+
+// dispatch hands the notification to the scheduler after dropping the channels the recipient opted out of for
+// its category
+func dispatch(
+	ctx context.Context,
+	pr repositories.PreferenceRepository,
+	sc scheduler.Scheduler,
+	n *models.Notification,
+) error {
+	n.Category = constants.NotificationCategories[n.Type]
+
+	if n.AuthID != 0 && !slices.Contains(constants.MandatoryCategories, n.Category) {
+		muted, err := pr.GetMutedChannels(ctx, n.AuthID, n.Category)
+		if err != nil {
+			return err
+		}
+		n.Muted = muted
+	}
+
+	return sc.Submit(ctx, n)
+}
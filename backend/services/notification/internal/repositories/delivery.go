@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const deliveryErrTracer string = "repository.delivery"
+
+const deliveryColumns string = "id, event_id, channel, template, recipient, provider_message_id, status, attempts, error, created_at, updated_at"
+
+type DeliveryRepository interface {
+	ClaimDelivery(ctx context.Context, data *models.CreateDelivery) (delivery *models.Delivery, err error)
+	SetSent(ctx context.Context, deliveryID int64, providerMessageID string) (err error)
+	SetFailed(ctx context.Context, deliveryID int64, reason string) (err error)
+	UpdateStatus(ctx context.Context, data *models.UpdateDeliveryStatus) (delivery *models.Delivery, err error)
+	ListDeliveries(ctx context.Context, data *models.ListDeliveries) (deliveries []models.Delivery, page *models.PageInfo, err error)
+	DeleteDeliveriesByAuthID(ctx context.Context, authID int64) (err error)
+}
+
+type deliveryRepository struct {
+	database *database.Database
+}
+
+func NewDeliveryRepository(db *database.Database) DeliveryRepository {
+	return &deliveryRepository{database: db}
+}
+
+// ClaimDelivery returns nil when an earlier attempt already handed the message to the provider, so a redelivered event does not send it twice
+func (r *deliveryRepository) ClaimDelivery(ctx context.Context, data *models.CreateDelivery) (*models.Delivery, error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "ClaimDelivery")
+	defer span.End()
+
+	query := `
+		INSERT INTO deliveries (event_id, auth_id, channel, template, recipient)
+		VALUES ($1, NULLIF($2, 0), $3, $4, $5)
+		ON CONFLICT (event_id, channel, recipient)
+		DO UPDATE SET status = 'queued', attempts = deliveries.attempts + 1, error = NULL, updated_at = NOW()
+		WHERE deliveries.status IN ('queued', 'failed')
+		RETURNING ` + deliveryColumns
+
+	row := r.database.QueryRow(ctx, query, data.EventID, data.AuthID, data.Channel, data.Template, data.Recipient)
+
+	delivery, err := scanDelivery(row)
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to claim delivery: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return delivery, nil
+}
+
+func (r *deliveryRepository) SetSent(ctx context.Context, deliveryID int64, providerMessageID string) error {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "SetSent")
+	defer span.End()
+
+	query := `
+		UPDATE deliveries
+		SET status = 'sent', provider_message_id = NULLIF($2, ''), error = NULL, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	if err := r.database.Execute(ctx, query, deliveryID, providerMessageID); err != nil {
+		e := fmt.Errorf("failed to set delivery sent: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func (r *deliveryRepository) SetFailed(ctx context.Context, deliveryID int64, reason string) error {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "SetFailed")
+	defer span.End()
+
+	query := "UPDATE deliveries SET status = 'failed', error = $2, updated_at = NOW() WHERE id = $1"
+
+	if err := r.database.Execute(ctx, query, deliveryID, reason); err != nil {
+		e := fmt.Errorf("failed to set delivery failed: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+// UpdateStatus returns nil when no delivery carries the provider message ID
+func (r *deliveryRepository) UpdateStatus(ctx context.Context, data *models.UpdateDeliveryStatus) (*models.Delivery, error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "UpdateStatus")
+	defer span.End()
+
+	query := `
+		UPDATE deliveries
+		SET status = $2, error = NULLIF($3, ''), updated_at = NOW()
+		WHERE id = (
+			SELECT id FROM deliveries
+			WHERE provider_message_id = $1
+			ORDER BY created_at DESC
+			LIMIT 1
+		)
+		RETURNING ` + deliveryColumns
+
+	row := r.database.QueryRow(ctx, query, data.ProviderMessageID, data.Status, data.Error)
+
+	delivery, err := scanDelivery(row)
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to update delivery status: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return delivery, nil
+}
+
+func (r *deliveryRepository) ListDeliveries(ctx context.Context, data *models.ListDeliveries) ([]models.Delivery, *models.PageInfo, error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "ListDeliveries")
+	defer span.End()
+
+	keys := deliverySortKeys(data.Page.Sort)
+	conditions := []string{"auth_id = $1"}
+	args := []interface{}{data.AuthID}
+	argPos := 2
+
+	for _, field := range []string{"channel", "status"} {
+		if v, ok := data.Page.Filters[field]; ok {
+			conditions = append(conditions, fmt.Sprintf("%s = $%d", field, argPos))
+			args = append(args, v)
+			argPos++
+		}
+	}
+
+	page := models.PageInfo{PageSize: data.Page.PageSize, Page: data.Page.Page}
+
+	var limitClause string
+	if data.Page.Page > 0 {
+		query := "SELECT COUNT(*) FROM deliveries WHERE " + strings.Join(conditions, " AND ")
+
+		var total int
+		if err := r.database.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+			e := fmt.Errorf("failed to list deliveries: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, nil, e
+		}
+
+		page.Total = &total
+		limitClause = fmt.Sprintf("LIMIT %d OFFSET %d", data.Page.PageSize+1, (data.Page.Page-1)*data.Page.PageSize)
+	} else {
+		if data.Page.Cursor != "" {
+			values, err := decodeCursor(keys, data.Page.Cursor)
+			if err != nil {
+				e := fmt.Errorf("failed to list deliveries: %w", err)
+				utils.TraceErr(span, e, ce.MsgInvalidPayload)
+				return nil, nil, e
+			}
+
+			clause, cargs := keysetClause(keys, values, argPos)
+			conditions = append(conditions, clause)
+			args = append(args, cargs...)
+		}
+
+		limitClause = fmt.Sprintf("LIMIT %d", data.Page.PageSize+1)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM deliveries WHERE %s ORDER BY %s %s",
+		deliveryColumns, strings.Join(conditions, " AND "), orderClause(keys), limitClause,
+	)
+
+	rows, err := r.database.QueryAll(ctx, query, args...)
+	if err != nil {
+		e := fmt.Errorf("failed to list deliveries: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, nil, e
+	}
+	defer rows.Close()
+
+	deliveries := make([]models.Delivery, 0, data.Page.PageSize)
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			e := fmt.Errorf("failed to list deliveries: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, nil, e
+		}
+
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to list deliveries: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, nil, e
+	}
+
+	if len(deliveries) > data.Page.PageSize {
+		deliveries = deliveries[:data.Page.PageSize]
+		page.HasMore = true
+	}
+	if page.HasMore && data.Page.Page == 0 {
+		last := deliveries[len(deliveries)-1]
+		values := []string{last.CreatedAt.Format(time.RFC3339Nano), strconv.FormatInt(last.ID, 10)}
+		page.NextCursor = encodeCursor(keys, values)
+	}
+
+	return deliveries, &page, nil
+}
+
+func (r *deliveryRepository) DeleteDeliveriesByAuthID(ctx context.Context, authID int64) error {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "DeleteDeliveriesByAuthID")
+	defer span.End()
+
+	query := "DELETE FROM deliveries WHERE auth_id = $1"
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete deliveries by auth id: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func deliverySortKeys(sort []models.SortField) []sortKey {
+	desc := true
+	if len(sort) > 0 {
+		desc = sort[0].Desc
+	}
+
+	return []sortKey{
+		{column: "created_at", cast: "timestamptz", desc: desc},
+		{column: "id", cast: "bigint", desc: desc},
+	}
+}
+
+func scanDelivery(row scanner) (*models.Delivery, error) {
+	var d models.Delivery
+	err := row.Scan(
+		&d.ID, &d.EventID, &d.Channel, &d.Template, &d.Recipient, &d.ProviderMessageID,
+		&d.Status, &d.Attempts, &d.Error, &d.CreatedAt, &d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -15,9 +15,8 @@ import (
 const eventErrTracer string = "repository.event"
 
 type EventRepository interface {
-	CreateEvent(ctx context.Context, data *models.CreateEvent) (err error)
-	GetEventByID(ctx context.Context, eventID string) (event *models.Event, err error)
-	SetCompleted(ctx context.Context, eventID string) (err error)
+	DeleteEventsByAuthID(ctx context.Context, authID int64, exceptEventID string) (err error)
+	GetEventsByAuthID(ctx context.Context, authID int64) (events []models.Event, err error)
 }
 
 type eventRepository struct {
@@ -28,14 +27,18 @@ func NewEventRepository(db *database.Database) EventRepository {
 	return &eventRepository{database: db}
 }
 
-func (r *eventRepository) CreateEvent(ctx context.Context, data *models.CreateEvent) error {
-	ctx, span := otel.Tracer(eventErrTracer).Start(ctx, "CreateEvent")
+func (r *eventRepository) DeleteEventsByAuthID(ctx context.Context, authID int64, exceptEventID string) error {
+	ctx, span := otel.Tracer(eventErrTracer).Start(ctx, "DeleteEventsByAuthID")
 	defer span.End()
 
-	query := "INSERT INTO events (event_id, event_type) VALUES ($1, $2)"
+	query := "DELETE FROM events WHERE auth_id = $1 AND event_id <> $2"
 
-	if err := r.database.Execute(ctx, query, data.ID, data.Type); err != nil {
-		e := fmt.Errorf("failed to create event: %w", err)
+	if err := r.database.Execute(ctx, query, authID, exceptEventID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete events by auth id: %w", err)
 		utils.TraceErr(span, e, ce.MsgInternalServer)
 		return e
 	}
@@ -43,46 +46,42 @@ func (r *eventRepository) CreateEvent(ctx context.Context, data *models.CreateEv
 	return nil
 }
 
-func (r *eventRepository) GetEventByID(ctx context.Context, eventID string) (*models.Event, error) {
-	ctx, span := otel.Tracer(eventErrTracer).Start(ctx, "GetEventByID")
+func (r *eventRepository) GetEventsByAuthID(ctx context.Context, authID int64) ([]models.Event, error) {
+	ctx, span := otel.Tracer(eventErrTracer).Start(ctx, "GetEventsByAuthID")
 	defer span.End()
 
 	query := `
 		SELECT event_id, event_type, processed_at, completed_at
 		FROM events
-		WHERE event_id = $1
+		WHERE auth_id = $1
+		ORDER BY processed_at DESC
 	`
-	if r.database.InTx(ctx) {
-		query += " FOR UPDATE"
-	}
-
-	row := r.database.QueryRow(ctx, query, eventID)
 
-	var e models.Event
-	if err := row.Scan(&e.ID, &e.Type, &e.ProcessedAt, &e.CompletedAt); err != nil {
-		if errors.Is(err, ce.ErrDBReturnNoRows) {
-			return nil, nil
-		}
-
-		e := fmt.Errorf("failed to fetch event by id: %w", err)
+	rows, err := r.database.QueryAll(ctx, query, authID)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch events by auth id: %w", err)
 		utils.TraceErr(span, e, ce.MsgInternalServer)
 		return nil, e
 	}
+	defer rows.Close()
+
+	events := make([]models.Event, 0)
+	for rows.Next() {
+		var e models.Event
+		if err := rows.Scan(&e.ID, &e.Type, &e.ProcessedAt, &e.CompletedAt); err != nil {
+			e := fmt.Errorf("failed to fetch events by auth id: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, e
+		}
 
-	return &e, nil
-}
-
-func (r *eventRepository) SetCompleted(ctx context.Context, eventID string) error {
-	ctx, span := otel.Tracer(eventErrTracer).Start(ctx, "SetCompleted")
-	defer span.End()
-
-	query := "UPDATE events SET completed_at = NOW() WHERE event_id = $1"
+		events = append(events, e)
+	}
 
-	if err := r.database.Execute(ctx, query, eventID); err != nil {
-		e := fmt.Errorf("failed to set event completed: %w", err)
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to fetch events by auth id: %w", err)
 		utils.TraceErr(span, e, ce.MsgInternalServer)
-		return e
+		return nil, e
 	}
 
-	return nil
+	return events, nil
 }
@@ -0,0 +1,341 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const inboxErrTracer string = "repository.inbox"
+
+const inboxColumns string = "id, notification_type, title, body, url, data, created_at, read_at, archived_at"
+
+type InboxRepository interface {
+	CreateInboxItem(ctx context.Context, data *models.CreateInboxItem) (item *models.InboxItem, err error)
+	ListInboxItems(ctx context.Context, data *models.ListInboxItems) (items []models.InboxItem, page *models.PageInfo, err error)
+	CountUnread(ctx context.Context, authID int64) (count *models.UnreadCount, err error)
+	MarkRead(ctx context.Context, authID, itemID int64) (item *models.InboxItem, err error)
+	MarkAllRead(ctx context.Context, authID int64) (updated int64, err error)
+	Archive(ctx context.Context, authID, itemID int64) (item *models.InboxItem, err error)
+	DeleteInboxItemsByAuthID(ctx context.Context, authID int64) (err error)
+}
+
+type inboxRepository struct {
+	database *database.Database
+}
+
+type scanner interface {
+	Scan(dest ...any) (err error)
+}
+
+func NewInboxRepository(db *database.Database) InboxRepository {
+	return &inboxRepository{database: db}
+}
+
+// CreateInboxItem returns nil when the event already produced an inbox item, so a redelivery is not announced twice
+func (r *inboxRepository) CreateInboxItem(ctx context.Context, data *models.CreateInboxItem) (*models.InboxItem, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "CreateInboxItem")
+	defer span.End()
+
+	payload, err := json.Marshal(data.Data)
+	if err != nil {
+		e := fmt.Errorf("failed to create inbox item: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	query := `
+		INSERT INTO notifications (event_id, auth_id, notification_type, title, body, url, data)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), COALESCE($7::jsonb, '{}'))
+		ON CONFLICT (event_id, auth_id) DO NOTHING
+		RETURNING ` + inboxColumns
+
+	row := r.database.QueryRow(ctx, query, data.EventID, data.AuthID, data.Type, data.Title, data.Body, data.URL, payload)
+
+	item, err := scanInboxItem(row)
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to create inbox item: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return item, nil
+}
+
+func (r *inboxRepository) ListInboxItems(ctx context.Context, data *models.ListInboxItems) ([]models.InboxItem, *models.PageInfo, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "ListInboxItems")
+	defer span.End()
+
+	keys := inboxSortKeys(data.Page.Sort)
+	conditions := []string{"auth_id = $1"}
+	args := []interface{}{data.AuthID}
+	argPos := 2
+
+	switch data.Page.Filters["status"] {
+	case constants.InboxStatusUnread:
+		conditions = append(conditions, "read_at IS NULL", "archived_at IS NULL")
+	case constants.InboxStatusRead:
+		conditions = append(conditions, "read_at IS NOT NULL", "archived_at IS NULL")
+	case constants.InboxStatusArchived:
+		conditions = append(conditions, "archived_at IS NOT NULL")
+	default:
+		conditions = append(conditions, "archived_at IS NULL")
+	}
+	if v, ok := data.Page.Filters["type"]; ok {
+		conditions = append(conditions, fmt.Sprintf("notification_type = $%d", argPos))
+		args = append(args, v)
+		argPos++
+	}
+
+	page := models.PageInfo{PageSize: data.Page.PageSize, Page: data.Page.Page}
+
+	// Offset mode counts the matching rows, cursor mode skips the count and resumes after the last row seen
+	var limitClause string
+	if data.Page.Page > 0 {
+		query := "SELECT COUNT(*) FROM notifications WHERE " + strings.Join(conditions, " AND ")
+
+		var total int
+		if err := r.database.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+			e := fmt.Errorf("failed to list inbox items: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, nil, e
+		}
+
+		page.Total = &total
+		limitClause = fmt.Sprintf("LIMIT %d OFFSET %d", data.Page.PageSize+1, (data.Page.Page-1)*data.Page.PageSize)
+	} else {
+		if data.Page.Cursor != "" {
+			values, err := decodeCursor(keys, data.Page.Cursor)
+			if err != nil {
+				e := fmt.Errorf("failed to list inbox items: %w", err)
+				utils.TraceErr(span, e, ce.MsgInvalidPayload)
+				return nil, nil, e
+			}
+
+			clause, cargs := keysetClause(keys, values, argPos)
+			conditions = append(conditions, clause)
+			args = append(args, cargs...)
+		}
+
+		limitClause = fmt.Sprintf("LIMIT %d", data.Page.PageSize+1)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM notifications WHERE %s ORDER BY %s %s",
+		inboxColumns, strings.Join(conditions, " AND "), orderClause(keys), limitClause,
+	)
+
+	rows, err := r.database.QueryAll(ctx, query, args...)
+	if err != nil {
+		e := fmt.Errorf("failed to list inbox items: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, nil, e
+	}
+	defer rows.Close()
+
+	items := make([]models.InboxItem, 0, data.Page.PageSize)
+	for rows.Next() {
+		item, err := scanInboxItem(rows)
+		if err != nil {
+			e := fmt.Errorf("failed to list inbox items: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, nil, e
+		}
+
+		items = append(items, *item)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to list inbox items: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, nil, e
+	}
+
+	// One extra row is fetched to tell whether another page follows
+	if len(items) > data.Page.PageSize {
+		items = items[:data.Page.PageSize]
+		page.HasMore = true
+	}
+	if page.HasMore && data.Page.Page == 0 {
+		last := items[len(items)-1]
+		values := []string{last.CreatedAt.Format(time.RFC3339Nano), strconv.FormatInt(last.ID, 10)}
+		page.NextCursor = encodeCursor(keys, values)
+	}
+
+	return items, &page, nil
+}
+
+func (r *inboxRepository) CountUnread(ctx context.Context, authID int64) (*models.UnreadCount, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "CountUnread")
+	defer span.End()
+
+	query := `
+		SELECT notification_type, COUNT(*)
+		FROM notifications
+		WHERE auth_id = $1 AND read_at IS NULL AND archived_at IS NULL
+		GROUP BY notification_type
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, authID)
+	if err != nil {
+		e := fmt.Errorf("failed to count unread inbox items: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+	defer rows.Close()
+
+	count := models.UnreadCount{ByType: make(map[string]int64)}
+	for rows.Next() {
+		var t string
+		var n int64
+		if err := rows.Scan(&t, &n); err != nil {
+			e := fmt.Errorf("failed to count unread inbox items: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, e
+		}
+
+		count.ByType[t] = n
+		count.Total += n
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to count unread inbox items: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return &count, nil
+}
+
+// MarkRead returns nil when the item does not exist, marking an already read item keeps its original read time
+func (r *inboxRepository) MarkRead(ctx context.Context, authID, itemID int64) (*models.InboxItem, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "MarkRead")
+	defer span.End()
+
+	query := `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, NOW())
+		WHERE id = $1 AND auth_id = $2
+		RETURNING ` + inboxColumns
+
+	item, err := scanInboxItem(r.database.QueryRow(ctx, query, itemID, authID))
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to mark inbox item read: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return item, nil
+}
+
+func (r *inboxRepository) MarkAllRead(ctx context.Context, authID int64) (int64, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "MarkAllRead")
+	defer span.End()
+
+	query := `
+		WITH updated AS (
+			UPDATE notifications
+			SET read_at = NOW()
+			WHERE auth_id = $1 AND read_at IS NULL AND archived_at IS NULL
+			RETURNING 1
+		)
+		SELECT COUNT(*) FROM updated
+	`
+
+	var updated int64
+	if err := r.database.QueryRow(ctx, query, authID).Scan(&updated); err != nil {
+		e := fmt.Errorf("failed to mark all inbox items read: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return 0, e
+	}
+
+	return updated, nil
+}
+
+// Archive returns nil when the item does not exist, archiving also marks the item read
+func (r *inboxRepository) Archive(ctx context.Context, authID, itemID int64) (*models.InboxItem, error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "Archive")
+	defer span.End()
+
+	query := `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, NOW()), archived_at = COALESCE(archived_at, NOW())
+		WHERE id = $1 AND auth_id = $2
+		RETURNING ` + inboxColumns
+
+	item, err := scanInboxItem(r.database.QueryRow(ctx, query, itemID, authID))
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to archive inbox item: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return item, nil
+}
+
+func (r *inboxRepository) DeleteInboxItemsByAuthID(ctx context.Context, authID int64) error {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "DeleteInboxItemsByAuthID")
+	defer span.End()
+
+	query := "DELETE FROM notifications WHERE auth_id = $1"
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete inbox items by auth id: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func inboxSortKeys(sort []models.SortField) []sortKey {
+	desc := true
+	if len(sort) > 0 {
+		desc = sort[0].Desc
+	}
+
+	return []sortKey{
+		{column: "created_at", cast: "timestamptz", desc: desc},
+		{column: "id", cast: "bigint", desc: desc},
+	}
+}
+
+func scanInboxItem(row scanner) (*models.InboxItem, error) {
+	var item models.InboxItem
+	err := row.Scan(
+		&item.ID, &item.Type, &item.Title, &item.Body, &item.URL, &item.Data,
+		&item.CreatedAt, &item.ReadAt, &item.ArchivedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
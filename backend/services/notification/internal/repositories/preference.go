@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const preferenceErrTracer string = "repository.preference"
+
+// Only deviations from the default are stored, a missing row means the channel is enabled
+type PreferenceRepository interface {
+	GetPreferences(ctx context.Context, authID int64) (preferences []models.Preference, err error)
+	GetMutedChannels(ctx context.Context, authID int64, category string) (channels []string, err error)
+	UpsertPreferences(ctx context.Context, data *models.UpdatePreferences) (err error)
+	DeletePreferencesByAuthID(ctx context.Context, authID int64) (err error)
+}
+
+type preferenceRepository struct {
+	database *database.Database
+}
+
+func NewPreferenceRepository(db *database.Database) PreferenceRepository {
+	return &preferenceRepository{database: db}
+}
+
+func (r *preferenceRepository) GetPreferences(ctx context.Context, authID int64) ([]models.Preference, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "GetPreferences")
+	defer span.End()
+
+	query := "SELECT category, channel, enabled FROM notification_preferences WHERE auth_id = $1"
+
+	rows, err := r.database.QueryAll(ctx, query, authID)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch preferences: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+	defer rows.Close()
+
+	preferences := make([]models.Preference, 0)
+	for rows.Next() {
+		var p models.Preference
+		if err := rows.Scan(&p.Category, &p.Channel, &p.Enabled); err != nil {
+			e := fmt.Errorf("failed to fetch preferences: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, e
+		}
+
+		preferences = append(preferences, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to fetch preferences: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return preferences, nil
+}
+
+func (r *preferenceRepository) GetMutedChannels(ctx context.Context, authID int64, category string) ([]string, error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "GetMutedChannels")
+	defer span.End()
+
+	query := `
+		SELECT channel
+		FROM notification_preferences
+		WHERE auth_id = $1 AND category = $2 AND NOT enabled
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, authID, category)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch muted channels: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+	defer rows.Close()
+
+	channels := make([]string, 0)
+	for rows.Next() {
+		var c string
+		if err := rows.Scan(&c); err != nil {
+			e := fmt.Errorf("failed to fetch muted channels: %w", err)
+			utils.TraceErr(span, e, ce.MsgInternalServer)
+			return nil, e
+		}
+
+		channels = append(channels, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to fetch muted channels: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return channels, nil
+}
+
+func (r *preferenceRepository) UpsertPreferences(ctx context.Context, data *models.UpdatePreferences) error {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "UpsertPreferences")
+	defer span.End()
+
+	categories := make([]string, 0, len(data.Preferences))
+	channels := make([]string, 0, len(data.Preferences))
+	enabled := make([]bool, 0, len(data.Preferences))
+	for _, p := range data.Preferences {
+		categories = append(categories, p.Category)
+		channels = append(channels, p.Channel)
+		enabled = append(enabled, p.Enabled)
+	}
+
+	query := `
+		INSERT INTO notification_preferences (auth_id, category, channel, enabled)
+		SELECT $1, * FROM UNNEST($2::varchar[], $3::varchar[], $4::boolean[])
+		ON CONFLICT (auth_id, category, channel)
+		DO UPDATE SET enabled = EXCLUDED.enabled, updated_at = NOW()
+	`
+
+	if err := r.database.Execute(ctx, query, data.AuthID, categories, channels, enabled); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to upsert preferences: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func (r *preferenceRepository) DeletePreferencesByAuthID(ctx context.Context, authID int64) error {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "DeletePreferencesByAuthID")
+	defer span.End()
+
+	query := "DELETE FROM notification_preferences WHERE auth_id = $1"
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete preferences by auth id: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
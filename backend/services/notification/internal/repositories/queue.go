@@ -0,0 +1,205 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const queueErrTracer string = "repository.queue"
+
+const queueColumns string = "id, payload, send_at, attempts"
+
+// QueueRepository stores notifications held back until their send time, the whole notification is kept as JSON
+type QueueRepository interface {
+	Enqueue(ctx context.Context, data *models.CreateScheduledNotification) (err error)
+	ListDue(ctx context.Context, now time.Time, limit int) (notifications []models.ScheduledNotification, err error)
+	ListDueDigests(ctx context.Context, now time.Time, limit int) (notifications []models.ScheduledNotification, err error)
+	SetDispatched(ctx context.Context, ids []int64) (err error)
+	SetRetry(ctx context.Context, data *models.RetryScheduledNotifications) (err error)
+	DeleteQueuedByAuthID(ctx context.Context, authID int64) (err error)
+}
+
+type queueRepository struct {
+	database *database.Database
+}
+
+func NewQueueRepository(db *database.Database) QueueRepository {
+	return &queueRepository{database: db}
+}
+
+// Enqueue ignores a notification already queued by an earlier delivery of the same event
+func (r *queueRepository) Enqueue(ctx context.Context, data *models.CreateScheduledNotification) error {
+	ctx, span := otel.Tracer(queueErrTracer).Start(ctx, "Enqueue")
+	defer span.End()
+
+	payload, err := json.Marshal(data.Notification)
+	if err != nil {
+		e := fmt.Errorf("failed to enqueue notification: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	query := `
+		INSERT INTO scheduled_notifications (event_id, auth_id, notification_type, payload, send_at, is_digest)
+		VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6)
+		ON CONFLICT (event_id, notification_type) DO NOTHING
+	`
+
+	n := data.Notification
+	if err := r.database.Execute(ctx, query, n.EventID, n.AuthID, n.Type, payload, data.SendAt, data.IsDigest); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to enqueue notification: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func (r *queueRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]models.ScheduledNotification, error) {
+	ctx, span := otel.Tracer(queueErrTracer).Start(ctx, "ListDue")
+	defer span.End()
+
+	query := `
+		SELECT ` + queueColumns + `
+		FROM scheduled_notifications
+		WHERE status = 'pending' AND NOT is_digest AND send_at <= $1
+		ORDER BY send_at
+		LIMIT $2
+	`
+
+	notifications, err := r.list(ctx, query, now, limit)
+	if err != nil {
+		e := fmt.Errorf("failed to list due notifications: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return notifications, nil
+}
+
+// ListDueDigests returns every due digest item of up to limit users, ordered by user, so no digest is split
+func (r *queueRepository) ListDueDigests(ctx context.Context, now time.Time, limit int) ([]models.ScheduledNotification, error) {
+	ctx, span := otel.Tracer(queueErrTracer).Start(ctx, "ListDueDigests")
+	defer span.End()
+
+	query := `
+		SELECT ` + queueColumns + `
+		FROM scheduled_notifications
+		WHERE status = 'pending' AND is_digest AND send_at <= $1 AND auth_id IN (
+			SELECT DISTINCT auth_id
+			FROM scheduled_notifications
+			WHERE status = 'pending' AND is_digest AND send_at <= $1
+			LIMIT $2
+		)
+		ORDER BY auth_id, send_at
+	`
+
+	notifications, err := r.list(ctx, query, now, limit)
+	if err != nil {
+		e := fmt.Errorf("failed to list due digests: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return notifications, nil
+}
+
+func (r *queueRepository) SetDispatched(ctx context.Context, ids []int64) error {
+	ctx, span := otel.Tracer(queueErrTracer).Start(ctx, "SetDispatched")
+	defer span.End()
+
+	query := `
+		UPDATE scheduled_notifications
+		SET status = 'dispatched', attempts = attempts + 1, last_error = NULL, updated_at = NOW()
+		WHERE id = ANY($1)
+	`
+
+	if err := r.database.Execute(ctx, query, ids); err != nil {
+		e := fmt.Errorf("failed to set notifications dispatched: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+// SetRetry pushes the notifications back to the retry time, giving up on those out of attempts
+func (r *queueRepository) SetRetry(ctx context.Context, data *models.RetryScheduledNotifications) error {
+	ctx, span := otel.Tracer(queueErrTracer).Start(ctx, "SetRetry")
+	defer span.End()
+
+	query := `
+		UPDATE scheduled_notifications
+		SET
+			attempts = attempts + 1,
+			last_error = $2,
+			send_at = $3,
+			status = CASE WHEN attempts + 1 >= $4 THEN 'failed' ELSE 'pending' END,
+			updated_at = NOW()
+		WHERE id = ANY($1)
+	`
+
+	if err := r.database.Execute(ctx, query, data.IDs, data.Error, data.RetryAt, data.MaxAttempts); err != nil {
+		e := fmt.Errorf("failed to set notifications retry: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func (r *queueRepository) DeleteQueuedByAuthID(ctx context.Context, authID int64) error {
+	ctx, span := otel.Tracer(queueErrTracer).Start(ctx, "DeleteQueuedByAuthID")
+	defer span.End()
+
+	query := "DELETE FROM scheduled_notifications WHERE auth_id = $1"
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete queued notifications by auth id: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
+
+func (r *queueRepository) list(ctx context.Context, query string, args ...any) ([]models.ScheduledNotification, error) {
+	rows, err := r.database.QueryAll(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]models.ScheduledNotification, 0)
+	for rows.Next() {
+		var sn models.ScheduledNotification
+		var payload []byte
+		if err := rows.Scan(&sn.ID, &payload, &sn.SendAt, &sn.Attempts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(payload, &sn.Notification); err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, sn)
+	}
+
+	return notifications, rows.Err()
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const scheduleErrTracer string = "repository.schedule"
+
+type ScheduleRepository interface {
+	GetSchedule(ctx context.Context, authID int64) (schedule *models.Schedule, err error)
+	UpsertSchedule(ctx context.Context, data *models.UpsertSchedule) (schedule *models.Schedule, err error)
+	DeleteScheduleByAuthID(ctx context.Context, authID int64) (err error)
+}
+
+type scheduleRepository struct {
+	database *database.Database
+}
+
+func NewScheduleRepository(db *database.Database) ScheduleRepository {
+	return &scheduleRepository{database: db}
+}
+
+// GetSchedule returns the default schedule, in UTC without quiet hours or digest, for users who never set one
+func (r *scheduleRepository) GetSchedule(ctx context.Context, authID int64) (*models.Schedule, error) {
+	ctx, span := otel.Tracer(scheduleErrTracer).Start(ctx, "GetSchedule")
+	defer span.End()
+
+	query := "SELECT time_zone, quiet_start, quiet_end, digest FROM notification_schedules WHERE auth_id = $1"
+
+	var s models.Schedule
+	if err := r.database.QueryRow(ctx, query, authID).Scan(&s.TimeZone, &s.QuietStart, &s.QuietEnd, &s.Digest); err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return &models.Schedule{TimeZone: constants.TimeZoneDefault, Digest: constants.DigestOff}, nil
+		}
+
+		e := fmt.Errorf("failed to fetch schedule: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return &s, nil
+}
+
+func (r *scheduleRepository) UpsertSchedule(ctx context.Context, data *models.UpsertSchedule) (*models.Schedule, error) {
+	ctx, span := otel.Tracer(scheduleErrTracer).Start(ctx, "UpsertSchedule")
+	defer span.End()
+
+	query := `
+		INSERT INTO notification_schedules (auth_id, time_zone, quiet_start, quiet_end, digest)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (auth_id)
+		DO UPDATE SET
+			time_zone = EXCLUDED.time_zone,
+			quiet_start = EXCLUDED.quiet_start,
+			quiet_end = EXCLUDED.quiet_end,
+			digest = EXCLUDED.digest,
+			updated_at = NOW()
+		RETURNING time_zone, quiet_start, quiet_end, digest
+	`
+
+	row := r.database.QueryRow(
+		ctx, query,
+		data.AuthID, data.Schedule.TimeZone, data.Schedule.QuietStart, data.Schedule.QuietEnd, data.Schedule.Digest,
+	)
+
+	var s models.Schedule
+	if err := row.Scan(&s.TimeZone, &s.QuietStart, &s.QuietEnd, &s.Digest); err != nil {
+		e := fmt.Errorf("failed to upsert schedule: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return nil, e
+	}
+
+	return &s, nil
+}
+
+func (r *scheduleRepository) DeleteScheduleByAuthID(ctx context.Context, authID int64) error {
+	ctx, span := otel.Tracer(scheduleErrTracer).Start(ctx, "DeleteScheduleByAuthID")
+	defer span.End()
+
+	query := "DELETE FROM notification_schedules WHERE auth_id = $1"
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete schedule by auth id: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
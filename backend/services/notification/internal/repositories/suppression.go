@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const suppressionErrTracer string = "repository.suppression"
+
+// Suppressions are keyed by address rather than account, so they outlive the account that bounced
+type SuppressionRepository interface {
+	IsSuppressed(ctx context.Context, channel, recipient string) (suppressed bool, err error)
+	CreateSuppression(ctx context.Context, data *models.CreateSuppression) (err error)
+}
+
+type suppressionRepository struct {
+	database *database.Database
+}
+
+func NewSuppressionRepository(db *database.Database) SuppressionRepository {
+	return &suppressionRepository{database: db}
+}
+
+func (r *suppressionRepository) IsSuppressed(ctx context.Context, channel, recipient string) (bool, error) {
+	ctx, span := otel.Tracer(suppressionErrTracer).Start(ctx, "IsSuppressed")
+	defer span.End()
+
+	query := "SELECT EXISTS (SELECT 1 FROM suppressions WHERE channel = $1 AND recipient = $2)"
+
+	var suppressed bool
+	if err := r.database.QueryRow(ctx, query, channel, recipient).Scan(&suppressed); err != nil {
+		e := fmt.Errorf("failed to check suppression: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return false, e
+	}
+
+	return suppressed, nil
+}
+
+func (r *suppressionRepository) CreateSuppression(ctx context.Context, data *models.CreateSuppression) error {
+	ctx, span := otel.Tracer(suppressionErrTracer).Start(ctx, "CreateSuppression")
+	defer span.End()
+
+	query := `
+		INSERT INTO suppressions (channel, recipient, reason)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (channel, recipient) DO NOTHING
+	`
+
+	if err := r.database.Execute(ctx, query, data.Channel, data.Recipient, data.Reason); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to create suppression: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/channels"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const schedulerErrTracer string = "scheduler"
+
+// Scheduler sits in front of the router and decides when a notification goes out
+type Scheduler interface {
+	Submit(ctx context.Context, n *models.Notification) (err error)
+	DispatchDue(ctx context.Context) (count int, err error)
+	DispatchDigests(ctx context.Context) (count int, err error)
+}
+
+type scheduler struct {
+	cfg *configs.Scheduler
+	rt  channels.Router
+	sr  repositories.ScheduleRepository
+	qr  repositories.QueueRepository
+}
+
+func NewScheduler(
+	cfg *configs.Scheduler,
+	rt channels.Router,
+	sr repositories.ScheduleRepository,
+	qr repositories.QueueRepository,
+) Scheduler {
+	return &scheduler{cfg: cfg, rt: rt, sr: sr, qr: qr}
+}
+
+// Submit dispatches right away when nothing holds the notification back, otherwise it is queued until its send
+// time, or until the next digest of the user when its priority is low
+func (s *scheduler) Submit(ctx context.Context, n *models.Notification) error {
+	ctx, span := otel.Tracer(schedulerErrTracer).Start(ctx, "Submit")
+	defer span.End()
+
+	now := time.Now().UTC()
+	sendAt := now
+	if n.SendAt.After(now) {
+		sendAt = n.SendAt
+	}
+
+	priority, ok := constants.CategoryPriorities[n.Category]
+	if !ok {
+		priority = constants.PriorityNormal
+	}
+
+	isDigest := false
+	if n.AuthID != 0 && priority != constants.PriorityHigh {
+		schedule, err := s.sr.GetSchedule(ctx, n.AuthID)
+		if err != nil {
+			return err
+		}
+
+		if priority == constants.PriorityLow && schedule.Digest != constants.DigestOff {
+			sendAt = utils.NextDigestTime(schedule, sendAt, s.cfg.DigestHour)
+			isDigest = true
+		} else {
+			sendAt = utils.AfterQuietHours(schedule, sendAt)
+		}
+	}
+
+	if !isDigest && !sendAt.After(now) {
+		return s.rt.Dispatch(ctx, n)
+	}
+
+	span.SetAttributes(attribute.String("notification.send_at", sendAt.Format(time.RFC3339)))
+	return s.qr.Enqueue(ctx, &models.CreateScheduledNotification{Notification: n, SendAt: sendAt, IsDigest: isDigest})
+}
+
+func (s *scheduler) DispatchDue(ctx context.Context) (int, error) {
+	ctx, span := otel.Tracer(schedulerErrTracer).Start(ctx, "DispatchDue")
+	defer span.End()
+
+	due, err := s.qr.ListDue(ctx, time.Now().UTC(), s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, sn := range due {
+		if err := s.dispatch(ctx, &sn.Notification, sn.Attempts, sn.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(due), nil
+}
+
+// DispatchDigests sends one digest per user, the count is the number of users
+func (s *scheduler) DispatchDigests(ctx context.Context) (int, error) {
+	ctx, span := otel.Tracer(schedulerErrTracer).Start(ctx, "DispatchDigests")
+	defer span.End()
+
+	due, err := s.qr.ListDueDigests(ctx, time.Now().UTC(), s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for start := 0; start < len(due); {
+		end := start + 1
+		for end < len(due) && due[end].Notification.AuthID == due[start].Notification.AuthID {
+			end++
+		}
+
+		group := due[start:end]
+		ids := make([]int64, 0, len(group))
+		for _, sn := range group {
+			ids = append(ids, sn.ID)
+		}
+
+		if err := s.dispatch(ctx, s.buildDigest(group), group[0].Attempts, ids...); err != nil {
+			return 0, err
+		}
+
+		count++
+		start = end
+	}
+
+	return count, nil
+}
+
+// dispatch only fails on storage errors, a failed send is recorded and retried with a growing delay
+func (s *scheduler) dispatch(ctx context.Context, n *models.Notification, attempts int, ids ...int64) error {
+	err := s.rt.Dispatch(ctx, n)
+	if err == nil {
+		return s.qr.SetDispatched(ctx, ids)
+	}
+
+	data := models.RetryScheduledNotifications{
+		IDs:         ids,
+		Error:       err.Error(),
+		RetryAt:     time.Now().UTC().Add(s.cfg.RetryDelay * time.Duration(attempts+1)),
+		MaxAttempts: s.cfg.MaxAttempts,
+	}
+	if e := s.qr.SetRetry(ctx, &data); e != nil {
+		return errors.Join(err, e)
+	}
+
+	return nil
+}
+
+// buildDigest takes the recipient from the most recent item, the event ID stays the same across retries so
+// channels that already delivered it are skipped
+func (s *scheduler) buildDigest(group []models.ScheduledNotification) *models.Notification {
+	last := group[len(group)-1].Notification
+
+	items := make([]string, 0, len(group))
+	for _, sn := range group {
+		items = append(items, sn.Notification.Title+": "+sn.Notification.Body)
+	}
+
+	return &models.Notification{
+		EventID:  fmt.Sprintf("digest:%d:%d", last.AuthID, group[0].ID),
+		Type:     constants.NotificationTypeDigest,
+		Category: constants.NotificationCategories[constants.NotificationTypeDigest],
+		Locale:   last.Locale,
+		AuthID:   last.AuthID,
+		Email:    last.Email,
+		Muted:    last.Muted,
+		Title:    "Your Pasarly digest",
+		Body:     fmt.Sprintf("You have %d updates from Pasarly.", len(group)),
+		Data: map[string]string{
+			"Count": strconv.Itoa(len(group)),
+			"Items": strings.Join(items, "\n"),
+		},
+	}
+}
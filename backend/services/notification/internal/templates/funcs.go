@@ -0,0 +1,46 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var monthsID = [12]string{
+	"Januari", "Februari", "Maret", "April", "Mei", "Juni",
+	"Juli", "Agustus", "September", "Oktober", "November", "Desember",
+}
+
+func funcs(locale string) map[string]any {
+	return map[string]any{
+		"locale":   func() string { return locale },
+		"datetime": datetime(locale),
+		"lines":    lines,
+	}
+}
+
+// lines splits a value the sender joined with newlines, such as the items of a digest
+func lines(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, "\n")
+}
+
+// datetime formats an RFC 3339 value in UTC, values that fail to parse are printed as they are
+func datetime(locale string) func(value string) string {
+	return func(value string) string {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return value
+		}
+
+		t = t.UTC()
+		switch locale {
+		case "id":
+			return fmt.Sprintf("%d %s %d pukul %s UTC", t.Day(), monthsID[t.Month()-1], t.Year(), t.Format("15:04"))
+		default:
+			return t.Format("January 2, 2006 at 15:04 UTC")
+		}
+	}
+}
@@ -1,6 +1,169 @@
 package templates
 
-import "embed"
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+)
 
-//go:embed *.html.tmpl
+//go:embed layouts partials locales
 var FS embed.FS
+
+type Email struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Renderer holds one template set per locale and email, every set is built from the shared layouts and partials,
+// the phrases common to every email of the locale, and the email itself
+type Renderer struct {
+	fallback string
+	html     map[string]*htmltemplate.Template
+	text     map[string]*texttemplate.Template
+}
+
+func NewRenderer(fallback string) (*Renderer, error) {
+	r := Renderer{
+		fallback: fallback,
+		html:     make(map[string]*htmltemplate.Template),
+		text:     make(map[string]*texttemplate.Template),
+	}
+
+	locales, err := fs.ReadDir(FS, "locales")
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize renderer: %w", err)
+	}
+
+	for _, l := range locales {
+		locale := l.Name()
+		common := path.Join("locales", locale, "common.tmpl")
+
+		files, err := fs.Glob(FS, path.Join("locales", locale, "*.html.tmpl"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize renderer: %w", err)
+		}
+
+		for _, file := range files {
+			name := strings.TrimSuffix(path.Base(file), ".html.tmpl")
+			txt := path.Join("locales", locale, name+".txt.tmpl")
+
+			// Variables missing from the data fail the render instead of printing "<no value>"
+			h, err := htmltemplate.New(name).
+				Option("missingkey=error").
+				Funcs(htmltemplate.FuncMap(funcs(locale))).
+				ParseFS(FS, "layouts/*.html.tmpl", "partials/*.html.tmpl", common, txt, file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize renderer: %w", err)
+			}
+
+			t, err := texttemplate.New(name).
+				Option("missingkey=error").
+				Funcs(texttemplate.FuncMap(funcs(locale))).
+				ParseFS(FS, "layouts/*.txt.tmpl", "partials/*.txt.tmpl", common, txt)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize renderer: %w", err)
+			}
+
+			r.html[key(locale, name)] = h
+			r.text[key(locale, name)] = t
+		}
+	}
+
+	if !r.has(fallback) {
+		return nil, fmt.Errorf("failed to initialize renderer: no templates for fallback locale %q", fallback)
+	}
+
+	return &r, nil
+}
+
+// Render falls back to the fallback locale when the email is not translated to the requested one
+func (r *Renderer) Render(locale, name string, data any) (*Email, error) {
+	k := key(normalize(locale), name)
+	if _, ok := r.html[k]; !ok {
+		k = key(r.fallback, name)
+	}
+
+	h, ok := r.html[k]
+	if !ok {
+		return nil, fmt.Errorf("failed to render email: template %q not found", name)
+	}
+	t := r.text[k]
+
+	var subject, text, html bytes.Buffer
+	if err := t.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render email subject: %w", err)
+	}
+	if err := t.ExecuteTemplate(&text, "layout.txt", data); err != nil {
+		return nil, fmt.Errorf("failed to render email text: %w", err)
+	}
+	if err := h.ExecuteTemplate(&html, "layout.html", data); err != nil {
+		return nil, fmt.Errorf("failed to render email html: %w", err)
+	}
+
+	return &Email{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()),
+		HTML:    html.String(),
+	}, nil
+}
+
+func (r *Renderer) Locales() []string {
+	locales := make([]string, 0)
+	for k := range r.html {
+		locale, _, _ := strings.Cut(k, "/")
+		if !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+
+	slices.Sort(locales)
+	return locales
+}
+
+func (r *Renderer) Names() []string {
+	names := make([]string, 0)
+	for k := range r.html {
+		_, name, _ := strings.Cut(k, "/")
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// Translated reports whether the email has its own variant in the locale rather than falling back
+func (r *Renderer) Translated(locale, name string) bool {
+	_, ok := r.html[key(normalize(locale), name)]
+	return ok
+}
+
+func (r *Renderer) has(locale string) bool {
+	for k := range r.html {
+		if strings.HasPrefix(k, locale+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func key(locale, name string) string {
+	return locale + "/" + name
+}
+
+// normalize reduces a language tag such as "id-ID" to its primary subtag
+func normalize(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
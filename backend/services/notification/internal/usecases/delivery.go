@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const deliveryErrTracer string = "usecase.delivery"
+
+type DeliveryUsecase interface {
+	ListDeliveries(ctx context.Context, data *models.ListDeliveries) (deliveries []models.Delivery, page *models.PageInfo, err *ce.Error)
+	ReportDeliveryEvent(ctx context.Context, data *models.DeliveryEvent) (delivery *models.Delivery, err *ce.Error)
+}
+
+type deliveryUsecase struct {
+	dr         repositories.DeliveryRepository
+	sr         repositories.SuppressionRepository
+	transactor *database.Transactor
+	validator  *utils.Validator
+}
+
+func NewDeliveryUsecase(
+	dr repositories.DeliveryRepository,
+	sr repositories.SuppressionRepository,
+	tx *database.Transactor,
+	v *utils.Validator,
+) DeliveryUsecase {
+	return &deliveryUsecase{dr: dr, sr: sr, transactor: tx, validator: v}
+}
+
+func (u *deliveryUsecase) ListDeliveries(ctx context.Context, data *models.ListDeliveries) ([]models.Delivery, *models.PageInfo, *ce.Error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "ListDeliveries")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.Page(&data.Page, constants.DeliverySortFields, constants.DeliveryFilterFields); !ok {
+		err := fmt.Errorf("failed to list deliveries: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("page", why)
+	}
+	if ok, why := u.validator.DeliveryFilters(data.Page.Filters); !ok {
+		err := fmt.Errorf("failed to list deliveries: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("filters", why)
+	}
+
+	// Normalizations
+	if data.Page.PageSize == 0 {
+		data.Page.PageSize = constants.PageSizeDefault
+	}
+
+	deliveries, page, err := u.dr.ListDeliveries(ctx, data)
+	if err != nil {
+		if errors.Is(err, ce.ErrInvalidCursor) {
+			return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, "Cursor is invalid", err)
+		}
+		return nil, nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return deliveries, page, nil
+}
+
+// ReportDeliveryEvent suppresses the recipient on a hard bounce or a complaint, soft bounces are only recorded
+func (u *deliveryUsecase) ReportDeliveryEvent(ctx context.Context, data *models.DeliveryEvent) (*models.Delivery, *ce.Error) {
+	ctx, span := otel.Tracer(deliveryErrTracer).Start(ctx, "ReportDeliveryEvent")
+	defer span.End()
+
+	// Normalizations
+	data.ProviderMessageID = strings.Trim(strings.TrimSpace(data.ProviderMessageID), "<>")
+	data.Type = strings.ToLower(strings.TrimSpace(data.Type))
+	data.BounceType = strings.ToLower(strings.TrimSpace(data.BounceType))
+
+	// Validations
+	if ok, why := u.validator.DeliveryEvent(data); !ok {
+		err := fmt.Errorf("failed to report delivery event: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+	}
+
+	status := constants.DeliveryStatusComplained
+	reason := constants.SuppressionReasonComplaint
+	if data.Type == constants.DeliveryEventBounce {
+		status = constants.DeliveryStatusBounced
+		reason = constants.SuppressionReasonHardBounce
+	}
+
+	var delivery *models.Delivery
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) (err error) {
+		delivery, err = u.dr.UpdateStatus(ctx, &models.UpdateDeliveryStatus{
+			ProviderMessageID: data.ProviderMessageID,
+			Status:            status,
+			Error:             data.Reason,
+		})
+		if err != nil || delivery == nil {
+			return err
+		}
+		if data.BounceType == constants.BounceTypeSoft {
+			return nil
+		}
+
+		return u.sr.CreateSuppression(ctx, &models.CreateSuppression{
+			Channel:   delivery.Channel,
+			Recipient: delivery.Recipient,
+			Reason:    reason,
+		})
+	})
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+	if delivery == nil {
+		err := fmt.Errorf("failed to report delivery event: %w", ce.ErrDBReturnNoRows)
+		return nil, ce.NewError(span, ce.CodeDeliveryNotFound, ce.MsgDeliveryNotFound, err)
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const inboxErrTracer string = "usecase.inbox"
+
+type InboxUsecase interface {
+	ListInbox(ctx context.Context, data *models.ListInboxItems) (items []models.InboxItem, page *models.PageInfo, err *ce.Error)
+	GetUnreadCount(ctx context.Context, authID int64) (count *models.UnreadCount, err *ce.Error)
+	MarkNotificationRead(ctx context.Context, authID, notificationID int64) (item *models.InboxItem, err *ce.Error)
+	MarkAllNotificationsRead(ctx context.Context, authID int64) (updated int64, err *ce.Error)
+	ArchiveNotification(ctx context.Context, authID, notificationID int64) (item *models.InboxItem, err *ce.Error)
+}
+
+type inboxUsecase struct {
+	ir        repositories.InboxRepository
+	validator *utils.Validator
+}
+
+func NewInboxUsecase(ir repositories.InboxRepository, v *utils.Validator) InboxUsecase {
+	return &inboxUsecase{ir: ir, validator: v}
+}
+
+func (u *inboxUsecase) ListInbox(ctx context.Context, data *models.ListInboxItems) ([]models.InboxItem, *models.PageInfo, *ce.Error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "ListInbox")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.Page(&data.Page, constants.InboxSortFields, constants.InboxFilterFields); !ok {
+		err := fmt.Errorf("failed to list inbox: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("page", why)
+	}
+	if ok, why := u.validator.InboxFilters(data.Page.Filters); !ok {
+		err := fmt.Errorf("failed to list inbox: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("filters", why)
+	}
+
+	// Normalizations
+	if data.Page.PageSize == 0 {
+		data.Page.PageSize = constants.PageSizeDefault
+	}
+
+	items, page, err := u.ir.ListInboxItems(ctx, data)
+	if err != nil {
+		if errors.Is(err, ce.ErrInvalidCursor) {
+			return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, "Cursor is invalid", err)
+		}
+		return nil, nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return items, page, nil
+}
+
+func (u *inboxUsecase) GetUnreadCount(ctx context.Context, authID int64) (*models.UnreadCount, *ce.Error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "GetUnreadCount")
+	defer span.End()
+
+	count, err := u.ir.CountUnread(ctx, authID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return count, nil
+}
+
+func (u *inboxUsecase) MarkNotificationRead(ctx context.Context, authID, notificationID int64) (*models.InboxItem, *ce.Error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "MarkNotificationRead")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.NotificationID(notificationID); !ok {
+		err := fmt.Errorf("failed to mark notification read: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("notification_id", why)
+	}
+
+	item, err := u.ir.MarkRead(ctx, authID, notificationID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+	if item == nil {
+		err := fmt.Errorf("failed to mark notification read: %w", ce.ErrDBReturnNoRows)
+		return nil, ce.NewError(span, ce.CodeNotificationNotFound, ce.MsgNotificationNotFound, err)
+	}
+
+	return item, nil
+}
+
+func (u *inboxUsecase) MarkAllNotificationsRead(ctx context.Context, authID int64) (int64, *ce.Error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "MarkAllNotificationsRead")
+	defer span.End()
+
+	updated, err := u.ir.MarkAllRead(ctx, authID)
+	if err != nil {
+		return 0, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return updated, nil
+}
+
+func (u *inboxUsecase) ArchiveNotification(ctx context.Context, authID, notificationID int64) (*models.InboxItem, *ce.Error) {
+	ctx, span := otel.Tracer(inboxErrTracer).Start(ctx, "ArchiveNotification")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.NotificationID(notificationID); !ok {
+		err := fmt.Errorf("failed to archive notification: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("notification_id", why)
+	}
+
+	item, err := u.ir.Archive(ctx, authID, notificationID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+	if item == nil {
+		err := fmt.Errorf("failed to archive notification: %w", ce.ErrDBReturnNoRows)
+		return nil, ce.NewError(span, ce.CodeNotificationNotFound, ce.MsgNotificationNotFound, err)
+	}
+
+	return item, nil
+}
@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const notificationErrTracer string = "usecase.notification"
+
+type NotificationUsecase interface {
+	ListNotificationHistory(ctx context.Context, authID int64) (events []models.Event, err *ce.Error)
+}
+
+type notificationUsecase struct {
+	er repositories.EventRepository
+}
+
+func NewNotificationUsecase(er repositories.EventRepository) NotificationUsecase {
+	return &notificationUsecase{er: er}
+}
+
+func (u *notificationUsecase) ListNotificationHistory(ctx context.Context, authID int64) ([]models.Event, *ce.Error) {
+	ctx, span := otel.Tracer(notificationErrTracer).Start(ctx, "ListNotificationHistory")
+	defer span.End()
+
+	events, err := u.er.GetEventsByAuthID(ctx, authID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return events, nil
+}
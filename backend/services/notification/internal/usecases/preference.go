@@ -0,0 +1,178 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const preferenceErrTracer string = "usecase.preference"
+
+type PreferenceUsecase interface {
+	GetPreferences(ctx context.Context, authID int64) (preferences []models.Preference, err *ce.Error)
+	UpdatePreferences(ctx context.Context, data *models.UpdatePreferences) (preferences []models.Preference, err *ce.Error)
+	Unsubscribe(ctx context.Context, token string) (unsubscribed *models.Unsubscribe, err *ce.Error)
+	GetSchedule(ctx context.Context, authID int64) (schedule *models.Schedule, err *ce.Error)
+	UpdateSchedule(ctx context.Context, data *models.UpdateSchedule) (schedule *models.Schedule, err *ce.Error)
+}
+
+type preferenceUsecase struct {
+	signingKey string
+	pr         repositories.PreferenceRepository
+	sr         repositories.ScheduleRepository
+	validator  *utils.Validator
+}
+
+func NewPreferenceUsecase(
+	pr repositories.PreferenceRepository,
+	sr repositories.ScheduleRepository,
+	v *utils.Validator,
+	signingKey string,
+) PreferenceUsecase {
+	return &preferenceUsecase{pr: pr, sr: sr, validator: v, signingKey: signingKey}
+}
+
+func (u *preferenceUsecase) GetPreferences(ctx context.Context, authID int64) ([]models.Preference, *ce.Error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "GetPreferences")
+	defer span.End()
+
+	stored, err := u.pr.GetPreferences(ctx, authID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return u.toMatrix(stored), nil
+}
+
+func (u *preferenceUsecase) UpdatePreferences(ctx context.Context, data *models.UpdatePreferences) ([]models.Preference, *ce.Error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "UpdatePreferences")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.Preferences(data.Preferences); !ok {
+		err := fmt.Errorf("failed to update preferences: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("preferences", why)
+	}
+
+	if err := u.pr.UpsertPreferences(ctx, data); err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	stored, err := u.pr.GetPreferences(ctx, data.AuthID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return u.toMatrix(stored), nil
+}
+
+func (u *preferenceUsecase) Unsubscribe(ctx context.Context, token string) (*models.Unsubscribe, *ce.Error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "Unsubscribe")
+	defer span.End()
+
+	unsubscribed, err := utils.VerifyUnsubscribe(u.signingKey, token)
+	if err != nil {
+		err = fmt.Errorf("failed to unsubscribe: %w", err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, "Unsubscribe link is invalid", err)
+	}
+
+	// Validations
+	if ok, why := u.validator.PreferenceTarget(unsubscribed.Category, unsubscribed.Channel); !ok {
+		err := fmt.Errorf("failed to unsubscribe: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, "Unsubscribe link is invalid", err)
+	}
+	if slices.Contains(constants.MandatoryCategories, unsubscribed.Category) {
+		err := fmt.Errorf("failed to unsubscribe: category %q is mandatory", unsubscribed.Category)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, "Unsubscribe link is invalid", err)
+	}
+
+	data := models.UpdatePreferences{
+		AuthID: unsubscribed.AuthID,
+		Preferences: []models.UpdatePreference{
+			{Category: unsubscribed.Category, Channel: unsubscribed.Channel, Enabled: false},
+		},
+	}
+	if err := u.pr.UpsertPreferences(ctx, &data); err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return unsubscribed, nil
+}
+
+func (u *preferenceUsecase) GetSchedule(ctx context.Context, authID int64) (*models.Schedule, *ce.Error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "GetSchedule")
+	defer span.End()
+
+	schedule, err := u.sr.GetSchedule(ctx, authID)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return schedule, nil
+}
+
+func (u *preferenceUsecase) UpdateSchedule(ctx context.Context, data *models.UpdateSchedule) (*models.Schedule, *ce.Error) {
+	ctx, span := otel.Tracer(preferenceErrTracer).Start(ctx, "UpdateSchedule")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.Schedule(data); !ok {
+		err := fmt.Errorf("failed to update schedule: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+	}
+
+	// Normalizations
+	upsert := models.UpsertSchedule{
+		AuthID:   data.AuthID,
+		Schedule: models.Schedule{TimeZone: data.TimeZone, Digest: data.Digest},
+	}
+	if data.QuietStart != "" {
+		start, _ := utils.ParseClock(data.QuietStart)
+		end, _ := utils.ParseClock(data.QuietEnd)
+		upsert.Schedule.QuietStart = &start
+		upsert.Schedule.QuietEnd = &end
+	}
+
+	schedule, err := u.sr.UpsertSchedule(ctx, &upsert)
+	if err != nil {
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, err)
+	}
+
+	return schedule, nil
+}
+
+// toMatrix expands the stored overrides into every category and channel pair
+func (u *preferenceUsecase) toMatrix(stored []models.Preference) []models.Preference {
+	overrides := make(map[string]bool, len(stored))
+	for _, p := range stored {
+		overrides[p.Category+":"+p.Channel] = p.Enabled
+	}
+
+	preferences := make([]models.Preference, 0, len(constants.Categories)*len(constants.Channels))
+	for _, category := range constants.Categories {
+		mandatory := slices.Contains(constants.MandatoryCategories, category)
+		for _, channel := range constants.Channels {
+			enabled, ok := overrides[category+":"+channel]
+			if !ok || mandatory {
+				enabled = true
+			}
+
+			preferences = append(preferences, models.Preference{
+				Category:  category,
+				Channel:   channel,
+				Enabled:   enabled,
+				Mandatory: mandatory,
+			})
+		}
+	}
+
+	return preferences
+}
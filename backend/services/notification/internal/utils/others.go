@@ -1,17 +1,39 @@
 package utils
 
 import (
+	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
+	"net/mail"
 	"net/url"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func MIMEBase64(value string) string {
 	return "=?UTF-8?B?" + base64.StdEncoding.EncodeToString([]byte(value)) + "?="
 }
 
+// MessageID returns a unique Message-ID, without angle brackets, on the domain of the sender address
+func MessageID(sender string) string {
+	domain := "localhost"
+	if addr, err := mail.ParseAddress(sender); err == nil {
+		if i := strings.LastIndex(addr.Address, "@"); i >= 0 {
+			domain = addr.Address[i+1:]
+		}
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b) + "@" + domain
+}
+
 func TraceErr(s trace.Span, err error, message string) {
 	s.RecordError(err)
 	s.SetStatus(codes.Error, message)
@@ -31,3 +53,17 @@ func URLWithToken(baseURL, path, token string) (string, error) {
 	u.RawQuery = q.Encode()
 	return u.String(), nil
 }
+
+func WrapString(s *string) *wrapperspb.StringValue {
+	if s != nil {
+		return wrapperspb.String(*s)
+	}
+	return nil
+}
+
+func WrapTime(t *time.Time) *timestamppb.Timestamp {
+	if t != nil {
+		return timestamppb.New(*t)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func UnwrapPageRequest(pr *apis.PageRequest) models.PageRequest {
+	page := models.PageRequest{
+		PageSize: int(pr.GetPageSize()),
+		Cursor:   pr.GetCursor(),
+		Page:     int(pr.GetPage()),
+		Sort:     make([]models.SortField, 0, len(pr.GetSort())),
+		Filters:  make(map[string]string, len(pr.GetFilters())),
+	}
+	for _, s := range pr.GetSort() {
+		page.Sort = append(page.Sort, models.SortField{
+			Field: s.GetField(),
+			Desc:  s.GetDirection() == apis.SortDirection_SORT_DIRECTION_DESC,
+		})
+	}
+	for _, f := range pr.GetFilters() {
+		page.Filters[f.GetField()] = f.GetValue()
+	}
+	return page
+}
+
+func WrapPageInfo(p *models.PageInfo) *apis.PageInfo {
+	page := apis.PageInfo{
+		PageSize:   int32(p.PageSize),
+		HasMore:    p.HasMore,
+		NextCursor: p.NextCursor,
+		Page:       int32(p.Page),
+	}
+	if p.Total != nil {
+		page.Total = wrapperspb.Int32(int32(*p.Total))
+	}
+	return &page
+}
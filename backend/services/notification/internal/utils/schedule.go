@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+)
+
+// ParseClock parses "HH:MM" into minutes since midnight
+func ParseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func FormatClock(minutes *int) string {
+	if minutes == nil {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d", *minutes/60, *minutes%60)
+}
+
+// AfterQuietHours moves t to the end of the quiet hours it falls into, windows may wrap past midnight
+func AfterQuietHours(s *models.Schedule, t time.Time) time.Time {
+	if s.QuietStart == nil || s.QuietEnd == nil || *s.QuietStart == *s.QuietEnd {
+		return t
+	}
+
+	loc := location(s.TimeZone)
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	start, end := *s.QuietStart, *s.QuietEnd
+
+	var quiet bool
+	if start < end {
+		quiet = minute >= start && minute < end
+	} else {
+		quiet = minute >= start || minute < end
+	}
+	if !quiet {
+		return t
+	}
+
+	day := local
+	if start > end && minute >= start {
+		day = local.AddDate(0, 0, 1)
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), end/60, end%60, 0, 0, loc)
+}
+
+// NextDigestTime returns the first digest slot after t, daily at the given local hour and weekly on Mondays
+func NextDigestTime(s *models.Schedule, t time.Time, hour int) time.Time {
+	loc := location(s.TimeZone)
+	local := t.In(loc)
+
+	next := time.Date(local.Year(), local.Month(), local.Day(), hour, 0, 0, 0, loc)
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	if s.Digest == constants.DigestWeekly {
+		for next.Weekday() != time.Monday {
+			next = next.AddDate(0, 0, 1)
+		}
+	}
+
+	return AfterQuietHours(s, next)
+}
+
+// location falls back to UTC for zones the host does not know, which validation should have kept out
+func location(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
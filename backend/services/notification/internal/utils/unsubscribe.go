@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+)
+
+// SignUnsubscribe issues a token that opts the account out of one category on one channel, it does not expire so
+// that links in old emails keep working
+func SignUnsubscribe(secret string, data *models.Unsubscribe) string {
+	payload := fmt.Sprintf("%d:%s:%s", data.AuthID, data.Category, data.Channel)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + hex.EncodeToString(mac.Sum(nil))
+}
+
+func VerifyUnsubscribe(secret, token string) (*models.Unsubscribe, error) {
+	if secret == "" {
+		return nil, errors.New("signing key is not configured")
+	}
+
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.New("invalid token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("invalid token")
+	}
+
+	given, err := hex.DecodeString(signature)
+	if err != nil {
+		return nil, errors.New("invalid signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), given) {
+		return nil, errors.New("invalid signature")
+	}
+
+	parts := strings.Split(string(payload), ":")
+	if len(parts) != 3 {
+		return nil, errors.New("invalid token")
+	}
+
+	authID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid token")
+	}
+
+	return &models.Unsubscribe{AuthID: authID, Category: parts[1], Channel: parts[2]}, nil
+}
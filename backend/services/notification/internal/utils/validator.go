@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/models"
+)
+
+var inboxStatuses = []string{
+	constants.InboxStatusUnread,
+	constants.InboxStatusRead,
+	constants.InboxStatusArchived,
+}
+
+type Validator struct{}
+
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+func (u *Validator) Page(value *models.PageRequest, sortFields, filterFields []string) (bool, string) {
+	if value.PageSize < 0 || value.PageSize > constants.PageSizeMax {
+		return false, fmt.Sprintf("Page size must be between 1 and %d", constants.PageSizeMax)
+	}
+	if value.Page < 0 {
+		return false, "Page must be at least 1"
+	}
+	if value.Page > 0 && value.Cursor != "" {
+		return false, "Page and cursor must not be combined"
+	}
+	if len(value.Sort) > 1 {
+		return false, "Only one sort field is supported"
+	}
+	for _, s := range value.Sort {
+		if !slices.Contains(sortFields, s.Field) {
+			return false, fmt.Sprintf("Sort field is not supported: %s", s.Field)
+		}
+	}
+	for field := range value.Filters {
+		if !slices.Contains(filterFields, field) {
+			return false, fmt.Sprintf("Filter field is not supported: %s", field)
+		}
+	}
+	return true, ""
+}
+
+func (u *Validator) InboxFilters(value map[string]string) (bool, string) {
+	if v, ok := value["status"]; ok && !slices.Contains(inboxStatuses, v) {
+		return false, fmt.Sprintf("Filter status is invalid: %s", v)
+	}
+	return true, ""
+}
+
+func (u *Validator) Preferences(value []models.UpdatePreference) (bool, string) {
+	if len(value) == 0 {
+		return false, "Preferences must not be empty"
+	}
+	for _, p := range value {
+		if ok, why := u.PreferenceTarget(p.Category, p.Channel); !ok {
+			return false, why
+		}
+		if slices.Contains(constants.MandatoryCategories, p.Category) {
+			return false, fmt.Sprintf("Category cannot be updated: %s", p.Category)
+		}
+	}
+	return true, ""
+}
+
+func (u *Validator) PreferenceTarget(category, channel string) (bool, string) {
+	if !slices.Contains(constants.Categories, category) {
+		return false, fmt.Sprintf("Category is invalid: %s", category)
+	}
+	if !slices.Contains(constants.Channels, channel) {
+		return false, fmt.Sprintf("Channel is invalid: %s", channel)
+	}
+	return true, ""
+}
+
+func (u *Validator) DeliveryFilters(value map[string]string) (bool, string) {
+	if v, ok := value["channel"]; ok && !slices.Contains(constants.Channels, v) {
+		return false, fmt.Sprintf("Filter channel is invalid: %s", v)
+	}
+	if v, ok := value["status"]; ok && !slices.Contains(constants.DeliveryStatuses, v) {
+		return false, fmt.Sprintf("Filter status is invalid: %s", v)
+	}
+	return true, ""
+}
+
+func (u *Validator) DeliveryEvent(value *models.DeliveryEvent) (bool, string) {
+	if value.ProviderMessageID == "" {
+		return false, "Provider message ID is required"
+	}
+	switch value.Type {
+	case constants.DeliveryEventBounce:
+		if value.BounceType != constants.BounceTypeHard && value.BounceType != constants.BounceTypeSoft {
+			return false, fmt.Sprintf("Bounce type is invalid: %s", value.BounceType)
+		}
+	case constants.DeliveryEventComplaint:
+	default:
+		return false, fmt.Sprintf("Event type is invalid: %s", value.Type)
+	}
+	return true, ""
+}
+
+func (u *Validator) Schedule(value *models.UpdateSchedule) (bool, string) {
+	if _, err := time.LoadLocation(value.TimeZone); err != nil || value.TimeZone == "" || value.TimeZone == "Local" {
+		return false, fmt.Sprintf("Time zone is invalid: %s", value.TimeZone)
+	}
+	if (value.QuietStart == "") != (value.QuietEnd == "") {
+		return false, "Quiet hours must have both a start and an end"
+	}
+	if value.QuietStart != "" {
+		if _, err := ParseClock(value.QuietStart); err != nil {
+			return false, fmt.Sprintf("Quiet hours start is invalid: %s", value.QuietStart)
+		}
+		if _, err := ParseClock(value.QuietEnd); err != nil {
+			return false, fmt.Sprintf("Quiet hours end is invalid: %s", value.QuietEnd)
+		}
+	}
+	if !slices.Contains(constants.Digests, value.Digest) {
+		return false, fmt.Sprintf("Digest is invalid: %s", value.Digest)
+	}
+	return true, ""
+}
+
+func (u *Validator) NotificationID(value int64) (bool, string) {
+	if value <= 0 {
+		return false, "Notification ID is invalid"
+	}
+	return true, ""
+}
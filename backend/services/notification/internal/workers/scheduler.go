@@ -0,0 +1,63 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/notification/internal/scheduler"
+	"github.com/ritchieridanko/pasarly/backend/shared/batch"
+)
+
+type SchedulerWorker struct {
+	interval  time.Duration
+	batchSize int
+	sc        scheduler.Scheduler
+	leader    *database.Leader
+	logger    *logger.Logger
+}
+
+func NewSchedulerWorker(
+	interval time.Duration,
+	batchSize int,
+	sc scheduler.Scheduler,
+	leader *database.Leader,
+	l *logger.Logger,
+) *SchedulerWorker {
+	return &SchedulerWorker{interval: interval, batchSize: batchSize, sc: sc, leader: leader, logger: l}
+}
+
+// Run dispatches due notifications and digests until ctx is cancelled, on the leading replica only
+func (w *SchedulerWorker) Run(ctx context.Context) error {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+
+	defer func() {
+		if err := w.leader.Release(context.Background()); err != nil {
+			w.logger.Sugar().Warnf("failed to release scheduler leadership: %s", err.Error())
+		}
+	}()
+
+	for {
+		if w.lead(ctx) {
+			batch.Drain(ctx, w.logger.Base(), w.batchSize, "dispatched scheduled notifications", w.sc.DispatchDue)
+			batch.Drain(ctx, w.logger.Base(), w.batchSize, "dispatched digests", w.sc.DispatchDigests)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+func (w *SchedulerWorker) lead(ctx context.Context) bool {
+	leader, err := w.leader.IsLeader(ctx)
+	if err != nil {
+		w.logger.Sugar().Errorf("failed to elect scheduler leader: %s", err.Error())
+		return false
+	}
+	return leader
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_events_auth_id;
+ALTER TABLE events DROP COLUMN IF EXISTS auth_id;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE events ADD COLUMN auth_id BIGINT;
+
+CREATE INDEX idx_events_auth_id ON events(auth_id) WHERE auth_id IS NOT NULL;
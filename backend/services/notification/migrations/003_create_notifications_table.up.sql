@@ -0,0 +1,14 @@
+CREATE TABLE notifications(
+  id BIGSERIAL PRIMARY KEY,
+  event_id VARCHAR NOT NULL,
+  auth_id BIGINT NOT NULL,
+  notification_type VARCHAR NOT NULL,
+  title VARCHAR NOT NULL,
+  body TEXT NOT NULL,
+  url VARCHAR,
+  data JSONB NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE(event_id, auth_id)
+);
+
+CREATE INDEX idx_notifications_auth_id ON notifications(auth_id, created_at DESC);
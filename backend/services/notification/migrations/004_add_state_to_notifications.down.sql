@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_notifications_auth_id_unread;
+ALTER TABLE notifications DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE notifications DROP COLUMN IF EXISTS read_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE notifications ADD COLUMN read_at TIMESTAMPTZ;
+ALTER TABLE notifications ADD COLUMN archived_at TIMESTAMPTZ;
+
+CREATE INDEX idx_notifications_auth_id_unread ON notifications(auth_id) WHERE read_at IS NULL AND archived_at IS NULL;
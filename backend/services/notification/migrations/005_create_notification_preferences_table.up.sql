@@ -0,0 +1,8 @@
+CREATE TABLE notification_preferences(
+  auth_id BIGINT NOT NULL,
+  category VARCHAR NOT NULL,
+  channel VARCHAR NOT NULL,
+  enabled BOOLEAN NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (auth_id, category, channel)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS deliveries CASCADE;
\ No newline at end of file
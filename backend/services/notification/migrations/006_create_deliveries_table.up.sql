@@ -0,0 +1,18 @@
+CREATE TABLE deliveries(
+  id BIGSERIAL PRIMARY KEY,
+  event_id VARCHAR NOT NULL,
+  auth_id BIGINT,
+  channel VARCHAR NOT NULL,
+  template VARCHAR NOT NULL,
+  recipient VARCHAR NOT NULL,
+  provider_message_id VARCHAR,
+  status VARCHAR NOT NULL DEFAULT 'queued',
+  attempts INT NOT NULL DEFAULT 1,
+  error TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE(event_id, channel, recipient)
+);
+
+CREATE INDEX idx_deliveries_auth_id ON deliveries(auth_id, created_at DESC);
+CREATE INDEX idx_deliveries_provider_message_id ON deliveries(provider_message_id);
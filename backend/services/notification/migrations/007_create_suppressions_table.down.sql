@@ -0,0 +1 @@
+DROP TABLE IF EXISTS suppressions CASCADE;
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE suppressions(
+  channel VARCHAR NOT NULL,
+  recipient VARCHAR NOT NULL,
+  reason VARCHAR NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (channel, recipient)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS notification_schedules CASCADE;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE notification_schedules(
+  auth_id BIGINT PRIMARY KEY,
+  time_zone VARCHAR NOT NULL DEFAULT 'UTC',
+  quiet_start SMALLINT,
+  quiet_end SMALLINT,
+  digest VARCHAR NOT NULL DEFAULT 'off',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS scheduled_notifications CASCADE;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE scheduled_notifications(
+  id BIGSERIAL PRIMARY KEY,
+  event_id VARCHAR NOT NULL,
+  auth_id BIGINT,
+  notification_type VARCHAR NOT NULL,
+  payload JSONB NOT NULL,
+  send_at TIMESTAMPTZ NOT NULL,
+  is_digest BOOLEAN NOT NULL DEFAULT FALSE,
+  status VARCHAR NOT NULL DEFAULT 'pending',
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE(event_id, notification_type)
+);
+
+CREATE INDEX idx_scheduled_notifications_due ON scheduled_notifications(send_at) WHERE status = 'pending';
+CREATE INDEX idx_scheduled_notifications_auth_id ON scheduled_notifications(auth_id);
@@ -0,0 +1,2 @@
+ALTER TABLE events DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE events DROP COLUMN IF EXISTS lease_token;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- The instance holding an unexpired lease is the only one allowed to process the event
+ALTER TABLE events ADD COLUMN lease_token VARCHAR;
+ALTER TABLE events ADD COLUMN lease_expires_at TIMESTAMPTZ;
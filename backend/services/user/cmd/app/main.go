@@ -14,6 +14,7 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/subscriber"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/interface/server"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/processors"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/workers"
 )
 
 func main() {
@@ -28,7 +29,10 @@ func main() {
 	}
 	defer i.Close()
 
-	container := di.Init(cfg, i)
+	container, err := di.Init(cfg, i)
+	if err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
 	s := container.Server()
 
 	// Run the server
@@ -42,7 +46,7 @@ func main() {
 	defer cancel()
 
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(3)
 
 	// Run the subscribers
 	go func(ctx context.Context, s *subscriber.Subscriber, p processors.UserProcessor) {
@@ -52,6 +56,21 @@ func main() {
 		}
 	}(ctx, container.SubAuthCreated(), container.UserProcessor())
 
+	go func(ctx context.Context, s *subscriber.Subscriber, p processors.UserProcessor) {
+		defer wg.Done()
+		if err := s.Listen(ctx, p.OnAuthDeleted); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.SubAuthDeleted(), container.UserProcessor())
+
+	// Run the workers
+	go func(ctx context.Context, w *workers.ExportWorker) {
+		defer wg.Done()
+		if err := w.Run(ctx); err != nil {
+			log.Println("ERROR ->", err.Error())
+		}
+	}(ctx, container.ExportWorker())
+
 	// Handle app shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -66,5 +85,6 @@ func main() {
 		log.Fatalln("FATAL ->", err.Error())
 	}
 
+	cancel()
 	wg.Wait()
 }
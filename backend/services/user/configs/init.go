@@ -1,8 +1,10 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,8 +14,16 @@ import (
 type Config struct {
 	App      `mapstructure:"app"`
 	Server   `mapstructure:"server"`
+	TLS      `mapstructure:"tls"`
+	Identity `mapstructure:"identity"`
 	Database `mapstructure:"database"`
 	Broker   `mapstructure:"broker"`
+	Service  `mapstructure:"service"`
+	Storage  `mapstructure:"storage"`
+	Geocoder `mapstructure:"geocoder"`
+	Image    `mapstructure:"image"`
+	Address  `mapstructure:"address"`
+	Export   `mapstructure:"export"`
 	Tracer   `mapstructure:"tracer"`
 }
 
@@ -23,8 +33,9 @@ type App struct {
 }
 
 type Server struct {
-	Host string `mapstructure:"host"`
-	Port int    `mapstructure:"port"`
+	Host           string `mapstructure:"host"`
+	Port           int    `mapstructure:"port"`
+	MaxRecvMsgSize int    `mapstructure:"max_recv_msg_size"`
 
 	Timeout struct {
 		Read     time.Duration `mapstructure:"read"`
@@ -33,6 +44,22 @@ type Server struct {
 	} `mapstructure:"timeout"`
 }
 
+type TLS struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	CertFile       string        `mapstructure:"cert_file"`
+	KeyFile        string        `mapstructure:"key_file"`
+	CAFile         string        `mapstructure:"ca_file"`
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// Methods each caller identity may call, matched in full, by service ("/auth.v1.AuthService/*"), or all ("*")
+	Allow map[string][]string `mapstructure:"allow"`
+}
+
+// Identity verifies the caller identity the gateway signs into the metadata of every call
+type Identity struct {
+	Secret string `mapstructure:"secret"`
+}
+
 type Database struct {
 	Host            string        `mapstructure:"host"`
 	Port            int           `mapstructure:"port"`
@@ -52,6 +79,94 @@ type Broker struct {
 	MaxBytes    int    `mapstructure:"max_bytes"`
 	MaxAttempts int    `mapstructure:"max_attempts"`
 	BaseDelay   int    `mapstructure:"base_delay"`
+
+	// Lease is how long a claimed event stays reserved for one instance without a heartbeat
+	Lease time.Duration `mapstructure:"lease"`
+
+	Timeout struct {
+		Batch time.Duration `mapstructure:"batch"`
+	} `mapstructure:"timeout"`
+}
+
+type Service struct {
+	Auth         Downstream `mapstructure:"auth"`
+	Notification Downstream `mapstructure:"notification"`
+}
+
+type Downstream struct {
+	Addr    string
+	Host    string        `mapstructure:"host"`
+	Port    int           `mapstructure:"port"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type Storage struct {
+	Driver string `mapstructure:"driver"`
+
+	Local struct {
+		Dir        string `mapstructure:"dir"`
+		BaseURL    string `mapstructure:"base_url"`
+		SigningKey string `mapstructure:"signing_key"`
+	} `mapstructure:"local"`
+
+	S3 struct {
+		Endpoint  string        `mapstructure:"endpoint"`
+		Region    string        `mapstructure:"region"`
+		Bucket    string        `mapstructure:"bucket"`
+		AccessKey string        `mapstructure:"access_key"`
+		SecretKey string        `mapstructure:"secret_key"`
+		BaseURL   string        `mapstructure:"base_url"`
+		Timeout   time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"s3"`
+}
+
+type Geocoder struct {
+	Driver    string  `mapstructure:"driver"`
+	RateLimit float64 `mapstructure:"rate_limit"`
+
+	Cache struct {
+		TTL  time.Duration `mapstructure:"ttl"`
+		Size int           `mapstructure:"size"`
+	} `mapstructure:"cache"`
+
+	Nominatim struct {
+		BaseURL   string        `mapstructure:"base_url"`
+		UserAgent string        `mapstructure:"user_agent"`
+		Email     string        `mapstructure:"email"`
+		Language  string        `mapstructure:"language"`
+		Timeout   time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"nominatim"`
+
+	Stub struct {
+		Latitude     float64  `mapstructure:"latitude"`
+		Longitude    float64  `mapstructure:"longitude"`
+		CountryCode  string   `mapstructure:"country_code"`
+		Subdivisions []string `mapstructure:"subdivisions"`
+		Street       string   `mapstructure:"street"`
+		Postcode     string   `mapstructure:"postcode"`
+		DisplayName  string   `mapstructure:"display_name"`
+	} `mapstructure:"stub"`
+}
+
+type Image struct {
+	MaxSize   int   `mapstructure:"max_size"`
+	MaxPixels int   `mapstructure:"max_pixels"`
+	Sizes     []int `mapstructure:"sizes"`
+	Quality   int   `mapstructure:"quality"`
+}
+
+type Address struct {
+	MaxPerUser       int           `mapstructure:"max_per_user"`
+	DuplicateRadiusM float64       `mapstructure:"duplicate_radius_m"`
+	UndoWindow       time.Duration `mapstructure:"undo_window"`
+}
+
+type Export struct {
+	TTL         time.Duration `mapstructure:"ttl"`
+	Interval    time.Duration `mapstructure:"interval"`
+	BatchSize   int           `mapstructure:"batch_size"`
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	StaleAfter  time.Duration `mapstructure:"stale_after"`
 }
 
 type Tracer struct {
@@ -86,6 +201,10 @@ func Init(path string) (*Config, error) {
 		return nil, fmt.Errorf("failed to initialize config: %w", err)
 	}
 
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("failed to initialize config: %w", err)
+	}
+
 	cfg.App.Env = env
 	cfg.Database.DSN = fmt.Sprintf(
 		"postgresql://%s:%s@%s:%d/%s?sslmode=%s",
@@ -96,7 +215,23 @@ func Init(path string) (*Config, error) {
 		cfg.Database.Name,
 		cfg.Database.SSLMode,
 	)
+	cfg.Service.Auth.Addr = fmt.Sprintf("%s:%d", cfg.Service.Auth.Host, cfg.Service.Auth.Port)
+	cfg.Service.Notification.Addr = fmt.Sprintf("%s:%d", cfg.Service.Notification.Host, cfg.Service.Notification.Port)
 	cfg.Tracer.Endpoint = fmt.Sprintf("%s:%d", cfg.Tracer.Host, cfg.Tracer.Port)
 
 	return &cfg, nil
 }
+
+// validate rejects settings the services cannot run safely or correctly with
+func (c *Config) validate() error {
+	if c.Identity.Secret == "" {
+		return errors.New("identity secret is not set")
+	}
+	if len(c.Image.Sizes) == 0 || slices.ContainsFunc(c.Image.Sizes, func(s int) bool { return s <= 0 }) {
+		return errors.New("image sizes must be set and positive")
+	}
+	if c.Export.Interval <= 0 || c.Export.BatchSize <= 0 {
+		return errors.New("export interval and batch size must be positive")
+	}
+	return nil
+}
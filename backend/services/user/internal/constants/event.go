@@ -2,4 +2,7 @@ package constants
 
 const (
 	EventTopicAuthCreated string = "auth.created"
+	EventTopicAuthDeleted string = "auth.deleted"
+
+	EventTopicUserDataExported string = "user.data_exported"
 )
@@ -0,0 +1,9 @@
+package constants
+
+const (
+	ExportStatusPending    string = "pending"
+	ExportStatusProcessing string = "processing"
+	ExportStatusCompleted  string = "completed"
+	ExportStatusFailed     string = "failed"
+	ExportStatusExpired    string = "expired"
+)
@@ -0,0 +1,11 @@
+package constants
+
+const (
+	PageSizeDefault int = 20
+	PageSizeMax     int = 100
+)
+
+var (
+	AddressSortFields   = []string{"created_at", "updated_at", "label", "recipient"}
+	AddressFilterFields = []string{"label", "country", "is_primary"}
+)
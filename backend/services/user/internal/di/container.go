@@ -5,6 +5,7 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/publisher"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/subscriber"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/interface/handlers"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/interface/server"
@@ -12,6 +13,8 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/repositories"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/usecases"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/workers"
+	"github.com/ritchieridanko/pasarly/backend/shared/idempotency"
 )
 
 type Container struct {
@@ -20,18 +23,28 @@ type Container struct {
 	transactor *database.Transactor
 	logger     *logger.Logger
 	acs        *subscriber.Subscriber
+	ads        *subscriber.Subscriber
+	udp        *publisher.Publisher
 	ur         repositories.UserRepository
 	ar         repositories.AddressRepository
+	rr         repositories.RegionRepository
+	er         repositories.ExportRepository
 	up         processors.UserProcessor
 	validator  *utils.Validator
+	image      *utils.ImageProcessor
 	uu         usecases.UserUsecase
 	au         usecases.AddressUsecase
+	ru         usecases.RegionUsecase
+	eu         usecases.ExportUsecase
 	uh         *handlers.UserHandler
 	ah         *handlers.AddressHandler
+	rh         *handlers.RegionHandler
+	eh         *handlers.ExportHandler
+	ew         *workers.ExportWorker
 	server     *server.Server
 }
 
-func Init(cfg *configs.Config, i *infra.Infra) *Container {
+func Init(cfg *configs.Config, i *infra.Infra) (*Container, error) {
 	// Infra
 	db := database.NewDatabase(i.Database())
 	tx := database.NewTransactor(i.Database())
@@ -39,27 +52,49 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 
 	// Subscribers
 	acs := subscriber.NewSubscriber(&cfg.Broker, i.SubAuthCreated(), l)
+	ads := subscriber.NewSubscriber(&cfg.Broker, i.SubAuthDeleted(), l)
+
+	// Publishers
+	udp := publisher.NewPublisher(i.PubUserDataExported(), l)
 
 	// Repositories
 	ur := repositories.NewUserRepository(db)
 	ar := repositories.NewAddressRepository(db)
-
-	// Processors
-	up := processors.NewUserProcessor(ur, tx)
+	rr := repositories.NewRegionRepository(i.Regions())
+	er := repositories.NewExportRepository(db)
 
 	// Utils
 	v := utils.NewValidator()
+	ip := utils.NewImageProcessor(cfg.Image.MaxPixels, cfg.Image.Sizes, cfg.Image.Quality)
+
+	// Processors
+	cl, err := idempotency.NewClaimer(i.Database(), cfg.Broker.Lease)
+	if err != nil {
+		return nil, err
+	}
+	up := processors.NewUserProcessor(ur, ar, i.Storage(), ip, tx, cl)
 
 	// Usecases
-	uu := usecases.NewUserUsecase(ur, v)
-	au := usecases.NewAddressUsecase(ar, tx, v)
+	uu := usecases.NewUserUsecase(&cfg.Image, ur, i.Storage(), v, ip)
+	au := usecases.NewAddressUsecase(&cfg.Address, ar, rr, i.Geocoder(), tx, v)
+	ru := usecases.NewRegionUsecase(rr)
+	eu := usecases.NewExportUsecase(
+		&cfg.Export, er, ur, ar,
+		i.AuthService(), i.NotificationService(), i.Storage(),
+		tx, udp, v, l,
+	)
 
 	// Handlers
-	uh := handlers.NewUserHandler(uu, l)
-	ah := handlers.NewAddressHandler(au, l)
+	uh := handlers.NewUserHandler(uu)
+	ah := handlers.NewAddressHandler(au)
+	rh := handlers.NewRegionHandler(ru)
+	eh := handlers.NewExportHandler(eu)
+
+	// Workers
+	ew := workers.NewExportWorker(cfg.Export.Interval, cfg.Export.BatchSize, eu, l)
 
 	// Server
-	s := server.Init(&cfg.Server, l, uh, ah)
+	s := server.Init(&cfg.Server, &cfg.TLS, &cfg.Identity, i.Certs(), l, uh, ah, rh, eh)
 
 	return &Container{
 		config:     cfg,
@@ -67,26 +102,44 @@ func Init(cfg *configs.Config, i *infra.Infra) *Container {
 		transactor: tx,
 		logger:     l,
 		acs:        acs,
+		ads:        ads,
+		udp:        udp,
 		ur:         ur,
 		ar:         ar,
+		rr:         rr,
+		er:         er,
 		up:         up,
 		validator:  v,
+		image:      ip,
 		uu:         uu,
 		au:         au,
+		ru:         ru,
+		eu:         eu,
 		uh:         uh,
 		ah:         ah,
+		rh:         rh,
+		eh:         eh,
+		ew:         ew,
 		server:     s,
-	}
+	}, nil
 }
 
 func (c *Container) SubAuthCreated() *subscriber.Subscriber {
 	return c.acs
 }
 
+func (c *Container) SubAuthDeleted() *subscriber.Subscriber {
+	return c.ads
+}
+
 func (c *Container) UserProcessor() processors.UserProcessor {
 	return c.up
 }
 
+func (c *Container) ExportWorker() *workers.ExportWorker {
+	return c.ew
+}
+
 func (c *Container) Server() *server.Server {
 	return c.server
 }
@@ -0,0 +1,34 @@
+package certs
+
+import (
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"go.uber.org/zap"
+)
+
+// Init loads the service certificate and keeps it in sync with the files on disk. It returns nil when TLS is
+// disabled, in which case gRPC connections fall back to plaintext.
+func Init(cfg *configs.TLS, l *zap.Logger) (*mtls.Reloader, error) {
+	if !cfg.Enabled {
+		l.Sugar().Warnln("⚠️ [TLS] is disabled, gRPC connections are neither encrypted nor authenticated...")
+		return nil, nil
+	}
+
+	r, err := mtls.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize tls: %w", err)
+	}
+
+	r.Watch(cfg.ReloadInterval, func(err error) {
+		if err != nil {
+			l.Sugar().Errorf("❌ [TLS] %s, keeping the current certificates", err.Error())
+			return
+		}
+		l.Sugar().Infof("✅ [TLS] reloaded (cert=%s)", cfg.CertFile)
+	})
+
+	l.Sugar().Infof("✅ [TLS] initialized (cert=%s, reload_interval=%s)", cfg.CertFile, cfg.ReloadInterval)
+	return r, nil
+}
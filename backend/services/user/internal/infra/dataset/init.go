@@ -0,0 +1,61 @@
+package dataset
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Administrative regions of Indonesia keyed by Kemendagri codes (e.g. "32", "32.73"). Only the provinces and the
+// regencies of DKI Jakarta are bundled, deeper levels can be added as long as the header is kept.
+//
+//go:embed regions.csv
+var regionsCSV []byte
+
+type Region struct {
+	ID       string
+	ParentID string
+	Name     string
+	Aliases  []string
+}
+
+func Init(l *zap.Logger) ([]Region, error) {
+	records, err := csv.NewReader(bytes.NewReader(regionsCSV)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize dataset: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("failed to initialize dataset: %w", errors.New("no regions found"))
+	}
+
+	ids := make(map[string]struct{}, len(records))
+	regions := make([]Region, 0, len(records)-1)
+
+	// The first record is the header
+	for n, r := range records[1:] {
+		if len(r) != 4 {
+			return nil, fmt.Errorf("failed to initialize dataset: invalid region at line %d", n+2)
+		}
+
+		region := Region{ID: r[0], ParentID: r[1], Name: r[2]}
+		if r[3] != "" {
+			region.Aliases = strings.Split(r[3], "|")
+		}
+		if region.ParentID != "" {
+			if _, ok := ids[region.ParentID]; !ok {
+				return nil, fmt.Errorf("failed to initialize dataset: unknown parent %s of region %s", region.ParentID, region.ID)
+			}
+		}
+
+		ids[region.ID] = struct{}{}
+		regions = append(regions, region)
+	}
+
+	l.Sugar().Infof("✅ [DATASET] initialized (regions=%d)", len(regions))
+	return regions, nil
+}
@@ -0,0 +1,119 @@
+package geocoder
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cachedGeocoder memoizes results, including misses, and throttles calls to the wrapped geocoder
+type cachedGeocoder struct {
+	next    Geocoder
+	limiter *limiter
+	ttl     time.Duration
+	size    int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type cacheEntry struct {
+	key       string
+	place     *Place
+	expiresAt time.Time
+}
+
+func newCachedGeocoder(next Geocoder, ttl time.Duration, size int, rps float64) *cachedGeocoder {
+	return &cachedGeocoder{
+		next:    next,
+		limiter: newLimiter(rps),
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (g *cachedGeocoder) Forward(ctx context.Context, q *Query) (*Place, error) {
+	key := "f:" + strings.ToLower(q.CountryCode+"|"+queryText(q))
+	return g.lookup(ctx, key, func(ctx context.Context) (*Place, error) {
+		return g.next.Forward(ctx, q)
+	})
+}
+
+func (g *cachedGeocoder) Reverse(ctx context.Context, latitude, longitude float64) (*Place, error) {
+	// Five decimals is roughly one meter, well within the precision of a dropped pin
+	key := fmt.Sprintf("r:%.5f,%.5f", latitude, longitude)
+	return g.lookup(ctx, key, func(ctx context.Context) (*Place, error) {
+		return g.next.Reverse(ctx, latitude, longitude)
+	})
+}
+
+func (g *cachedGeocoder) lookup(ctx context.Context, key string, fetch func(ctx context.Context) (*Place, error)) (*Place, error) {
+	if place, ok := g.get(key); ok {
+		if place == nil {
+			return nil, ErrNoResult
+		}
+		return place, nil
+	}
+
+	if err := g.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	place, err := fetch(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNoResult) {
+			g.set(key, nil)
+		}
+		return nil, err
+	}
+
+	g.set(key, place)
+	return place, nil
+}
+
+func (g *cachedGeocoder) get(key string) (*Place, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	e, ok := g.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := e.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		g.order.Remove(e)
+		delete(g.entries, key)
+		return nil, false
+	}
+
+	g.order.MoveToFront(e)
+	return entry.place, true
+}
+
+func (g *cachedGeocoder) set(key string, place *Place) {
+	if g.size <= 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if e, ok := g.entries[key]; ok {
+		g.order.Remove(e)
+	}
+
+	g.entries[key] = g.order.PushFront(&cacheEntry{key: key, place: place, expiresAt: time.Now().Add(g.ttl)})
+	for g.order.Len() > g.size {
+		oldest := g.order.Back()
+		g.order.Remove(oldest)
+		delete(g.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,13 @@
+package geocoder
+
+import "context"
+
+type disabledGeocoder struct{}
+
+func (g *disabledGeocoder) Forward(ctx context.Context, q *Query) (*Place, error) {
+	return nil, ErrDisabled
+}
+
+func (g *disabledGeocoder) Reverse(ctx context.Context, latitude, longitude float64) (*Place, error) {
+	return nil, ErrDisabled
+}
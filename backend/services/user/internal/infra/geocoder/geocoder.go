@@ -0,0 +1,33 @@
+package geocoder
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrDisabled = errors.New("geocoder is disabled")
+	ErrNoResult = errors.New("no geocoding result")
+)
+
+type Geocoder interface {
+	Forward(ctx context.Context, q *Query) (place *Place, err error)
+	Reverse(ctx context.Context, latitude, longitude float64) (place *Place, err error)
+}
+
+type Query struct {
+	CountryCode  string
+	Subdivisions []string
+	Street       string
+	Postcode     string
+}
+
+type Place struct {
+	Latitude     float64
+	Longitude    float64
+	CountryCode  string
+	Subdivisions []string
+	Street       string
+	Postcode     string
+	DisplayName  string
+}
@@ -0,0 +1,52 @@
+package geocoder
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"go.uber.org/zap"
+)
+
+func Init(cfg *configs.Geocoder, l *zap.Logger) (Geocoder, error) {
+	var g Geocoder
+
+	switch cfg.Driver {
+	case "", "none":
+		l.Sugar().Infof("✅ [GEOCODER] initialized (driver=none)")
+		return &disabledGeocoder{}, nil
+	case "stub":
+		g = &stubGeocoder{
+			place: Place{
+				Latitude:     cfg.Stub.Latitude,
+				Longitude:    cfg.Stub.Longitude,
+				CountryCode:  strings.ToUpper(cfg.Stub.CountryCode),
+				Subdivisions: cfg.Stub.Subdivisions,
+				Street:       cfg.Stub.Street,
+				Postcode:     cfg.Stub.Postcode,
+				DisplayName:  cfg.Stub.DisplayName,
+			},
+		}
+	case "nominatim":
+		if cfg.Nominatim.UserAgent == "" {
+			return nil, fmt.Errorf("failed to initialize geocoder: user agent is required by nominatim")
+		}
+
+		g = &nominatimGeocoder{
+			baseURL:   strings.TrimRight(cfg.Nominatim.BaseURL, "/"),
+			userAgent: cfg.Nominatim.UserAgent,
+			email:     cfg.Nominatim.Email,
+			language:  cfg.Nominatim.Language,
+			client:    &http.Client{Timeout: cfg.Nominatim.Timeout},
+		}
+	default:
+		return nil, fmt.Errorf("failed to initialize geocoder: unsupported driver %q", cfg.Driver)
+	}
+
+	l.Sugar().Infof(
+		"✅ [GEOCODER] initialized (driver=%s, rate_limit=%.2f, cache_ttl=%s, cache_size=%d)",
+		cfg.Driver, cfg.RateLimit, cfg.Cache.TTL, cfg.Cache.Size,
+	)
+	return newCachedGeocoder(g, cfg.Cache.TTL, cfg.Cache.Size, cfg.RateLimit), nil
+}
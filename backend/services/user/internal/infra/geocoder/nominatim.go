@@ -0,0 +1,147 @@
+package geocoder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Address keys of Nominatim responses, in order of preference per subdivision level
+var nominatimSubdivisionKeys = [][]string{
+	{"state", "province", "region"},
+	{"city", "regency", "state_district", "county"},
+	{"city_district", "district", "municipality", "county", "town"},
+	{"village", "suburb", "quarter", "neighbourhood", "hamlet"},
+}
+
+type nominatimGeocoder struct {
+	baseURL   string
+	userAgent string
+	email     string
+	language  string
+	client    *http.Client
+}
+
+type nominatimPlace struct {
+	Lat         string            `json:"lat"`
+	Lon         string            `json:"lon"`
+	DisplayName string            `json:"display_name"`
+	Address     map[string]string `json:"address"`
+	Error       string            `json:"error"`
+}
+
+func (g *nominatimGeocoder) Forward(ctx context.Context, q *Query) (*Place, error) {
+	params := url.Values{}
+	params.Set("q", queryText(q))
+	params.Set("limit", "1")
+	if q.CountryCode != "" {
+		params.Set("countrycodes", strings.ToLower(q.CountryCode))
+	}
+
+	var results []nominatimPlace
+	if err := g.get(ctx, "/search", params, &results); err != nil {
+		return nil, fmt.Errorf("failed to geocode address: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("failed to geocode address: %w", ErrNoResult)
+	}
+
+	p, err := results[0].toPlace()
+	if err != nil {
+		return nil, fmt.Errorf("failed to geocode address: %w", err)
+	}
+	return p, nil
+}
+
+func (g *nominatimGeocoder) Reverse(ctx context.Context, latitude, longitude float64) (*Place, error) {
+	params := url.Values{}
+	params.Set("lat", strconv.FormatFloat(latitude, 'f', -1, 64))
+	params.Set("lon", strconv.FormatFloat(longitude, 'f', -1, 64))
+	params.Set("zoom", "18")
+
+	var result nominatimPlace
+	if err := g.get(ctx, "/reverse", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to reverse geocode location: %w", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("failed to reverse geocode location: %w", ErrNoResult)
+	}
+
+	p, err := result.toPlace()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reverse geocode location: %w", err)
+	}
+	return p, nil
+}
+
+func (g *nominatimGeocoder) get(ctx context.Context, path string, params url.Values, v any) error {
+	params.Set("format", "jsonv2")
+	params.Set("addressdetails", "1")
+	if g.email != "" {
+		params.Set("email", g.email)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+	if g.language != "" {
+		req.Header.Set("Accept-Language", g.language)
+	}
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (p *nominatimPlace) toPlace() (*Place, error) {
+	lat, err := strconv.ParseFloat(p.Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude %q: %w", p.Lat, err)
+	}
+	lon, err := strconv.ParseFloat(p.Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude %q: %w", p.Lon, err)
+	}
+
+	used := make(map[string]bool)
+	subdivisions := make([]string, 0, len(nominatimSubdivisionKeys))
+	for _, keys := range nominatimSubdivisionKeys {
+		value := ""
+		for _, k := range keys {
+			if v := p.Address[k]; v != "" && !used[k] {
+				value, used[k] = v, true
+				break
+			}
+		}
+		subdivisions = append(subdivisions, value)
+	}
+
+	street := p.Address["road"]
+	if n := p.Address["house_number"]; n != "" && street != "" {
+		street = fmt.Sprintf("%s %s", street, n)
+	}
+
+	return &Place{
+		Latitude:     lat,
+		Longitude:    lon,
+		CountryCode:  strings.ToUpper(p.Address["country_code"]),
+		Subdivisions: subdivisions,
+		Street:       street,
+		Postcode:     p.Address["postcode"],
+		DisplayName:  p.DisplayName,
+	}, nil
+}
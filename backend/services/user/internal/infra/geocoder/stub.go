@@ -0,0 +1,30 @@
+package geocoder
+
+import (
+	"context"
+	"strings"
+)
+
+// stubGeocoder resolves every query to a fixed place for local development
+type stubGeocoder struct {
+	place Place
+}
+
+func (g *stubGeocoder) Forward(ctx context.Context, q *Query) (*Place, error) {
+	if q.CountryCode != "" && !strings.EqualFold(q.CountryCode, g.place.CountryCode) {
+		return nil, ErrNoResult
+	}
+	return g.clone(), nil
+}
+
+func (g *stubGeocoder) Reverse(ctx context.Context, latitude, longitude float64) (*Place, error) {
+	p := g.clone()
+	p.Latitude, p.Longitude = latitude, longitude
+	return p, nil
+}
+
+func (g *stubGeocoder) clone() *Place {
+	p := g.place
+	p.Subdivisions = append([]string(nil), g.place.Subdivisions...)
+	return &p
+}
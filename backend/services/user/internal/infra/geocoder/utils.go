@@ -0,0 +1,70 @@
+package geocoder
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// limiter spaces calls evenly so that no more than rps calls are made per second
+type limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newLimiter(rps float64) *limiter {
+	if rps <= 0 {
+		return &limiter{}
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rps)}
+}
+
+func (l *limiter) Wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	d := time.Until(at)
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// queryText joins the query parts from the most to the least specific
+func queryText(q *Query) string {
+	parts := make([]string, 0, len(q.Subdivisions)+2)
+	if s := strings.TrimSpace(q.Street); s != "" {
+		parts = append(parts, s)
+	}
+	for i := len(q.Subdivisions) - 1; i >= 0; i-- {
+		if s := strings.TrimSpace(q.Subdivisions[i]); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if s := strings.TrimSpace(q.Postcode); s != "" {
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ", ")
+}
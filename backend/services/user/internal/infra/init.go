@@ -6,21 +6,37 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/certs"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/dataset"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/geocoder"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/publisher"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/services"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/storage"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/subscriber"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/tracer"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
 type Infra struct {
+	certs    *mtls.Reloader
 	config   *configs.Config
 	database *pgxpool.Pool
+	geocoder geocoder.Geocoder
 	logger   *zap.Logger
+	regions  []dataset.Region
+	storage  storage.Storage
 	tracer   *tracer.Tracer
+	as       apis.AuthServiceClient
+	ns       apis.NotificationServiceClient
 
 	acs *kafka.Reader
+	ads *kafka.Reader
+	udp *kafka.Writer
 }
 
 func Init(cfg *configs.Config) (*Infra, error) {
@@ -29,41 +45,130 @@ func Init(cfg *configs.Config) (*Infra, error) {
 		return nil, err
 	}
 
+	cr, err := certs.Init(&cfg.TLS, l)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := database.Init(&cfg.Database, l)
 	if err != nil {
 		return nil, err
 	}
 
+	rs, err := dataset.Init(l)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := storage.Init(&cfg.Storage, l)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := geocoder.Init(&cfg.Geocoder, l)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := tracer.Init(cfg.App.Name, cfg.Tracer.Endpoint, l)
 	if err != nil {
 		return nil, err
 	}
 
+	// Services
+	as, err := services.NewAuthService(&cfg.Service, cr, l)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := services.NewNotificationService(&cfg.Service, cr, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// Subscribers
 	acs := subscriber.Init(&cfg.Broker, constants.EventTopicAuthCreated, l)
+	ads := subscriber.Init(&cfg.Broker, constants.EventTopicAuthDeleted, l)
+
+	// Publishers
+	udp := publisher.Init(&cfg.Broker, constants.EventTopicUserDataExported, l)
 
-	return &Infra{config: cfg, database: db, logger: l, tracer: t, acs: acs}, nil
+	return &Infra{
+		certs:    cr,
+		config:   cfg,
+		database: db,
+		geocoder: g,
+		logger:   l,
+		regions:  rs,
+		storage:  s,
+		tracer:   t,
+		as:       as,
+		ns:       ns,
+		acs:      acs,
+		ads:      ads,
+		udp:      udp,
+	}, nil
 }
 
 func (i *Infra) Database() *pgxpool.Pool {
 	return i.database
 }
 
+func (i *Infra) Geocoder() geocoder.Geocoder {
+	return i.geocoder
+}
+
+func (i *Infra) Certs() *mtls.Reloader {
+	return i.certs
+}
+
 func (i *Infra) Logger() *zap.Logger {
 	return i.logger
 }
 
+func (i *Infra) AuthService() apis.AuthServiceClient {
+	return i.as
+}
+
+func (i *Infra) NotificationService() apis.NotificationServiceClient {
+	return i.ns
+}
+
+func (i *Infra) Regions() []dataset.Region {
+	return i.regions
+}
+
+func (i *Infra) Storage() storage.Storage {
+	return i.storage
+}
+
 func (i *Infra) SubAuthCreated() *kafka.Reader {
 	return i.acs
 }
 
+func (i *Infra) SubAuthDeleted() *kafka.Reader {
+	return i.ads
+}
+
+func (i *Infra) PubUserDataExported() *kafka.Writer {
+	return i.udp
+}
+
 func (i *Infra) Close() error {
+	if i.certs != nil {
+		i.certs.Close()
+	}
 	if err := i.logger.Sync(); err != nil {
 		return fmt.Errorf("failed to close logger: %w", err)
 	}
 	if err := i.acs.Close(); err != nil {
 		return fmt.Errorf("failed to close subscriber (%s): %w", constants.EventTopicAuthCreated, err)
 	}
+	if err := i.ads.Close(); err != nil {
+		return fmt.Errorf("failed to close subscriber (%s): %w", constants.EventTopicAuthDeleted, err)
+	}
+	if err := i.udp.Close(); err != nil {
+		return fmt.Errorf("failed to close publisher (%s): %w", constants.EventTopicUserDataExported, err)
+	}
 
 	i.database.Close()
 	i.tracer.Cleanup()
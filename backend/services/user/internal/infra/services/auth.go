@@ -0,0 +1,20 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"go.uber.org/zap"
+)
+
+func NewAuthService(cfg *configs.Service, r *mtls.Reloader, l *zap.Logger) (apis.AuthServiceClient, error) {
+	conn, err := newConn(&cfg.Auth, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize auth service: %w", err)
+	}
+
+	l.Sugar().Infof("✅ [AUTH-SERVICE] running on (host=%s, port=%d)", cfg.Auth.Host, cfg.Auth.Port)
+	return apis.NewAuthServiceClient(conn), nil
+}
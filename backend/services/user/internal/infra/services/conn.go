@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/interceptors"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const serviceConfig string = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+func newConn(d *configs.Downstream, r *mtls.Reloader) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if r != nil {
+		creds = mtls.ClientCredentials(r, d.Host)
+	}
+
+	// Resolve through DNS so every replica behind the host is balanced round-robin
+	return grpc.NewClient(
+		fmt.Sprintf("dns:///%s", d.Addr),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(interceptors.UnaryClientPropagation(), timeoutInterceptor(d)),
+	)
+}
+
+func timeoutInterceptor(d *configs.Downstream) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if d.Timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, d.Timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
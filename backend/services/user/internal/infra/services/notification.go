@@ -0,0 +1,20 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
+	"go.uber.org/zap"
+)
+
+func NewNotificationService(cfg *configs.Service, r *mtls.Reloader, l *zap.Logger) (apis.NotificationServiceClient, error) {
+	conn, err := newConn(&cfg.Notification, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize notification service: %w", err)
+	}
+
+	l.Sugar().Infof("✅ [NOTIFICATION-SERVICE] running on (host=%s, port=%d)", cfg.Notification.Host, cfg.Notification.Port)
+	return apis.NewNotificationServiceClient(conn), nil
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"go.uber.org/zap"
+)
+
+func Init(cfg *configs.Storage, l *zap.Logger) (Storage, error) {
+	switch cfg.Driver {
+	case "local":
+		if err := os.MkdirAll(cfg.Local.Dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to initialize storage: %w", err)
+		}
+
+		s := localStorage{
+			dir:        cfg.Local.Dir,
+			baseURL:    strings.TrimRight(cfg.Local.BaseURL, "/"),
+			signingKey: cfg.Local.SigningKey,
+		}
+
+		l.Sugar().Infof("✅ [STORAGE] initialized (driver=%s, dir=%s)", cfg.Driver, cfg.Local.Dir)
+		return &s, nil
+	case "s3":
+		s := s3Storage{
+			endpoint:  strings.TrimRight(cfg.S3.Endpoint, "/"),
+			region:    cfg.S3.Region,
+			bucket:    cfg.S3.Bucket,
+			accessKey: cfg.S3.AccessKey,
+			secretKey: cfg.S3.SecretKey,
+			baseURL:   strings.TrimRight(cfg.S3.BaseURL, "/"),
+			client:    &http.Client{Timeout: cfg.S3.Timeout},
+		}
+
+		l.Sugar().Infof("✅ [STORAGE] initialized (driver=%s, endpoint=%s, bucket=%s)", cfg.Driver, cfg.S3.Endpoint, cfg.S3.Bucket)
+		return &s, nil
+	default:
+		return nil, fmt.Errorf("failed to initialize storage: unsupported driver %q", cfg.Driver)
+	}
+}
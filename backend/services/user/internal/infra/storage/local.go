@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+type localStorage struct {
+	dir        string
+	baseURL    string
+	signingKey string
+}
+
+func (s *localStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+
+	// Written to a temporary file first so readers never see a partial image
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, keys ...string) error {
+	var errs []error
+	for _, key := range keys {
+		path, err := s.path(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to delete objects: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (s *localStorage) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// SignedURL signs the key and expiry with the shared signing key, the gateway verifies both before serving
+func (s *localStorage) SignedURL(key string, expiresAt time.Time) (string, error) {
+	if s.signingKey == "" {
+		return "", errors.New("failed to sign object url: signing key is not configured")
+	}
+
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	signature := hex.EncodeToString(hmacSHA256([]byte(s.signingKey), key+"\n"+expires))
+
+	q := url.Values{}
+	q.Set("expires", expires)
+	q.Set("signature", signature)
+
+	return s.URL(key) + "?" + q.Encode(), nil
+}
+
+func (s *localStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid object key: %s", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,13 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+type Storage interface {
+	Put(ctx context.Context, key, contentType string, data []byte) (err error)
+	Delete(ctx context.Context, keys ...string) (err error)
+	URL(key string) (url string)
+	SignedURL(key string, expiresAt time.Time) (url string, err error)
+}
@@ -6,6 +6,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
@@ -39,6 +40,7 @@ func Init(appName, endpoint string, l *zap.Logger) (*Tracer, error) {
 	)
 
 	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
 
 	l.Sugar().Infof("✅ [TRACER] initialized (app_name=%s, endpoint=%s)", appName, endpoint)
 	return &Tracer{Cleanup: func() { _ = tp.Shutdown(ctx) }}, nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"strings"
 
-	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/usecases"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
@@ -17,20 +16,24 @@ const addressErrTracer string = "handler.address"
 
 type AddressHandler struct {
 	apis.UnimplementedUserAddressServiceServer
-	au     usecases.AddressUsecase
-	logger *logger.Logger
+	au usecases.AddressUsecase
 }
 
-func NewAddressHandler(au usecases.AddressUsecase, l *logger.Logger) *AddressHandler {
-	return &AddressHandler{au: au, logger: l}
+func NewAddressHandler(au usecases.AddressUsecase) *AddressHandler {
+	return &AddressHandler{au: au}
 }
 
 func (h *AddressHandler) CreateAddress(ctx context.Context, req *apis.CreateUserAddressRequest) (*apis.CreateUserAddressResponse, error) {
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "CreateAddress")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.CreateAddress{
-		AuthID:       req.GetAuthId(),
+		AuthID:       authID,
 		Recipient:    strings.TrimSpace(req.GetRecipient()),
 		Phone:        strings.TrimSpace(req.GetPhone()),
 		Label:        strings.TrimSpace(req.GetLabel()),
@@ -49,8 +52,7 @@ func (h *AddressHandler) CreateAddress(ctx context.Context, req *apis.CreateUser
 
 	address, opa, err := h.au.CreateAddress(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	var oldPrimaryAddress *apis.UserAddress
@@ -68,10 +70,19 @@ func (h *AddressHandler) GetAllAddresses(ctx context.Context, req *apis.GetAllUs
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAllAddresses")
 	defer span.End()
 
-	addresses, err := h.au.GetAllAddresses(ctx, req.GetAuthId())
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
+	}
+
+	data := models.ListAddresses{
+		AuthID: authID,
+		Page:   utils.UnwrapPageRequest(req.GetPage()),
+	}
+
+	addresses, page, err := h.au.GetAllAddresses(ctx, &data)
+	if err != nil {
+		return nil, err
 	}
 
 	addrs := make([]*apis.UserAddress, 0, len(addresses))
@@ -80,15 +91,20 @@ func (h *AddressHandler) GetAllAddresses(ctx context.Context, req *apis.GetAllUs
 		addrs = append(addrs, addr)
 	}
 
-	return &apis.GetAllUserAddressesResponse{Addresses: addrs}, nil
+	return &apis.GetAllUserAddressesResponse{Addresses: addrs, Page: utils.WrapPageInfo(page)}, nil
 }
 
 func (h *AddressHandler) UpdateAddress(ctx context.Context, req *apis.UpdateUserAddressRequest) (*apis.UpdateUserAddressResponse, error) {
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "UpdateAddress")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.UpdateAddress{
-		AuthID:       req.GetAuthId(),
+		AuthID:       authID,
 		AddressID:    req.GetAddressId(),
 		Recipient:    utils.TrimSpacePtr(utils.UnwrapString(req.GetRecipient())),
 		Phone:        utils.TrimSpacePtr(utils.UnwrapString(req.GetPhone())),
@@ -107,8 +123,7 @@ func (h *AddressHandler) UpdateAddress(ctx context.Context, req *apis.UpdateUser
 
 	address, err := h.au.UpdateAddress(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.UpdateUserAddressResponse{Address: h.toAddress(address)}, nil
@@ -118,15 +133,19 @@ func (h *AddressHandler) SetPrimaryAddress(ctx context.Context, req *apis.SetPri
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "SetPrimaryAddress")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.SetPrimaryAddress{
-		AuthID:    req.GetAuthId(),
+		AuthID:    authID,
 		AddressID: req.GetAddressId(),
 	}
 
 	npa, opa, err := h.au.SetPrimaryAddress(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.SetPrimaryAddressResponse{
@@ -139,15 +158,19 @@ func (h *AddressHandler) DeleteAddress(ctx context.Context, req *apis.DeleteAddr
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "DeleteAddress")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.DeleteAddress{
-		AuthID:    req.GetAuthId(),
+		AuthID:    authID,
 		AddressID: req.GetAddressId(),
 	}
 
 	npa, err := h.au.DeleteAddress(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	var address *apis.UserAddress
@@ -158,6 +181,132 @@ func (h *AddressHandler) DeleteAddress(ctx context.Context, req *apis.DeleteAddr
 	return &apis.DeleteAddressResponse{NewPrimaryAddress: address}, nil
 }
 
+func (h *AddressHandler) RestoreAddress(ctx context.Context, req *apis.RestoreAddressRequest) (*apis.RestoreAddressResponse, error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "RestoreAddress")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := h.au.RestoreAddress(ctx, authID, req.GetAddressId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.RestoreAddressResponse{Address: h.toAddress(address)}, nil
+}
+
+func (h *AddressHandler) GetAddressesWithinRadius(ctx context.Context, req *apis.GetAddressesWithinRadiusRequest) (*apis.GetAddressesWithinRadiusResponse, error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressesWithinRadius")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	data := models.GetAddressesWithinRadius{
+		AuthID:    authID,
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+		RadiusKm:  req.GetRadiusKm(),
+	}
+
+	ads, err := h.au.GetAddressesWithinRadius(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]*apis.UserAddressDistance, 0, len(ads))
+	for i := range ads {
+		addresses = append(addresses, h.toAddressDistance(&ads[i]))
+	}
+
+	return &apis.GetAddressesWithinRadiusResponse{Addresses: addresses}, nil
+}
+
+func (h *AddressHandler) GetNearestAddress(ctx context.Context, req *apis.GetNearestAddressRequest) (*apis.GetNearestAddressResponse, error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetNearestAddress")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	data := models.GetNearestAddress{
+		AuthID:    authID,
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+	}
+
+	ad, err := h.au.GetNearestAddress(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetNearestAddressResponse{Address: h.toAddressDistance(ad)}, nil
+}
+
+func (h *AddressHandler) GetAddressDistance(ctx context.Context, req *apis.GetAddressDistanceRequest) (*apis.GetAddressDistanceResponse, error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressDistance")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	data := models.GetAddressDistance{
+		AuthID:        authID,
+		FromAddressID: req.GetFromAddressId(),
+		ToAddressID:   req.GetToAddressId(),
+	}
+
+	distanceKm, err := h.au.GetAddressDistance(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetAddressDistanceResponse{DistanceKm: distanceKm}, nil
+}
+
+func (h *AddressHandler) GeocodeAddress(ctx context.Context, req *apis.GeocodeAddressRequest) (*apis.GeocodeAddressResponse, error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GeocodeAddress")
+	defer span.End()
+
+	data := models.GeocodeAddress{
+		Country:      utils.NormalizeString(req.GetCountry()),
+		Subdivision1: utils.NormalizeStringPtr(utils.UnwrapString(req.GetSubdivision_1())),
+		Subdivision2: utils.NormalizeStringPtr(utils.UnwrapString(req.GetSubdivision_2())),
+		Subdivision3: utils.NormalizeStringPtr(utils.UnwrapString(req.GetSubdivision_3())),
+		Subdivision4: utils.NormalizeStringPtr(utils.UnwrapString(req.GetSubdivision_4())),
+		Street:       strings.TrimSpace(req.GetStreet()),
+		Postcode:     strings.TrimSpace(req.GetPostcode()),
+	}
+
+	address, err := h.au.GeocodeAddress(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GeocodeAddressResponse{Address: h.toGeocodedAddress(address)}, nil
+}
+
+func (h *AddressHandler) ReverseGeocode(ctx context.Context, req *apis.ReverseGeocodeRequest) (*apis.ReverseGeocodeResponse, error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "ReverseGeocode")
+	defer span.End()
+
+	address, err := h.au.ReverseGeocode(ctx, req.GetLatitude(), req.GetLongitude())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.ReverseGeocodeResponse{Address: h.toGeocodedAddress(address)}, nil
+}
+
 func (h *AddressHandler) toAddress(a *models.Address) *apis.UserAddress {
 	address := apis.UserAddress{
 		Id:            a.ID,
@@ -166,7 +315,7 @@ func (h *AddressHandler) toAddress(a *models.Address) *apis.UserAddress {
 		Label:         a.Label,
 		Notes:         utils.WrapString(a.Notes),
 		IsPrimary:     a.IsPrimary,
-		Country:       utils.ToTitlecase(a.Country),
+		Country:       a.Country,
 		Subdivision_1: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision1)),
 		Subdivision_2: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision2)),
 		Subdivision_3: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision3)),
@@ -180,3 +329,25 @@ func (h *AddressHandler) toAddress(a *models.Address) *apis.UserAddress {
 	}
 	return &address
 }
+
+func (h *AddressHandler) toAddressDistance(ad *models.AddressDistance) *apis.UserAddressDistance {
+	return &apis.UserAddressDistance{
+		Address:    h.toAddress(&ad.Address),
+		DistanceKm: ad.DistanceKm,
+	}
+}
+
+func (h *AddressHandler) toGeocodedAddress(a *models.GeocodedAddress) *apis.GeocodedAddress {
+	return &apis.GeocodedAddress{
+		Country:       a.Country,
+		Subdivision_1: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision1)),
+		Subdivision_2: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision2)),
+		Subdivision_3: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision3)),
+		Subdivision_4: utils.WrapString(utils.ToTitlecasePtr(a.Subdivision4)),
+		Street:        a.Street,
+		Postcode:      a.Postcode,
+		Latitude:      a.Latitude,
+		Longitude:     a.Longitude,
+		DisplayName:   a.DisplayName,
+	}
+}
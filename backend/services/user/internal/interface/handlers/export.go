@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const exportErrTracer string = "handler.export"
+
+type ExportHandler struct {
+	apis.UnimplementedUserExportServiceServer
+	eu usecases.ExportUsecase
+}
+
+func NewExportHandler(eu usecases.ExportUsecase) *ExportHandler {
+	return &ExportHandler{eu: eu}
+}
+
+func (h *ExportHandler) ExportMyData(ctx context.Context, req *apis.ExportMyDataRequest) (*apis.ExportMyDataResponse, error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "ExportMyData")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := h.eu.ExportMyData(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.ExportMyDataResponse{DataExport: h.toDataExport(export)}, nil
+}
+
+func (h *ExportHandler) GetDataExport(ctx context.Context, req *apis.GetDataExportRequest) (*apis.GetDataExportResponse, error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "GetDataExport")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := h.eu.GetDataExport(ctx, authID, req.GetExportId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetDataExportResponse{DataExport: h.toDataExport(export)}, nil
+}
+
+func (h *ExportHandler) toDataExport(export *models.DataExport) *apis.DataExport {
+	return &apis.DataExport{
+		Id:          export.ID,
+		Status:      export.Status,
+		DownloadUrl: utils.WrapString(export.DownloadURL),
+		ExpiresAt:   utils.WrapTime(export.ExpiresAt),
+		CreatedAt:   timestamppb.New(export.CreatedAt),
+		CompletedAt: utils.WrapTime(export.CompletedAt),
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/identity"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// callerAuthID resolves the account a call acts on from the identity verified by the server, the auth_id of the
+// request is only kept for compatibility and must name the same account when set
+func callerAuthID(ctx context.Context, span trace.Span, requested int64) (int64, *ce.Error) {
+	id, ok := identity.FromContext(ctx)
+	if !ok {
+		e := fmt.Errorf("failed to resolve caller: %w", errors.New("identity not provided"))
+		return 0, ce.NewError(span, ce.CodeUnauthenticated, ce.MsgUnauthenticated, e)
+	}
+	if requested != 0 && requested != id.AuthID {
+		e := fmt.Errorf("failed to resolve caller (auth_id=%d, requested=%d): %w", id.AuthID, requested, ce.ErrIdentityMismatch)
+		return 0, ce.NewError(span, ce.CodeUnauthorized, ce.MsgUnauthorized, e)
+	}
+
+	return id.AuthID, nil
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"go.opentelemetry.io/otel"
+)
+
+const regionErrTracer string = "handler.region"
+
+type RegionHandler struct {
+	apis.UnimplementedUserRegionServiceServer
+	ru usecases.RegionUsecase
+}
+
+func NewRegionHandler(ru usecases.RegionUsecase) *RegionHandler {
+	return &RegionHandler{ru: ru}
+}
+
+func (h *RegionHandler) ListRegions(ctx context.Context, req *apis.ListRegionsRequest) (*apis.ListRegionsResponse, error) {
+	ctx, span := otel.Tracer(regionErrTracer).Start(ctx, "ListRegions")
+	defer span.End()
+
+	regions, err := h.ru.ListRegions(ctx, req.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*apis.Region, 0, len(regions))
+	for _, region := range regions {
+		rs = append(rs, h.toRegion(&region))
+	}
+
+	return &apis.ListRegionsResponse{Regions: rs}, nil
+}
+
+func (h *RegionHandler) toRegion(r *models.Region) *apis.Region {
+	if r == nil {
+		return nil
+	}
+
+	region := apis.Region{
+		Id:       r.ID,
+		ParentId: r.ParentID,
+		Level:    int32(r.Level),
+		Name:     r.Name,
+	}
+	return &region
+}
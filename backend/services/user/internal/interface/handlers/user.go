@@ -3,7 +3,6 @@ package handlers
 import (
 	"context"
 
-	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/usecases"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
@@ -16,20 +15,24 @@ const userErrTracer string = "handler.user"
 
 type UserHandler struct {
 	apis.UnimplementedUserServiceServer
-	uu     usecases.UserUsecase
-	logger *logger.Logger
+	uu usecases.UserUsecase
 }
 
-func NewUserHandler(uu usecases.UserUsecase, l *logger.Logger) *UserHandler {
-	return &UserHandler{uu: uu, logger: l}
+func NewUserHandler(uu usecases.UserUsecase) *UserHandler {
+	return &UserHandler{uu: uu}
 }
 
 func (h *UserHandler) UpsertUser(ctx context.Context, req *apis.UpsertUserRequest) (*apis.UpsertUserResponse, error) {
 	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpsertUser")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.UpsertUser{
-		AuthID:    req.GetAuthId(),
+		AuthID:    authID,
 		Name:      req.GetName(),
 		Bio:       utils.UnwrapString(req.GetBio()),
 		Sex:       utils.UnwrapString(req.GetSex()),
@@ -39,8 +42,7 @@ func (h *UserHandler) UpsertUser(ctx context.Context, req *apis.UpsertUserReques
 
 	user, err := h.uu.UpsertUser(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.UpsertUserResponse{User: h.toUser(user)}, nil
@@ -50,10 +52,14 @@ func (h *UserHandler) GetUser(ctx context.Context, req *apis.GetUserRequest) (*a
 	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetUser")
 	defer span.End()
 
-	user, err := h.uu.GetUser(ctx, req.GetAuthId())
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.uu.GetUser(ctx, authID)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.GetUserResponse{User: h.toUser(user)}, nil
@@ -63,8 +69,13 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *apis.UpdateUserReques
 	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateUser")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.UpdateUser{
-		AuthID:    req.GetAuthId(),
+		AuthID:    authID,
 		Name:      utils.UnwrapString(req.GetName()),
 		Bio:       utils.UnwrapString(req.GetBio()),
 		Sex:       utils.UnwrapString(req.GetSex()),
@@ -74,8 +85,7 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *apis.UpdateUserReques
 
 	user, err := h.uu.UpdateUser(ctx, &data)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
 	return &apis.UpdateUserResponse{User: h.toUser(user)}, nil
@@ -85,18 +95,96 @@ func (h *UserHandler) UpdateProfilePicture(ctx context.Context, req *apis.Update
 	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateProfilePicture")
 	defer span.End()
 
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
 	data := models.UpdateProfilePicture{
-		AuthID:         req.GetAuthId(),
-		ProfilePicture: req.GetProfilePicture(),
+		AuthID: authID,
+		Image:  req.GetImage(),
+	}
+
+	pp, err := h.uu.UpdateProfilePicture(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnails := make(map[int32]string, len(pp.Thumbnails))
+	for size, url := range pp.Thumbnails {
+		thumbnails[int32(size)] = url
+	}
+
+	return &apis.UpdateProfilePictureResponse{ProfilePicture: pp.URL, Thumbnails: thumbnails}, nil
+}
+
+func (h *UserHandler) GetPublicProfile(ctx context.Context, req *apis.GetPublicProfileRequest) (*apis.GetPublicProfileResponse, error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetPublicProfile")
+	defer span.End()
+
+	profile, err := h.uu.GetPublicProfile(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.GetPublicProfileResponse{
+		Profile: &apis.PublicProfile{
+			Id:             profile.ID,
+			Name:           profile.Name,
+			Bio:            utils.WrapString(profile.Bio),
+			ProfilePicture: utils.WrapString(profile.ProfilePicture),
+			MemberSince:    utils.WrapTime(profile.MemberSince),
+		},
+	}, nil
+}
+
+func (h *UserHandler) GetProfileVisibility(ctx context.Context, req *apis.GetProfileVisibilityRequest) (*apis.GetProfileVisibilityResponse, error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetProfileVisibility")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
 	}
 
-	profilePicture, err := h.uu.UpdateProfilePicture(ctx, &data)
+	visibility, err := h.uu.GetProfileVisibility(ctx, authID)
 	if err != nil {
-		h.logger.Sugar().Errorln(err.Error())
-		return nil, err.ToGRPCStatus()
+		return nil, err
 	}
 
-	return &apis.UpdateProfilePictureResponse{ProfilePicture: profilePicture}, nil
+	return &apis.GetProfileVisibilityResponse{Visibility: h.toProfileVisibility(visibility)}, nil
+}
+
+func (h *UserHandler) UpdateProfileVisibility(ctx context.Context, req *apis.UpdateProfileVisibilityRequest) (*apis.UpdateProfileVisibilityResponse, error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateProfileVisibility")
+	defer span.End()
+
+	authID, err := callerAuthID(ctx, span, req.GetAuthId())
+	if err != nil {
+		return nil, err
+	}
+
+	data := models.UpdateProfileVisibility{
+		AuthID:         authID,
+		Bio:            utils.UnwrapBool(req.GetBio()),
+		ProfilePicture: utils.UnwrapBool(req.GetProfilePicture()),
+		MemberSince:    utils.UnwrapBool(req.GetMemberSince()),
+	}
+
+	visibility, err := h.uu.UpdateProfileVisibility(ctx, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apis.UpdateProfileVisibilityResponse{Visibility: h.toProfileVisibility(visibility)}, nil
+}
+
+func (h *UserHandler) toProfileVisibility(v *models.ProfileVisibility) *apis.ProfileVisibility {
+	return &apis.ProfileVisibility{
+		Bio:            v.Bio,
+		ProfilePicture: v.ProfilePicture,
+		MemberSince:    v.MemberSince,
+	}
 }
 
 func (h *UserHandler) toUser(u *models.User) *apis.User {
@@ -9,6 +9,9 @@ import (
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/interface/handlers"
 	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/identity"
+	"github.com/ritchieridanko/pasarly/backend/shared/interceptors"
+	"github.com/ritchieridanko/pasarly/backend/shared/mtls"
 	"google.golang.org/grpc"
 )
 
@@ -18,11 +21,33 @@ type Server struct {
 	logger *logger.Logger
 }
 
-func Init(cfg *configs.Server, l *logger.Logger, uh *handlers.UserHandler, ah *handlers.AddressHandler) *Server {
-	s := grpc.NewServer()
+func Init(
+	cfg *configs.Server,
+	tls *configs.TLS,
+	id *configs.Identity,
+	r *mtls.Reloader,
+	l *logger.Logger,
+	uh *handlers.UserHandler,
+	ah *handlers.AddressHandler,
+	rh *handlers.RegionHandler,
+	eh *handlers.ExportHandler,
+) *Server {
+	opts := []grpc.ServerOption{interceptors.Unary(l.Base()), grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize)}
+	if r != nil {
+		opts = append(
+			opts,
+			grpc.Creds(mtls.ServerCredentials(r)),
+			grpc.ChainUnaryInterceptor(mtls.AllowInterceptor(tls.Allow)),
+		)
+	}
+	opts = append(opts, grpc.ChainUnaryInterceptor(identity.UnaryServerInterceptor(id.Secret)))
+
+	s := grpc.NewServer(opts...)
 
 	apis.RegisterUserServiceServer(s, uh)
 	apis.RegisterUserAddressServiceServer(s, ah)
+	apis.RegisterUserRegionServiceServer(s, rh)
+	apis.RegisterUserExportServiceServer(s, eh)
 
 	return &Server{config: cfg, server: s, logger: l}
 }
@@ -40,6 +40,11 @@ type CreateAddress struct {
 	Longitude    float64
 }
 
+type ListAddresses struct {
+	AuthID int64
+	Page   PageRequest
+}
+
 type UpdateAddress struct {
 	AuthID       int64
 	AddressID    int64
@@ -67,3 +72,66 @@ type DeleteAddress struct {
 	AuthID    int64
 	AddressID int64
 }
+
+type RestoreAddress struct {
+	AuthID       int64
+	AddressID    int64
+	DeletedAfter time.Time
+}
+
+type FindDuplicateAddress struct {
+	AuthID    int64
+	AddressID int64
+	Street    string
+	Postcode  string
+	Latitude  float64
+	Longitude float64
+	RadiusM   float64
+}
+
+type AddressDistance struct {
+	Address    Address
+	DistanceKm float64
+}
+
+type GetAddressesWithinRadius struct {
+	AuthID    int64
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+type GetNearestAddress struct {
+	AuthID    int64
+	Latitude  float64
+	Longitude float64
+}
+
+type GetAddressDistance struct {
+	AuthID        int64
+	FromAddressID int64
+	ToAddressID   int64
+}
+
+type GeocodeAddress struct {
+	Country      string
+	Subdivision1 *string
+	Subdivision2 *string
+	Subdivision3 *string
+	Subdivision4 *string
+	Street       string
+	Postcode     string
+}
+
+type GeocodedAddress struct {
+	Country      string
+	Subdivision1 *string
+	Subdivision2 *string
+	Subdivision3 *string
+	Subdivision4 *string
+	Street       string
+	Postcode     string
+	Latitude     float64
+	Longitude    float64
+	DisplayName  string
+}
@@ -0,0 +1,70 @@
+package models
+
+import "time"
+
+type DataExport struct {
+	ID          string
+	AuthID      int64
+	Status      string
+	ObjectKey   *string
+	Attempts    int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+	DownloadURL *string
+}
+
+type CompleteDataExport struct {
+	ExportID  string
+	ObjectKey string
+	ExpiresAt time.Time
+}
+
+type FailDataExport struct {
+	ExportID    string
+	Error       string
+	MaxAttempts int
+}
+
+type ExpiredDataExport struct {
+	ID        string
+	ObjectKey string
+}
+
+// DataExportContent is everything gathered about a user across services
+type DataExportContent struct {
+	ExportedAt    time.Time
+	Auth          ExportedAuth
+	Sessions      []ExportedSession
+	Profile       *User
+	Addresses     []Address
+	Notifications []ExportedNotification
+}
+
+type ExportedAuth struct {
+	ID                  int64
+	Email               string
+	Role                string
+	IsVerified          bool
+	Locale              string
+	DeletionScheduledAt *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type ExportedSession struct {
+	ID        int64
+	UserAgent string
+	IPAddress string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
+type ExportedNotification struct {
+	ID          string
+	Type        string
+	ProcessedAt time.Time
+	CompletedAt *time.Time
+}
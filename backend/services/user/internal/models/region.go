@@ -0,0 +1,8 @@
+package models
+
+type Region struct {
+	ID       string
+	ParentID string
+	Level    int
+	Name     string
+}
@@ -40,6 +40,34 @@ type UpdateUser struct {
 }
 
 type UpdateProfilePicture struct {
+	AuthID            int64
+	Image             []byte
+	ProfilePicture    string
+	ProfilePictureKey string
+}
+
+type ProfilePicture struct {
+	URL        string
+	Thumbnails map[int]string
+}
+
+type PublicProfile struct {
+	ID             string
+	Name           string
+	Bio            *string
+	ProfilePicture *string
+	MemberSince    *time.Time
+}
+
+type ProfileVisibility struct {
+	Bio            bool
+	ProfilePicture bool
+	MemberSince    bool
+}
+
+type UpdateProfileVisibility struct {
 	AuthID         int64
-	ProfilePicture string
+	Bio            *bool
+	ProfilePicture *bool
+	MemberSince    *bool
 }
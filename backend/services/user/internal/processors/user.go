@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/storage"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/repositories"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
 	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/idempotency"
 	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -20,15 +24,27 @@ const userErrTracer string = "processor.user"
 
 type UserProcessor interface {
 	OnAuthCreated(ctx context.Context, m kafka.Message) (err error)
+	OnAuthDeleted(ctx context.Context, m kafka.Message) (err error)
 }
 
 type userProcessor struct {
 	ur         repositories.UserRepository
+	ar         repositories.AddressRepository
+	storage    storage.Storage
+	image      *utils.ImageProcessor
 	transactor *database.Transactor
+	claimer    *idempotency.Claimer
 }
 
-func NewUserProcessor(ur repositories.UserRepository, tx *database.Transactor) UserProcessor {
-	return &userProcessor{ur: ur, transactor: tx}
+func NewUserProcessor(
+	ur repositories.UserRepository,
+	ar repositories.AddressRepository,
+	s storage.Storage,
+	ip *utils.ImageProcessor,
+	tx *database.Transactor,
+	c *idempotency.Claimer,
+) UserProcessor {
+	return &userProcessor{ur: ur, ar: ar, storage: s, image: ip, transactor: tx, claimer: c}
 }
 
 func (p *userProcessor) OnAuthCreated(ctx context.Context, m kafka.Message) error {
@@ -42,7 +58,13 @@ func (p *userProcessor) OnAuthCreated(ctx context.Context, m kafka.Message) erro
 		return e
 	}
 
-	err := p.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+	ctx, lease, err := p.claim(ctx, span, evt.GetEventId(), constants.EventTopicAuthCreated, evt.GetAuthId())
+	if err != nil || lease == nil {
+		return err
+	}
+	defer lease.Release(ctx)
+
+	txErr := p.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
 		exists, err := p.ur.Exists(ctx, evt.GetAuthId())
 		if err != nil {
 			return err
@@ -61,9 +83,70 @@ func (p *userProcessor) OnAuthCreated(ctx context.Context, m kafka.Message) erro
 		_, err = p.ur.CreateUser(ctx, &data)
 		return err
 	})
+	if txErr != nil {
+		return txErr.Err
+	}
+
+	return lease.Complete(ctx)
+}
+
+func (p *userProcessor) OnAuthDeleted(ctx context.Context, m kafka.Message) error {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "OnAuthDeleted")
+	defer span.End()
+
+	var evt events.AuthDeleted
+	if err := proto.Unmarshal(m.Value, &evt); err != nil {
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(span, e, ce.MsgInternalServer)
+		return e
+	}
+
+	ctx, lease, err := p.claim(ctx, span, evt.GetEventId(), constants.EventTopicAuthDeleted, evt.GetAuthId())
+	if err != nil || lease == nil {
+		return err
+	}
+	defer lease.Release(ctx)
+
+	// The picture key is only known until the erasure commits, so a storage failure retries it
+	txErr := p.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		prevKey, err := p.ur.AnonymizeUser(ctx, evt.GetAuthId())
+		if err != nil && err.Code != ce.CodeUserNotFound {
+			return err
+		}
+
+		if err := p.ar.DeleteAllAddresses(ctx, evt.GetAuthId()); err != nil {
+			return err
+		}
+
+		if prevKey != nil && *prevKey != "" {
+			if err := p.storage.Delete(ctx, p.image.VariantKeys(*prevKey)...); err != nil {
+				e := fmt.Errorf("failed to process message: %w", err)
+				return ce.NewError(span, ce.CodeStorageFailed, ce.MsgInternalServer, e)
+			}
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr.Err
+	}
+
+	return lease.Complete(ctx)
+}
+
+// claim leases the event to this instance, a nil lease means it has already been completed
+func (p *userProcessor) claim(
+	ctx context.Context,
+	s trace.Span,
+	eventID, eventType string,
+	authID int64,
+) (context.Context, *idempotency.Lease, error) {
+	ctx, lease, err := p.claimer.Claim(ctx, eventID, eventType, authID)
 	if err != nil {
-		return err.Err
+		e := fmt.Errorf("failed to process message: %w", err)
+		utils.TraceErr(s, e, ce.MsgInternalServer)
+		return ctx, nil, e
 	}
 
-	return nil
+	return ctx, lease, nil
 }
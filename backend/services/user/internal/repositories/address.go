@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
@@ -17,12 +19,21 @@ const addressErrTracer string = "repository.address"
 type AddressRepository interface {
 	CreateAddress(ctx context.Context, data *models.CreateAddress) (address *models.Address, err *ce.Error)
 	GetAllAddresses(ctx context.Context, authID int64) (addresses []models.Address, err *ce.Error)
+	ListAddresses(ctx context.Context, data *models.ListAddresses) (addresses []models.Address, page *models.PageInfo, err *ce.Error)
+	GetAddressByID(ctx context.Context, authID, addressID int64) (address *models.Address, err *ce.Error)
 	UpdateAddress(ctx context.Context, data *models.UpdateAddress) (address *models.Address, err *ce.Error)
 	DeleteAddress(ctx context.Context, data *models.DeleteAddress) (err *ce.Error)
+	RestoreAddress(ctx context.Context, data *models.RestoreAddress) (address *models.Address, err *ce.Error)
+	DeleteAllAddresses(ctx context.Context, authID int64) (err *ce.Error)
+	CountAddresses(ctx context.Context, authID int64) (count int, err *ce.Error)
+	FindDuplicateAddress(ctx context.Context, data *models.FindDuplicateAddress) (address *models.Address, err *ce.Error)
 	HasPrimary(ctx context.Context, authID int64) (exists bool, err *ce.Error)
 	SetPrimary(ctx context.Context, data *models.SetPrimaryAddress) (address *models.Address, err *ce.Error)
 	UnsetPrimary(ctx context.Context, authID int64) (address *models.Address, err *ce.Error)
 	SetLastUpdatedPrimary(ctx context.Context, authID int64) (address *models.Address, err *ce.Error)
+	GetAddressesWithinRadius(ctx context.Context, data *models.GetAddressesWithinRadius) (addresses []models.AddressDistance, err *ce.Error)
+	GetNearestAddress(ctx context.Context, data *models.GetNearestAddress) (address *models.AddressDistance, err *ce.Error)
+	GetAddressDistance(ctx context.Context, data *models.GetAddressDistance) (distanceKm float64, err *ce.Error)
 }
 
 type addressRepository struct {
@@ -85,7 +96,7 @@ func (r *addressRepository) GetAllAddresses(ctx context.Context, authID int64) (
 			subdivision_1, subdivision_2, subdivision_3, subdivision_4,
 			street, postcode, latitude, longitude, created_at, updated_at
 		FROM addresses
-		WHERE auth_id = $1
+		WHERE auth_id = $1 AND deleted_at IS NULL
 		ORDER BY is_primary DESC, updated_at DESC
 	`
 
@@ -126,6 +137,162 @@ func (r *addressRepository) GetAllAddresses(ctx context.Context, authID int64) (
 	return addresses, nil
 }
 
+func (r *addressRepository) ListAddresses(ctx context.Context, data *models.ListAddresses) ([]models.Address, *models.PageInfo, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "ListAddresses")
+	defer span.End()
+
+	keys := addressSortKeys(data.Page.Sort)
+	conditions := []string{"auth_id = $1", "deleted_at IS NULL"}
+	args := []interface{}{data.AuthID}
+	argPos := 2
+
+	if v, ok := data.Page.Filters["label"]; ok {
+		conditions = append(conditions, fmt.Sprintf("LOWER(label) = LOWER($%d)", argPos))
+		args = append(args, v)
+		argPos++
+	}
+	if v, ok := data.Page.Filters["country"]; ok {
+		conditions = append(conditions, fmt.Sprintf("country = UPPER($%d)", argPos))
+		args = append(args, v)
+		argPos++
+	}
+	if v, ok := data.Page.Filters["is_primary"]; ok {
+		conditions = append(conditions, fmt.Sprintf("is_primary = $%d::boolean", argPos))
+		args = append(args, v)
+		argPos++
+	}
+
+	page := models.PageInfo{PageSize: data.Page.PageSize, Page: data.Page.Page}
+
+	// Offset mode counts the matching rows, cursor mode skips the count and resumes after the last row seen
+	var limitClause string
+	if data.Page.Page > 0 {
+		query := "SELECT COUNT(*) FROM addresses WHERE " + strings.Join(conditions, " AND ")
+
+		var total int
+		if err := r.database.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+			e := fmt.Errorf("failed to list addresses: %w", err)
+			return nil, nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		page.Total = &total
+		limitClause = fmt.Sprintf("LIMIT %d OFFSET %d", data.Page.PageSize+1, (data.Page.Page-1)*data.Page.PageSize)
+	} else {
+		if data.Page.Cursor != "" {
+			values, err := decodeCursor(keys, data.Page.Cursor)
+			if err != nil {
+				e := fmt.Errorf("failed to list addresses: %w", err)
+				return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, "Cursor is invalid", e)
+			}
+
+			clause, cargs := keysetClause(keys, values, argPos)
+			conditions = append(conditions, clause)
+			args = append(args, cargs...)
+		}
+
+		limitClause = fmt.Sprintf("LIMIT %d", data.Page.PageSize+1)
+	}
+
+	query := fmt.Sprintf(
+		`
+			SELECT
+				address_id, recipient, phone, label, notes, is_primary, country,
+				subdivision_1, subdivision_2, subdivision_3, subdivision_4,
+				street, postcode, latitude, longitude, created_at, updated_at
+			FROM addresses
+			WHERE %s
+			ORDER BY %s
+			%s
+		`,
+		strings.Join(conditions, " AND "), orderClause(keys), limitClause,
+	)
+
+	rows, err := r.database.QueryAll(ctx, query, args...)
+	if err != nil {
+		e := fmt.Errorf("failed to list addresses: %w", err)
+		return nil, nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	defer rows.Close()
+
+	addresses := make([]models.Address, 0, data.Page.PageSize)
+	for rows.Next() {
+		var address models.Address
+
+		err := rows.Scan(
+			&address.ID, &address.Recipient, &address.Phone, &address.Label, &address.Notes,
+			&address.IsPrimary, &address.Country, &address.Subdivision1, &address.Subdivision2,
+			&address.Subdivision3, &address.Subdivision4, &address.Street, &address.Postcode,
+			&address.Latitude, &address.Longitude, &address.CreatedAt, &address.UpdatedAt,
+		)
+		if err != nil {
+			e := fmt.Errorf("failed to list addresses: %w", err)
+			return nil, nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to list addresses: %w", err)
+		return nil, nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	// One extra row is fetched to tell whether another page follows
+	if len(addresses) > data.Page.PageSize {
+		addresses = addresses[:data.Page.PageSize]
+		page.HasMore = true
+	}
+	if page.HasMore && data.Page.Page == 0 {
+		last := addresses[len(addresses)-1]
+
+		values := make([]string, 0, len(keys))
+		for _, k := range keys {
+			values = append(values, addressSortValue(&last, k.column))
+		}
+
+		page.NextCursor = encodeCursor(keys, values)
+	}
+
+	return addresses, &page, nil
+}
+
+func (r *addressRepository) GetAddressByID(ctx context.Context, authID, addressID int64) (*models.Address, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressByID")
+	defer span.End()
+
+	query := `
+		SELECT
+			address_id, recipient, phone, label, notes, is_primary, country,
+			subdivision_1, subdivision_2, subdivision_3, subdivision_4,
+			street, postcode, latitude, longitude, created_at, updated_at
+		FROM addresses
+		WHERE address_id = $1 AND auth_id = $2 AND deleted_at IS NULL
+	`
+	if r.database.InTx(ctx) {
+		query += " FOR UPDATE"
+	}
+
+	row := r.database.QueryRow(ctx, query, addressID, authID)
+
+	var address models.Address
+	err := row.Scan(
+		&address.ID, &address.Recipient, &address.Phone, &address.Label, &address.Notes,
+		&address.IsPrimary, &address.Country, &address.Subdivision1, &address.Subdivision2,
+		&address.Subdivision3, &address.Subdivision4, &address.Street, &address.Postcode,
+		&address.Latitude, &address.Longitude, &address.CreatedAt, &address.UpdatedAt,
+	)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch address by id: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeAddressNotFound, ce.MsgAddressNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &address, nil
+}
+
 func (r *addressRepository) UpdateAddress(ctx context.Context, data *models.UpdateAddress) (*models.Address, *ce.Error) {
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "UpdateAddress")
 	defer span.End()
@@ -210,7 +377,7 @@ func (r *addressRepository) UpdateAddress(ctx context.Context, data *models.Upda
 		`
 			UPDATE addresses
 			SET %s
-			WHERE address_id = $%d AND auth_id = $%d
+			WHERE address_id = $%d AND auth_id = $%d AND deleted_at IS NULL
 			RETURNING
 				address_id, recipient, phone, label, notes, is_primary, country,
 				subdivision_1, subdivision_2, subdivision_3, subdivision_4,
@@ -244,7 +411,12 @@ func (r *addressRepository) DeleteAddress(ctx context.Context, data *models.Dele
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "DeleteAddress")
 	defer span.End()
 
-	query := "DELETE FROM addresses WHERE address_id = $1 AND auth_id = $2"
+	// Rows are kept so that references held by past orders stay resolvable
+	query := `
+		UPDATE addresses
+		SET is_primary = FALSE, updated_at = NOW(), deleted_at = NOW()
+		WHERE address_id = $1 AND auth_id = $2 AND deleted_at IS NULL
+	`
 
 	if err := r.database.Execute(ctx, query, data.AddressID, data.AuthID); err != nil {
 		e := fmt.Errorf("failed to delete address: %w", err)
@@ -258,11 +430,136 @@ func (r *addressRepository) DeleteAddress(ctx context.Context, data *models.Dele
 	return nil
 }
 
+func (r *addressRepository) RestoreAddress(ctx context.Context, data *models.RestoreAddress) (*models.Address, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "RestoreAddress")
+	defer span.End()
+
+	query := `
+		UPDATE addresses
+		SET deleted_at = NULL, updated_at = NOW()
+		WHERE address_id = $1 AND auth_id = $2 AND deleted_at >= $3
+		RETURNING
+			address_id, recipient, phone, label, notes, is_primary, country,
+			subdivision_1, subdivision_2, subdivision_3, subdivision_4,
+			street, postcode, latitude, longitude, created_at, updated_at
+	`
+
+	row := r.database.QueryRow(ctx, query, data.AddressID, data.AuthID, data.DeletedAfter)
+
+	var address models.Address
+	err := row.Scan(
+		&address.ID, &address.Recipient, &address.Phone, &address.Label, &address.Notes,
+		&address.IsPrimary, &address.Country, &address.Subdivision1, &address.Subdivision2,
+		&address.Subdivision3, &address.Subdivision4, &address.Street, &address.Postcode,
+		&address.Latitude, &address.Longitude, &address.CreatedAt, &address.UpdatedAt,
+	)
+	if err != nil {
+		e := fmt.Errorf("failed to restore address: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeAddressNotFound, ce.MsgAddressNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &address, nil
+}
+
+func (r *addressRepository) DeleteAllAddresses(ctx context.Context, authID int64) *ce.Error {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "DeleteAllAddresses")
+	defer span.End()
+
+	// Account erasure removes soft deleted rows as well
+	query := "DELETE FROM addresses WHERE auth_id = $1"
+
+	if err := r.database.Execute(ctx, query, authID); err != nil {
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return nil
+		}
+
+		e := fmt.Errorf("failed to delete all addresses: %w", err)
+		return ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+func (r *addressRepository) CountAddresses(ctx context.Context, authID int64) (int, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "CountAddresses")
+	defer span.End()
+
+	// Row locks cannot stop concurrent inserts, so transactions counting the same account are serialized
+	// on an advisory lock keyed by its auth_id, held until they end
+	if r.database.InTx(ctx) {
+		var locked int
+		if err := r.database.QueryRow(ctx, "SELECT 1 FROM pg_advisory_xact_lock($1)", authID).Scan(&locked); err != nil {
+			e := fmt.Errorf("failed to count addresses: %w", err)
+			return 0, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+	}
+
+	query := "SELECT COUNT(*) FROM addresses WHERE auth_id = $1 AND deleted_at IS NULL"
+	row := r.database.QueryRow(ctx, query, authID)
+
+	var count int
+	if err := row.Scan(&count); err != nil {
+		e := fmt.Errorf("failed to count addresses: %w", err)
+		return 0, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return count, nil
+}
+
+// FindDuplicateAddress looks for another active address with the same street and postcode
+// once punctuation and casing are ignored, located within the given radius
+func (r *addressRepository) FindDuplicateAddress(ctx context.Context, data *models.FindDuplicateAddress) (*models.Address, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "FindDuplicateAddress")
+	defer span.End()
+
+	query := `
+		SELECT
+			address_id, recipient, phone, label, notes, is_primary, country,
+			subdivision_1, subdivision_2, subdivision_3, subdivision_4,
+			street, postcode, latitude, longitude, created_at, updated_at
+		FROM addresses
+		WHERE
+			auth_id = $1 AND address_id <> $2 AND deleted_at IS NULL
+			AND postcode = $3
+			AND REGEXP_REPLACE(LOWER(street), '[^[:alnum:]]', '', 'g') = REGEXP_REPLACE(LOWER($4), '[^[:alnum:]]', '', 'g')
+			AND ST_DWithin(location, ST_SetSRID(ST_MakePoint($6, $5), 4326)::geography, $7)
+		LIMIT 1
+	`
+
+	row := r.database.QueryRow(
+		ctx, query,
+		data.AuthID, data.AddressID, data.Postcode, data.Street,
+		data.Latitude, data.Longitude, data.RadiusM,
+	)
+
+	var address models.Address
+	err := row.Scan(
+		&address.ID, &address.Recipient, &address.Phone, &address.Label, &address.Notes,
+		&address.IsPrimary, &address.Country, &address.Subdivision1, &address.Subdivision2,
+		&address.Subdivision3, &address.Subdivision4, &address.Street, &address.Postcode,
+		&address.Latitude, &address.Longitude, &address.CreatedAt, &address.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to find duplicate address: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &address, nil
+}
+
 func (r *addressRepository) HasPrimary(ctx context.Context, authID int64) (bool, *ce.Error) {
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "HasPrimary")
 	defer span.End()
 
-	query := "SELECT 1 FROM addresses WHERE auth_id = $1 AND is_primary = TRUE"
+	query := "SELECT 1 FROM addresses WHERE auth_id = $1 AND is_primary = TRUE AND deleted_at IS NULL"
 	if r.database.InTx(ctx) {
 		query += " FOR UPDATE"
 	}
@@ -289,7 +586,7 @@ func (r *addressRepository) SetPrimary(ctx context.Context, data *models.SetPrim
 	query := `
 		UPDATE addresses
 		SET is_primary = TRUE, updated_at = NOW()
-		WHERE address_id = $1 AND auth_id = $2
+		WHERE address_id = $1 AND auth_id = $2 AND deleted_at IS NULL
 		RETURNING
 			address_id, recipient, phone, label, notes, is_primary, country,
 			subdivision_1, subdivision_2, subdivision_3, subdivision_4,
@@ -356,7 +653,7 @@ func (r *addressRepository) SetLastUpdatedPrimary(ctx context.Context, authID in
 		UPDATE addresses
 		SET is_primary = TRUE, updated_at = NOW()
 		WHERE address_id = (
-			SELECT address_id FROM addresses WHERE auth_id = $1
+			SELECT address_id FROM addresses WHERE auth_id = $1 AND deleted_at IS NULL
 			ORDER BY updated_at DESC LIMIT 1
 		)
 		RETURNING
@@ -385,3 +682,165 @@ func (r *addressRepository) SetLastUpdatedPrimary(ctx context.Context, authID in
 
 	return &address, nil
 }
+
+func (r *addressRepository) GetAddressesWithinRadius(ctx context.Context, data *models.GetAddressesWithinRadius) ([]models.AddressDistance, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressesWithinRadius")
+	defer span.End()
+
+	query := `
+		WITH point AS (
+			SELECT ST_SetSRID(ST_MakePoint($3, $2), 4326)::geography AS location
+		)
+		SELECT
+			a.address_id, a.recipient, a.phone, a.label, a.notes, a.is_primary, a.country,
+			a.subdivision_1, a.subdivision_2, a.subdivision_3, a.subdivision_4,
+			a.street, a.postcode, a.latitude, a.longitude, a.created_at, a.updated_at,
+			ST_Distance(a.location, p.location) / 1000 AS distance_km
+		FROM addresses a, point p
+		WHERE a.auth_id = $1 AND a.deleted_at IS NULL AND ST_DWithin(a.location, p.location, $4 * 1000)
+		ORDER BY distance_km ASC
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, data.AuthID, data.Latitude, data.Longitude, data.RadiusKm)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch addresses within radius: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	defer rows.Close()
+
+	addresses := make([]models.AddressDistance, 0)
+	for rows.Next() {
+		var ad models.AddressDistance
+
+		err := rows.Scan(
+			&ad.Address.ID, &ad.Address.Recipient, &ad.Address.Phone, &ad.Address.Label, &ad.Address.Notes,
+			&ad.Address.IsPrimary, &ad.Address.Country, &ad.Address.Subdivision1, &ad.Address.Subdivision2,
+			&ad.Address.Subdivision3, &ad.Address.Subdivision4, &ad.Address.Street, &ad.Address.Postcode,
+			&ad.Address.Latitude, &ad.Address.Longitude, &ad.Address.CreatedAt, &ad.Address.UpdatedAt,
+			&ad.DistanceKm,
+		)
+		if err != nil {
+			e := fmt.Errorf("failed to fetch addresses within radius: %w", err)
+			return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		addresses = append(addresses, ad)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to fetch addresses within radius: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return addresses, nil
+}
+
+func (r *addressRepository) GetNearestAddress(ctx context.Context, data *models.GetNearestAddress) (*models.AddressDistance, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetNearestAddress")
+	defer span.End()
+
+	query := `
+		WITH point AS (
+			SELECT ST_SetSRID(ST_MakePoint($3, $2), 4326)::geography AS location
+		)
+		SELECT
+			a.address_id, a.recipient, a.phone, a.label, a.notes, a.is_primary, a.country,
+			a.subdivision_1, a.subdivision_2, a.subdivision_3, a.subdivision_4,
+			a.street, a.postcode, a.latitude, a.longitude, a.created_at, a.updated_at,
+			ST_Distance(a.location, p.location) / 1000 AS distance_km
+		FROM addresses a, point p
+		WHERE a.auth_id = $1 AND a.deleted_at IS NULL AND a.location IS NOT NULL
+		ORDER BY a.location <-> p.location
+		LIMIT 1
+	`
+
+	row := r.database.QueryRow(ctx, query, data.AuthID, data.Latitude, data.Longitude)
+
+	var ad models.AddressDistance
+	err := row.Scan(
+		&ad.Address.ID, &ad.Address.Recipient, &ad.Address.Phone, &ad.Address.Label, &ad.Address.Notes,
+		&ad.Address.IsPrimary, &ad.Address.Country, &ad.Address.Subdivision1, &ad.Address.Subdivision2,
+		&ad.Address.Subdivision3, &ad.Address.Subdivision4, &ad.Address.Street, &ad.Address.Postcode,
+		&ad.Address.Latitude, &ad.Address.Longitude, &ad.Address.CreatedAt, &ad.Address.UpdatedAt,
+		&ad.DistanceKm,
+	)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch nearest address: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeAddressNotFound, ce.MsgAddressNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &ad, nil
+}
+
+func (r *addressRepository) GetAddressDistance(ctx context.Context, data *models.GetAddressDistance) (float64, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressDistance")
+	defer span.End()
+
+	query := `
+		SELECT ST_Distance(f.location, t.location) / 1000
+		FROM addresses f
+		JOIN addresses t ON t.address_id = $3 AND t.auth_id = $1 AND t.deleted_at IS NULL
+		WHERE f.address_id = $2 AND f.auth_id = $1 AND f.deleted_at IS NULL
+	`
+
+	row := r.database.QueryRow(ctx, query, data.AuthID, data.FromAddressID, data.ToAddressID)
+
+	// The distance is NULL when either address was saved without coordinates
+	var distanceKm *float64
+	if err := row.Scan(&distanceKm); err != nil {
+		e := fmt.Errorf("failed to fetch address distance: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return 0, ce.NewError(span, ce.CodeAddressNotFound, ce.MsgAddressNotFound, e)
+		}
+
+		return 0, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	if distanceKm == nil {
+		e := fmt.Errorf("failed to fetch address distance: address %d or %d has no location", data.FromAddressID, data.ToAddressID)
+		return 0, ce.NewError(span, ce.CodeLocationNotFound, ce.MsgLocationNotFound, e)
+	}
+
+	return *distanceKm, nil
+}
+
+// addressSortKeys defaults to primary first then most recently updated, address_id keeps the order stable
+func addressSortKeys(sort []models.SortField) []sortKey {
+	if len(sort) == 0 {
+		return []sortKey{
+			{column: "is_primary", cast: "boolean", desc: true},
+			{column: "updated_at", cast: "timestamptz", desc: true},
+			{column: "address_id", cast: "bigint", desc: true},
+		}
+	}
+
+	cast := "text"
+	if sort[0].Field == "created_at" || sort[0].Field == "updated_at" {
+		cast = "timestamptz"
+	}
+
+	return []sortKey{
+		{column: sort[0].Field, cast: cast, desc: sort[0].Desc},
+		{column: "address_id", cast: "bigint", desc: sort[0].Desc},
+	}
+}
+
+func addressSortValue(a *models.Address, column string) string {
+	switch column {
+	case "is_primary":
+		return strconv.FormatBool(a.IsPrimary)
+	case "created_at":
+		return a.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		return a.UpdatedAt.Format(time.RFC3339Nano)
+	case "label":
+		return a.Label
+	case "recipient":
+		return a.Recipient
+	default:
+		return strconv.FormatInt(a.ID, 10)
+	}
+}
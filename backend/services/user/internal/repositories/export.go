@@ -0,0 +1,274 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const exportErrTracer string = "repository.export"
+
+type ExportRepository interface {
+	CreateExport(ctx context.Context, authID int64, exportID string) (export *models.DataExport, err *ce.Error)
+	GetActiveExport(ctx context.Context, authID int64) (export *models.DataExport, err *ce.Error)
+	GetExportByID(ctx context.Context, authID int64, exportID string) (export *models.DataExport, err *ce.Error)
+	ClaimExports(ctx context.Context, limit int, staleBefore time.Time) (exports []models.DataExport, err *ce.Error)
+	CompleteExport(ctx context.Context, data *models.CompleteDataExport) (err *ce.Error)
+	FailExport(ctx context.Context, data *models.FailDataExport) (status string, err *ce.Error)
+	ExpireExports(ctx context.Context, limit int) (exports []models.ExpiredDataExport, err *ce.Error)
+}
+
+type exportRepository struct {
+	database *database.Database
+}
+
+func NewExportRepository(db *database.Database) ExportRepository {
+	return &exportRepository{database: db}
+}
+
+// CreateExport returns nil when the user already has an export in progress
+func (r *exportRepository) CreateExport(ctx context.Context, authID int64, exportID string) (*models.DataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "CreateExport")
+	defer span.End()
+
+	query := `
+		INSERT INTO data_exports (export_id, auth_id)
+		VALUES ($1, $2)
+		ON CONFLICT (auth_id) WHERE status IN ('pending', 'processing') DO NOTHING
+		RETURNING
+			export_id, auth_id, status, object_key, attempts,
+			created_at, updated_at, completed_at, expires_at
+	`
+
+	row := r.database.QueryRow(ctx, query, exportID, authID)
+
+	export, err := scanExport(row)
+	if err != nil {
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, nil
+		}
+
+		e := fmt.Errorf("failed to create export: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return export, nil
+}
+
+func (r *exportRepository) GetActiveExport(ctx context.Context, authID int64) (*models.DataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "GetActiveExport")
+	defer span.End()
+
+	query := `
+		SELECT
+			export_id, auth_id, status, object_key, attempts,
+			created_at, updated_at, completed_at, expires_at
+		FROM data_exports
+		WHERE auth_id = $1 AND status IN ('pending', 'processing')
+	`
+
+	row := r.database.QueryRow(ctx, query, authID)
+
+	export, err := scanExport(row)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch active export: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeExportNotFound, ce.MsgExportNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return export, nil
+}
+
+func (r *exportRepository) GetExportByID(ctx context.Context, authID int64, exportID string) (*models.DataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "GetExportByID")
+	defer span.End()
+
+	query := `
+		SELECT
+			export_id, auth_id, status, object_key, attempts,
+			created_at, updated_at, completed_at, expires_at
+		FROM data_exports
+		WHERE export_id = $1 AND auth_id = $2
+	`
+
+	row := r.database.QueryRow(ctx, query, exportID, authID)
+
+	export, err := scanExport(row)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch export by id: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeExportNotFound, ce.MsgExportNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return export, nil
+}
+
+// ClaimExports marks pending exports, and those stuck processing since before staleBefore, as processing
+func (r *exportRepository) ClaimExports(ctx context.Context, limit int, staleBefore time.Time) ([]models.DataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "ClaimExports")
+	defer span.End()
+
+	query := `
+		UPDATE data_exports
+		SET status = 'processing', attempts = attempts + 1, updated_at = NOW()
+		WHERE export_id IN (
+			SELECT export_id
+			FROM data_exports
+			WHERE status = 'pending' OR (status = 'processing' AND updated_at < $2)
+			ORDER BY updated_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			export_id, auth_id, status, object_key, attempts,
+			created_at, updated_at, completed_at, expires_at
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, limit, staleBefore)
+	if err != nil {
+		e := fmt.Errorf("failed to claim exports: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	defer rows.Close()
+
+	exports := make([]models.DataExport, 0)
+	for rows.Next() {
+		export, err := scanExport(rows)
+		if err != nil {
+			e := fmt.Errorf("failed to claim exports: %w", err)
+			return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		exports = append(exports, *export)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to claim exports: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return exports, nil
+}
+
+func (r *exportRepository) CompleteExport(ctx context.Context, data *models.CompleteDataExport) *ce.Error {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "CompleteExport")
+	defer span.End()
+
+	query := `
+		UPDATE data_exports
+		SET
+			status = 'completed', object_key = $1, last_error = NULL,
+			completed_at = NOW(), expires_at = $2, updated_at = NOW()
+		WHERE export_id = $3 AND status = 'processing'
+	`
+
+	if err := r.database.Execute(ctx, query, data.ObjectKey, data.ExpiresAt, data.ExportID); err != nil {
+		e := fmt.Errorf("failed to complete export: %w", err)
+		if errors.Is(err, ce.ErrDBAffectNoRows) {
+			return ce.NewError(span, ce.CodeExportNotFound, ce.MsgExportNotFound, e)
+		}
+
+		return ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return nil
+}
+
+// FailExport puts the export back in the queue until it has used up its attempts
+func (r *exportRepository) FailExport(ctx context.Context, data *models.FailDataExport) (string, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "FailExport")
+	defer span.End()
+
+	query := `
+		UPDATE data_exports
+		SET
+			status = CASE WHEN attempts >= $1 THEN 'failed' ELSE 'pending' END,
+			last_error = $2, updated_at = NOW()
+		WHERE export_id = $3 AND status = 'processing'
+		RETURNING status
+	`
+
+	row := r.database.QueryRow(ctx, query, data.MaxAttempts, data.Error, data.ExportID)
+
+	var status string
+	if err := row.Scan(&status); err != nil {
+		e := fmt.Errorf("failed to fail export: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return "", ce.NewError(span, ce.CodeExportNotFound, ce.MsgExportNotFound, e)
+		}
+
+		return "", ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return status, nil
+}
+
+func (r *exportRepository) ExpireExports(ctx context.Context, limit int) ([]models.ExpiredDataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "ExpireExports")
+	defer span.End()
+
+	query := `
+		UPDATE data_exports
+		SET status = 'expired', updated_at = NOW()
+		WHERE export_id IN (
+			SELECT export_id
+			FROM data_exports
+			WHERE status = 'completed' AND expires_at <= NOW()
+			ORDER BY expires_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING export_id, object_key
+	`
+
+	rows, err := r.database.QueryAll(ctx, query, limit)
+	if err != nil {
+		e := fmt.Errorf("failed to expire exports: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+	defer rows.Close()
+
+	exports := make([]models.ExpiredDataExport, 0)
+	for rows.Next() {
+		var export models.ExpiredDataExport
+		if err := rows.Scan(&export.ID, &export.ObjectKey); err != nil {
+			e := fmt.Errorf("failed to expire exports: %w", err)
+			return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		}
+
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		e := fmt.Errorf("failed to expire exports: %w", err)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return exports, nil
+}
+
+func scanExport(row pgx.Row) (*models.DataExport, error) {
+	var export models.DataExport
+	err := row.Scan(
+		&export.ID, &export.AuthID, &export.Status, &export.ObjectKey, &export.Attempts,
+		&export.CreatedAt, &export.UpdatedAt, &export.CompletedAt, &export.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+)
+
+type sortKey struct {
+	column string
+	cast   string
+	desc   bool
+}
+
+type cursor struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+}
+
+func orderClause(keys []sortKey) string {
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		dir := "ASC"
+		if k.desc {
+			dir = "DESC"
+		}
+		parts = append(parts, k.column+" "+dir)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// keysetClause matches rows strictly after the cursor position, expanded so that keys may mix directions
+func keysetClause(keys []sortKey, values []string, argPos int) (string, []interface{}) {
+	args := make([]interface{}, 0, len(keys))
+	for _, v := range values {
+		args = append(args, v)
+	}
+
+	ors := make([]string, 0, len(keys))
+	for i, k := range keys {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, fmt.Sprintf("%s = $%d::%s", keys[j].column, argPos+j, keys[j].cast))
+		}
+
+		op := ">"
+		if k.desc {
+			op = "<"
+		}
+		ands = append(ands, fmt.Sprintf("%s %s $%d::%s", k.column, op, argPos+i, k.cast))
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+
+	return "(" + strings.Join(ors, " OR ") + ")", args
+}
+
+func sortSignature(keys []sortKey) string {
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s:%t", k.column, k.desc))
+	}
+	return strings.Join(parts, ",")
+}
+
+func encodeCursor(keys []sortKey, values []string) string {
+	b, _ := json.Marshal(cursor{Sort: sortSignature(keys), Values: values})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(keys []sortKey, value string) ([]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ce.ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ce.ErrInvalidCursor
+	}
+	if c.Sort != sortSignature(keys) || len(c.Values) != len(keys) {
+		return nil, ce.ErrInvalidCursor
+	}
+
+	return c.Values, nil
+}
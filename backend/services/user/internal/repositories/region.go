@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/dataset"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const regionErrTracer string = "repository.region"
+
+type RegionRepository interface {
+	GetRegions(ctx context.Context, parentID string) (regions []models.Region, err *ce.Error)
+	GetRegionByID(ctx context.Context, regionID string) (region *models.Region, err *ce.Error)
+	GetRegionByName(ctx context.Context, parentID, name string) (region *models.Region, err *ce.Error)
+	HasChildren(ctx context.Context, regionID string) (exists bool)
+}
+
+type regionRepository struct {
+	regions  map[string]*models.Region
+	children map[string][]*models.Region
+	names    map[string]*models.Region
+}
+
+// Regions are served from memory, the dataset is read-only and small enough to index upfront
+func NewRegionRepository(rs []dataset.Region) RegionRepository {
+	r := regionRepository{
+		regions:  make(map[string]*models.Region, len(rs)),
+		children: make(map[string][]*models.Region),
+		names:    make(map[string]*models.Region, len(rs)),
+	}
+
+	for _, row := range rs {
+		region := models.Region{
+			ID:       row.ID,
+			ParentID: row.ParentID,
+			Level:    strings.Count(row.ID, ".") + 1,
+			Name:     row.Name,
+		}
+
+		r.regions[region.ID] = &region
+		r.children[region.ParentID] = append(r.children[region.ParentID], &region)
+		for _, name := range append([]string{row.Name}, row.Aliases...) {
+			r.names[nameKey(region.ParentID, name)] = &region
+		}
+	}
+
+	for _, c := range r.children {
+		sort.Slice(c, func(i, j int) bool { return c[i].Name < c[j].Name })
+	}
+
+	return &r
+}
+
+func (r *regionRepository) GetRegions(ctx context.Context, parentID string) ([]models.Region, *ce.Error) {
+	_, span := otel.Tracer(regionErrTracer).Start(ctx, "GetRegions")
+	defer span.End()
+
+	if _, ok := r.regions[parentID]; parentID != "" && !ok {
+		err := fmt.Errorf("failed to fetch regions: parent %s not found", parentID)
+		return nil, ce.NewError(span, ce.CodeRegionNotFound, ce.MsgRegionNotFound, err)
+	}
+
+	regions := make([]models.Region, 0, len(r.children[parentID]))
+	for _, region := range r.children[parentID] {
+		regions = append(regions, *region)
+	}
+
+	return regions, nil
+}
+
+func (r *regionRepository) GetRegionByID(ctx context.Context, regionID string) (*models.Region, *ce.Error) {
+	_, span := otel.Tracer(regionErrTracer).Start(ctx, "GetRegionByID")
+	defer span.End()
+
+	region, ok := r.regions[regionID]
+	if !ok {
+		err := fmt.Errorf("failed to fetch region by id: region %s not found", regionID)
+		return nil, ce.NewError(span, ce.CodeRegionNotFound, ce.MsgRegionNotFound, err)
+	}
+
+	res := *region
+	return &res, nil
+}
+
+func (r *regionRepository) GetRegionByName(ctx context.Context, parentID, name string) (*models.Region, *ce.Error) {
+	_, span := otel.Tracer(regionErrTracer).Start(ctx, "GetRegionByName")
+	defer span.End()
+
+	region, ok := r.names[nameKey(parentID, name)]
+	if !ok {
+		// Clients picking from dropdowns may send the region code instead of its name
+		region, ok = r.regions[strings.TrimSpace(name)]
+	}
+	if !ok || region.ParentID != parentID {
+		err := fmt.Errorf("failed to fetch region by name: region %q not found under %q", name, parentID)
+		return nil, ce.NewError(span, ce.CodeRegionNotFound, ce.MsgRegionNotFound, err)
+	}
+
+	res := *region
+	return &res, nil
+}
+
+func (r *regionRepository) HasChildren(ctx context.Context, regionID string) bool {
+	return len(r.children[regionID]) > 0
+}
+
+func nameKey(parentID, name string) string {
+	return parentID + "|" + strings.ToLower(strings.TrimSpace(name))
+}
@@ -19,8 +19,12 @@ type UserRepository interface {
 	UpsertUser(ctx context.Context, data *models.UpsertUser) (user *models.User, err *ce.Error)
 	GetUserByAuthID(ctx context.Context, authID int64) (user *models.User, err *ce.Error)
 	UpdateUser(ctx context.Context, data *models.UpdateUser) (user *models.User, err *ce.Error)
-	UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (profilePicture string, err *ce.Error)
+	UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (prevKey *string, err *ce.Error)
 	Exists(ctx context.Context, authID int64) (exists bool, err *ce.Error)
+	AnonymizeUser(ctx context.Context, authID int64) (prevKey *string, err *ce.Error)
+	GetPublicProfile(ctx context.Context, userID string) (profile *models.PublicProfile, err *ce.Error)
+	GetProfileVisibility(ctx context.Context, authID int64) (visibility *models.ProfileVisibility, err *ce.Error)
+	UpdateProfileVisibility(ctx context.Context, data *models.UpdateProfileVisibility) (visibility *models.ProfileVisibility, err *ce.Error)
 }
 
 type userRepository struct {
@@ -207,30 +211,36 @@ func (r *userRepository) UpdateUser(ctx context.Context, data *models.UpdateUser
 	return &user, nil
 }
 
-func (r *userRepository) UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (string, *ce.Error) {
+func (r *userRepository) UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (*string, *ce.Error) {
 	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateProfilePicture")
 	defer span.End()
 
 	query := `
-		UPDATE users
-		SET profile_picture = $1, updated_at = NOW()
-		WHERE auth_id = $2 AND deleted_at IS NULL
-		RETURNING profile_picture
+		UPDATE users u
+		SET profile_picture = $1, profile_picture_key = $2, updated_at = NOW()
+		FROM (
+			SELECT auth_id, profile_picture_key
+			FROM users
+			WHERE auth_id = $3 AND deleted_at IS NULL
+			FOR UPDATE
+		) prev
+		WHERE u.auth_id = prev.auth_id
+		RETURNING prev.profile_picture_key
 	`
 
-	row := r.database.QueryRow(ctx, query, data.ProfilePicture, data.AuthID)
+	row := r.database.QueryRow(ctx, query, data.ProfilePicture, data.ProfilePictureKey, data.AuthID)
 
-	var profilePicture string
-	if err := row.Scan(&profilePicture); err != nil {
+	var prevKey *string
+	if err := row.Scan(&prevKey); err != nil {
 		e := fmt.Errorf("failed to update profile picture: %w", err)
 		if errors.Is(err, ce.ErrDBReturnNoRows) {
-			return "", ce.NewError(span, ce.CodeUserNotFound, ce.MsgUserNotFound, e)
+			return nil, ce.NewError(span, ce.CodeUserNotFound, ce.MsgUserNotFound, e)
 		}
 
-		return "", ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
 	}
 
-	return profilePicture, nil
+	return prevKey, nil
 }
 
 func (r *userRepository) Exists(ctx context.Context, authID int64) (bool, *ce.Error) {
@@ -256,3 +266,154 @@ func (r *userRepository) Exists(ctx context.Context, authID int64) (bool, *ce.Er
 
 	return true, nil
 }
+
+func (r *userRepository) AnonymizeUser(ctx context.Context, authID int64) (*string, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "AnonymizeUser")
+	defer span.End()
+
+	// user_id is kept so that references held by other services stay resolvable
+	query := `
+		UPDATE users u
+		SET
+			name = 'Deleted User', bio = NULL, sex = NULL, birthdate = NULL, phone = NULL,
+			profile_picture = NULL, profile_picture_key = NULL,
+			updated_at = NOW(), deleted_at = NOW()
+		FROM (
+			SELECT auth_id, profile_picture_key
+			FROM users
+			WHERE auth_id = $1 AND deleted_at IS NULL
+			FOR UPDATE
+		) prev
+		WHERE u.auth_id = prev.auth_id
+		RETURNING prev.profile_picture_key
+	`
+
+	row := r.database.QueryRow(ctx, query, authID)
+
+	var prevKey *string
+	if err := row.Scan(&prevKey); err != nil {
+		e := fmt.Errorf("failed to anonymize user: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeUserNotFound, ce.MsgUserNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return prevKey, nil
+}
+
+func (r *userRepository) GetPublicProfile(ctx context.Context, userID string) (*models.PublicProfile, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetPublicProfile")
+	defer span.End()
+
+	// Hidden fields are dropped here so they never leave the database
+	query := `
+		SELECT
+			user_id, name,
+			CASE WHEN show_bio THEN bio END,
+			CASE WHEN show_profile_picture THEN profile_picture END,
+			CASE WHEN show_member_since THEN created_at END
+		FROM users
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+
+	row := r.database.QueryRow(ctx, query, userID)
+
+	var profile models.PublicProfile
+	err := row.Scan(
+		&profile.ID, &profile.Name, &profile.Bio,
+		&profile.ProfilePicture, &profile.MemberSince,
+	)
+	if err != nil {
+		e := fmt.Errorf("failed to fetch public profile: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeUserNotFound, ce.MsgUserNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &profile, nil
+}
+
+func (r *userRepository) GetProfileVisibility(ctx context.Context, authID int64) (*models.ProfileVisibility, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetProfileVisibility")
+	defer span.End()
+
+	query := `
+		SELECT show_bio, show_profile_picture, show_member_since
+		FROM users
+		WHERE auth_id = $1 AND deleted_at IS NULL
+	`
+
+	row := r.database.QueryRow(ctx, query, authID)
+
+	var visibility models.ProfileVisibility
+	if err := row.Scan(&visibility.Bio, &visibility.ProfilePicture, &visibility.MemberSince); err != nil {
+		e := fmt.Errorf("failed to fetch profile visibility: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeUserNotFound, ce.MsgUserNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &visibility, nil
+}
+
+func (r *userRepository) UpdateProfileVisibility(ctx context.Context, data *models.UpdateProfileVisibility) (*models.ProfileVisibility, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateProfileVisibility")
+	defer span.End()
+
+	setClauses := []string{}
+	args := []interface{}{}
+	argPos := 1
+
+	if data.Bio != nil {
+		setClauses = append(setClauses, fmt.Sprintf("show_bio = $%d", argPos))
+		args = append(args, *data.Bio)
+		argPos++
+	}
+	if data.ProfilePicture != nil {
+		setClauses = append(setClauses, fmt.Sprintf("show_profile_picture = $%d", argPos))
+		args = append(args, *data.ProfilePicture)
+		argPos++
+	}
+	if data.MemberSince != nil {
+		setClauses = append(setClauses, fmt.Sprintf("show_member_since = $%d", argPos))
+		args = append(args, *data.MemberSince)
+		argPos++
+	}
+	if len(setClauses) == 0 {
+		err := fmt.Errorf("failed to update profile visibility: %w", ce.ErrNoFieldsToUpdate)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, ce.MsgInvalidPayload, err)
+	}
+
+	setClauses = append(setClauses, "updated_at = NOW()")
+	args = append(args, data.AuthID)
+
+	query := fmt.Sprintf(
+		`
+			UPDATE users
+			SET %s
+			WHERE auth_id = $%d AND deleted_at IS NULL
+			RETURNING show_bio, show_profile_picture, show_member_since
+		`,
+		strings.Join(setClauses, ", "), argPos,
+	)
+
+	row := r.database.QueryRow(ctx, query, args...)
+
+	var visibility models.ProfileVisibility
+	if err := row.Scan(&visibility.Bio, &visibility.ProfilePicture, &visibility.MemberSince); err != nil {
+		e := fmt.Errorf("failed to update profile visibility: %w", err)
+		if errors.Is(err, ce.ErrDBReturnNoRows) {
+			return nil, ce.NewError(span, ce.CodeUserNotFound, ce.MsgUserNotFound, e)
+		}
+
+		return nil, ce.NewError(span, ce.CodeDBQueryExec, ce.MsgInternalServer, e)
+	}
+
+	return &visibility, nil
+}
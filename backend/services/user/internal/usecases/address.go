@@ -4,33 +4,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/constants"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/geocoder"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/repositories"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const addressErrTracer string = "usecase.address"
 
 type AddressUsecase interface {
 	CreateAddress(ctx context.Context, data *models.CreateAddress) (address *models.Address, oldPrimaryAddress *models.Address, err *ce.Error)
-	GetAllAddresses(ctx context.Context, authID int64) (addresses []models.Address, err *ce.Error)
+	GetAllAddresses(ctx context.Context, data *models.ListAddresses) (addresses []models.Address, page *models.PageInfo, err *ce.Error)
 	UpdateAddress(ctx context.Context, data *models.UpdateAddress) (address *models.Address, err *ce.Error)
 	SetPrimaryAddress(ctx context.Context, data *models.SetPrimaryAddress) (newPrimaryAddress *models.Address, oldPrimaryAddress *models.Address, err *ce.Error)
 	DeleteAddress(ctx context.Context, data *models.DeleteAddress) (newPrimaryAddress *models.Address, err *ce.Error)
+	RestoreAddress(ctx context.Context, authID, addressID int64) (address *models.Address, err *ce.Error)
+	GetAddressesWithinRadius(ctx context.Context, data *models.GetAddressesWithinRadius) (addresses []models.AddressDistance, err *ce.Error)
+	GetNearestAddress(ctx context.Context, data *models.GetNearestAddress) (address *models.AddressDistance, err *ce.Error)
+	GetAddressDistance(ctx context.Context, data *models.GetAddressDistance) (distanceKm float64, err *ce.Error)
+	GeocodeAddress(ctx context.Context, data *models.GeocodeAddress) (address *models.GeocodedAddress, err *ce.Error)
+	ReverseGeocode(ctx context.Context, latitude, longitude float64) (address *models.GeocodedAddress, err *ce.Error)
 }
 
 type addressUsecase struct {
+	cfg        *configs.Address
 	ar         repositories.AddressRepository
+	rr         repositories.RegionRepository
+	geocoder   geocoder.Geocoder
 	transactor *database.Transactor
 	validator  *utils.Validator
 }
 
-func NewAddressUsecase(ar repositories.AddressRepository, tx *database.Transactor, v *utils.Validator) AddressUsecase {
-	return &addressUsecase{ar: ar, transactor: tx, validator: v}
+func NewAddressUsecase(
+	cfg *configs.Address,
+	ar repositories.AddressRepository,
+	rr repositories.RegionRepository,
+	g geocoder.Geocoder,
+	tx *database.Transactor,
+	v *utils.Validator,
+) AddressUsecase {
+	return &addressUsecase{cfg: cfg, ar: ar, rr: rr, geocoder: g, transactor: tx, validator: v}
 }
 
 func (u *addressUsecase) CreateAddress(ctx context.Context, data *models.CreateAddress) (*models.Address, *models.Address, *ce.Error) {
@@ -38,61 +59,111 @@ func (u *addressUsecase) CreateAddress(ctx context.Context, data *models.CreateA
 	defer span.End()
 
 	// Validations
+	if ok, why := u.validator.AddrCountry(&data.Country, false); !ok {
+		err := fmt.Errorf("failed to create address: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("country", why)
+	}
+
+	country := utils.CountryCode(data.Country)
 	if ok, why := u.validator.Name(&data.Recipient, false); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("recipient", why)
 	}
-	if ok, why := u.validator.AddrPhone(&data.Phone, false); !ok {
+	if ok, why := u.validator.AddrPhone(&data.Phone, false, country); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("phone", why)
 	}
 	if ok, why := u.validator.AddrLabel(&data.Label, false); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("label", why)
 	}
 	if ok, why := u.validator.AddrNotes(data.Notes); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
-	}
-	if ok, why := u.validator.AddrCountry(&data.Country, false); !ok {
-		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("notes", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision1); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_1", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision2); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_2", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision3); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_3", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision4); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_4", why)
 	}
 	if ok, why := u.validator.AddrStreet(&data.Street, false); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("street", why)
 	}
-	if ok, why := u.validator.AddrPostcode(&data.Postcode, false); !ok {
+	if ok, why := u.validator.AddrPostcode(&data.Postcode, false, country); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("postcode", why)
 	}
 	if ok, why := u.validator.AddrLatitude(&data.Latitude, false); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("latitude", why)
 	}
 	if ok, why := u.validator.AddrLongitude(&data.Longitude, false); !ok {
 		err := fmt.Errorf("failed to create address: %w", errors.New(why))
-		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("longitude", why)
+	}
+
+	// Normalizations
+	data.Country = country
+	data.Phone = utils.ToE164(data.Phone, country)
+	data.Postcode = utils.NormalizePostcode(data.Postcode)
+
+	// Auto-completions
+	if data.Latitude == 0 && data.Longitude == 0 {
+		q := geocoder.Query{
+			CountryCode:  country,
+			Subdivisions: utils.DerefStrings([]*string{data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4}),
+			Street:       data.Street,
+			Postcode:     data.Postcode,
+		}
+		if lat, lon, ok := u.suggestCoordinates(ctx, span, &q); ok {
+			data.Latitude, data.Longitude = lat, lon
+		}
+	} else if data.Subdivision1 == nil && data.Subdivision2 == nil && data.Subdivision3 == nil && data.Subdivision4 == nil {
+		if s := u.suggestSubdivisions(ctx, span, country, data.Latitude, data.Longitude, data.Postcode); s != nil {
+			data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4 = s[0], s[1], s[2], s[3]
+		}
+	}
+
+	if country == constants.RegionCountryCode {
+		subdivisions := []*string{data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4}
+		resolved, why := u.resolveSubdivisions(ctx, subdivisions)
+		if why != "" {
+			err := fmt.Errorf("failed to create address: %w", errors.New(why))
+			return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		}
+
+		data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4 = resolved[0], resolved[1], resolved[2], resolved[3]
 	}
 
 	var address, oldPrimaryAddress *models.Address
 	err := u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		if err := u.checkLimit(ctx, span, data.AuthID); err != nil {
+			return err
+		}
+
+		dup := models.FindDuplicateAddress{
+			AuthID:    data.AuthID,
+			Street:    data.Street,
+			Postcode:  data.Postcode,
+			Latitude:  data.Latitude,
+			Longitude: data.Longitude,
+		}
+		if err := u.checkDuplicate(ctx, span, &dup); err != nil {
+			return err
+		}
+
 		exists, err := u.ar.HasPrimary(ctx, data.AuthID)
 		if err != nil {
 			return err
@@ -114,11 +185,26 @@ func (u *addressUsecase) CreateAddress(ctx context.Context, data *models.CreateA
 	return address, oldPrimaryAddress, err
 }
 
-func (u *addressUsecase) GetAllAddresses(ctx context.Context, authID int64) ([]models.Address, *ce.Error) {
+func (u *addressUsecase) GetAllAddresses(ctx context.Context, data *models.ListAddresses) ([]models.Address, *models.PageInfo, *ce.Error) {
 	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAllAddresses")
 	defer span.End()
 
-	return u.ar.GetAllAddresses(ctx, authID)
+	// Validations
+	if ok, why := u.validator.Page(&data.Page, constants.AddressSortFields, constants.AddressFilterFields); !ok {
+		err := fmt.Errorf("failed to get all addresses: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("page", why)
+	}
+	if ok, why := u.validator.AddrFilters(data.Page.Filters); !ok {
+		err := fmt.Errorf("failed to get all addresses: %w", errors.New(why))
+		return nil, nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("filters", why)
+	}
+
+	// Normalizations
+	if data.Page.PageSize == 0 {
+		data.Page.PageSize = constants.PageSizeDefault
+	}
+
+	return u.ar.ListAddresses(ctx, data)
 }
 
 func (u *addressUsecase) UpdateAddress(ctx context.Context, data *models.UpdateAddress) (*models.Address, *ce.Error) {
@@ -128,55 +214,172 @@ func (u *addressUsecase) UpdateAddress(ctx context.Context, data *models.UpdateA
 	// Validations
 	if ok, why := u.validator.Name(data.Recipient, true); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
-	}
-	if ok, why := u.validator.AddrPhone(data.Phone, true); !ok {
-		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("recipient", why)
 	}
 	if ok, why := u.validator.AddrLabel(data.Label, true); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("label", why)
 	}
 	if ok, why := u.validator.AddrNotes(data.Notes); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("notes", why)
 	}
 	if ok, why := u.validator.AddrCountry(data.Country, true); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("country", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision1); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_1", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision2); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_2", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision3); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_3", why)
 	}
 	if ok, why := u.validator.AddrSubdivision(data.Subdivision4); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("subdivision_4", why)
 	}
 	if ok, why := u.validator.AddrStreet(data.Street, true); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
-	}
-	if ok, why := u.validator.AddrPostcode(data.Postcode, true); !ok {
-		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("street", why)
 	}
 	if ok, why := u.validator.AddrLatitude(data.Latitude, true); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("latitude", why)
 	}
 	if ok, why := u.validator.AddrLongitude(data.Longitude, true); !ok {
 		err := fmt.Errorf("failed to update address: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("longitude", why)
+	}
+
+	// Auto-completions
+	forward := data.Latitude == nil && data.Longitude == nil && (data.Street != nil || data.Postcode != nil)
+	reverse := data.Latitude != nil && data.Longitude != nil && data.Country == nil &&
+		data.Subdivision1 == nil && data.Subdivision2 == nil && data.Subdivision3 == nil && data.Subdivision4 == nil
+
+	var address *models.Address
+	if forward || reverse {
+		a, err := u.ar.GetAddressByID(ctx, data.AuthID, data.AddressID)
+		if err != nil {
+			return nil, err
+		}
+
+		address = a
+		country := utils.CountryCode(*utils.CoalesceString(data.Country, &address.Country))
+		postcode := utils.NormalizePostcode(*utils.CoalesceString(data.Postcode, &address.Postcode))
+
+		if forward {
+			q := geocoder.Query{
+				CountryCode: country,
+				Subdivisions: utils.DerefStrings([]*string{
+					utils.CoalesceString(data.Subdivision1, address.Subdivision1),
+					utils.CoalesceString(data.Subdivision2, address.Subdivision2),
+					utils.CoalesceString(data.Subdivision3, address.Subdivision3),
+					utils.CoalesceString(data.Subdivision4, address.Subdivision4),
+				}),
+				Street:   *utils.CoalesceString(data.Street, &address.Street),
+				Postcode: postcode,
+			}
+			if lat, lon, ok := u.suggestCoordinates(ctx, span, &q); ok {
+				data.Latitude, data.Longitude = &lat, &lon
+			}
+		}
+
+		// Subdivisions can only be cleared by replacing all of them, so partial suggestions are skipped
+		if reverse {
+			s := u.suggestSubdivisions(ctx, span, country, *data.Latitude, *data.Longitude, postcode)
+			if s != nil && s[0] != nil && s[1] != nil && s[2] != nil && s[3] != nil {
+				data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4 = s[0], s[1], s[2], s[3]
+			}
+		}
+	}
+
+	// Country-dependent fields are validated against the effective state of the address
+	if data.Country != nil || data.Phone != nil || data.Postcode != nil ||
+		data.Subdivision1 != nil || data.Subdivision2 != nil || data.Subdivision3 != nil || data.Subdivision4 != nil {
+		if address == nil {
+			a, err := u.ar.GetAddressByID(ctx, data.AuthID, data.AddressID)
+			if err != nil {
+				return nil, err
+			}
+
+			address = a
+		}
+
+		country, postcode := utils.CountryCode(address.Country), data.Postcode
+		if data.Country != nil {
+			country = utils.CountryCode(*data.Country)
+			if postcode == nil {
+				postcode = &address.Postcode
+			}
+		}
+
+		if ok, why := u.validator.AddrPhone(data.Phone, true, country); !ok {
+			err := fmt.Errorf("failed to update address: %w", errors.New(why))
+			return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("phone", why)
+		}
+		if ok, why := u.validator.AddrPostcode(postcode, true, country); !ok {
+			err := fmt.Errorf("failed to update address: %w", errors.New(why))
+			return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("postcode", why)
+		}
+
+		// Normalizations
+		if data.Country != nil {
+			data.Country = &country
+		}
+		if data.Postcode != nil {
+			p := utils.NormalizePostcode(*data.Postcode)
+			data.Postcode = &p
+		}
+		data.Phone = utils.ToE164Ptr(data.Phone, country)
+
+		if country == constants.RegionCountryCode {
+			subdivisions := []*string{
+				utils.CoalesceString(data.Subdivision1, address.Subdivision1),
+				utils.CoalesceString(data.Subdivision2, address.Subdivision2),
+				utils.CoalesceString(data.Subdivision3, address.Subdivision3),
+				utils.CoalesceString(data.Subdivision4, address.Subdivision4),
+			}
+
+			resolved, why := u.resolveSubdivisions(ctx, subdivisions)
+			if why != "" {
+				err := fmt.Errorf("failed to update address: %w", errors.New(why))
+				return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+			}
+
+			data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4 = resolved[0], resolved[1], resolved[2], resolved[3]
+		}
+	}
+
+	if data.Street != nil || data.Postcode != nil || (data.Latitude != nil && data.Longitude != nil) {
+		if address == nil {
+			a, err := u.ar.GetAddressByID(ctx, data.AuthID, data.AddressID)
+			if err != nil {
+				return nil, err
+			}
+
+			address = a
+		}
+
+		dup := models.FindDuplicateAddress{
+			AuthID:    data.AuthID,
+			AddressID: data.AddressID,
+			Street:    *utils.CoalesceString(data.Street, &address.Street),
+			Postcode:  *utils.CoalesceString(data.Postcode, &address.Postcode),
+			Latitude:  address.Latitude,
+			Longitude: address.Longitude,
+		}
+		if data.Latitude != nil && data.Longitude != nil {
+			dup.Latitude, dup.Longitude = *data.Latitude, *data.Longitude
+		}
+		if err := u.checkDuplicate(ctx, span, &dup); err != nil {
+			return nil, err
+		}
 	}
 
 	return u.ar.UpdateAddress(ctx, data)
@@ -223,3 +426,323 @@ func (u *addressUsecase) DeleteAddress(ctx context.Context, data *models.DeleteA
 
 	return newPrimaryAddress, err
 }
+
+func (u *addressUsecase) RestoreAddress(ctx context.Context, authID, addressID int64) (*models.Address, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "RestoreAddress")
+	defer span.End()
+
+	var address *models.Address
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) (err *ce.Error) {
+		if err := u.checkLimit(ctx, span, authID); err != nil {
+			return err
+		}
+
+		data := models.RestoreAddress{
+			AuthID:       authID,
+			AddressID:    addressID,
+			DeletedAfter: time.Now().UTC().Add(-u.cfg.UndoWindow),
+		}
+
+		address, err = u.ar.RestoreAddress(ctx, &data)
+		if err != nil {
+			return err
+		}
+
+		// An equivalent address may have been added since this one was deleted
+		dup := models.FindDuplicateAddress{
+			AuthID:    authID,
+			AddressID: address.ID,
+			Street:    address.Street,
+			Postcode:  address.Postcode,
+			Latitude:  address.Latitude,
+			Longitude: address.Longitude,
+		}
+		if err := u.checkDuplicate(ctx, span, &dup); err != nil {
+			return err
+		}
+
+		exists, err := u.ar.HasPrimary(ctx, authID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			address, err = u.ar.SetPrimary(ctx, &models.SetPrimaryAddress{AuthID: authID, AddressID: address.ID})
+		}
+
+		return err
+	})
+
+	return address, err
+}
+
+func (u *addressUsecase) GetAddressesWithinRadius(ctx context.Context, data *models.GetAddressesWithinRadius) ([]models.AddressDistance, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressesWithinRadius")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.AddrLatitude(&data.Latitude, false); !ok {
+		err := fmt.Errorf("failed to get addresses within radius: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("latitude", why)
+	}
+	if ok, why := u.validator.AddrLongitude(&data.Longitude, false); !ok {
+		err := fmt.Errorf("failed to get addresses within radius: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("longitude", why)
+	}
+	if ok, why := u.validator.AddrRadius(&data.RadiusKm); !ok {
+		err := fmt.Errorf("failed to get addresses within radius: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("radius_km", why)
+	}
+
+	return u.ar.GetAddressesWithinRadius(ctx, data)
+}
+
+func (u *addressUsecase) GetNearestAddress(ctx context.Context, data *models.GetNearestAddress) (*models.AddressDistance, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetNearestAddress")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.AddrLatitude(&data.Latitude, false); !ok {
+		err := fmt.Errorf("failed to get nearest address: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("latitude", why)
+	}
+	if ok, why := u.validator.AddrLongitude(&data.Longitude, false); !ok {
+		err := fmt.Errorf("failed to get nearest address: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("longitude", why)
+	}
+
+	return u.ar.GetNearestAddress(ctx, data)
+}
+
+func (u *addressUsecase) GetAddressDistance(ctx context.Context, data *models.GetAddressDistance) (float64, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GetAddressDistance")
+	defer span.End()
+
+	return u.ar.GetAddressDistance(ctx, data)
+}
+
+func (u *addressUsecase) GeocodeAddress(ctx context.Context, data *models.GeocodeAddress) (*models.GeocodedAddress, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "GeocodeAddress")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.AddrCountry(&data.Country, false); !ok {
+		err := fmt.Errorf("failed to geocode address: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("country", why)
+	}
+	if data.Street == "" && data.Postcode == "" && data.Subdivision1 == nil {
+		why := "Street, postcode or subdivision must be provided"
+		err := fmt.Errorf("failed to geocode address: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+	}
+
+	q := geocoder.Query{
+		CountryCode:  utils.CountryCode(data.Country),
+		Subdivisions: utils.DerefStrings([]*string{data.Subdivision1, data.Subdivision2, data.Subdivision3, data.Subdivision4}),
+		Street:       data.Street,
+		Postcode:     utils.NormalizePostcode(data.Postcode),
+	}
+
+	place, err := u.geocoder.Forward(ctx, &q)
+	if err != nil {
+		return nil, u.geocodingError(span, fmt.Errorf("failed to geocode address: %w", err))
+	}
+
+	return u.toGeocodedAddress(ctx, place), nil
+}
+
+func (u *addressUsecase) ReverseGeocode(ctx context.Context, latitude, longitude float64) (*models.GeocodedAddress, *ce.Error) {
+	ctx, span := otel.Tracer(addressErrTracer).Start(ctx, "ReverseGeocode")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.AddrLatitude(&latitude, false); !ok {
+		err := fmt.Errorf("failed to reverse geocode location: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("latitude", why)
+	}
+	if ok, why := u.validator.AddrLongitude(&longitude, false); !ok {
+		err := fmt.Errorf("failed to reverse geocode location: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("longitude", why)
+	}
+
+	place, err := u.geocoder.Reverse(ctx, latitude, longitude)
+	if err != nil {
+		return nil, u.geocodingError(span, fmt.Errorf("failed to reverse geocode location: %w", err))
+	}
+
+	address := u.toGeocodedAddress(ctx, place)
+	address.Latitude, address.Longitude = latitude, longitude
+
+	return address, nil
+}
+
+func (u *addressUsecase) checkLimit(ctx context.Context, span trace.Span, authID int64) *ce.Error {
+	count, err := u.ar.CountAddresses(ctx, authID)
+	if err != nil {
+		return err
+	}
+	if count >= u.cfg.MaxPerUser {
+		e := fmt.Errorf("failed to add address: limit of %d addresses reached", u.cfg.MaxPerUser)
+		return ce.NewError(span, ce.CodeLimitExceeded, ce.MsgAddressLimitReached, e)
+	}
+
+	return nil
+}
+
+func (u *addressUsecase) checkDuplicate(ctx context.Context, span trace.Span, data *models.FindDuplicateAddress) *ce.Error {
+	data.RadiusM = u.cfg.DuplicateRadiusM
+
+	address, err := u.ar.FindDuplicateAddress(ctx, data)
+	if err != nil {
+		return err
+	}
+	if address != nil {
+		e := fmt.Errorf("failed to save address: duplicate of address %d", address.ID)
+		return ce.NewError(span, ce.CodeDataConflict, ce.MsgAddressAlreadyExists, e)
+	}
+
+	return nil
+}
+
+// resolveSubdivisions checks Indonesian subdivisions against the reference data and returns them under their
+// canonical names. Only provinces and the regencies of DKI Jakarta are loaded, levels below a region without
+// reference data are kept as given.
+func (u *addressUsecase) resolveSubdivisions(ctx context.Context, subdivisions []*string) ([]*string, string) {
+	resolved := make([]*string, len(subdivisions))
+
+	parentID, known, missing := "", true, 0
+
+	for i, s := range subdivisions {
+		if s == nil || *s == "" {
+			resolved[i] = s
+			if missing == 0 {
+				missing = i + 1
+			}
+			continue
+		}
+		if missing != 0 {
+			return nil, fmt.Sprintf("Subdivision %d must be provided before subdivision %d", missing, i+1)
+		}
+		if !known || !u.rr.HasChildren(ctx, parentID) {
+			known = false
+			resolved[i] = s
+			continue
+		}
+
+		region, err := u.rr.GetRegionByName(ctx, parentID, *s)
+		if err != nil {
+			return nil, fmt.Sprintf("Subdivision %d is invalid: %s", i+1, *s)
+		}
+
+		name := utils.NormalizeString(region.Name)
+		resolved[i] = &name
+		parentID = region.ID
+	}
+
+	return resolved, ""
+}
+
+// matchSubdivisions maps geocoded names onto canonical region names, stopping at the first unknown one
+func (u *addressUsecase) matchSubdivisions(ctx context.Context, names []string) []*string {
+	matched := make([]*string, 4)
+
+	parentID, known := "", true
+	for i := 0; i < len(matched) && i < len(names); i++ {
+		if names[i] == "" {
+			break
+		}
+		if !known || !u.rr.HasChildren(ctx, parentID) {
+			known = false
+			name := utils.NormalizeString(names[i])
+			matched[i] = &name
+			continue
+		}
+
+		region, err := u.rr.GetRegionByName(ctx, parentID, names[i])
+		if err != nil {
+			break
+		}
+
+		name := utils.NormalizeString(region.Name)
+		matched[i] = &name
+		parentID = region.ID
+	}
+
+	return matched
+}
+
+// suggestCoordinates geocodes the address, failures are only recorded since auto-completion is best-effort
+func (u *addressUsecase) suggestCoordinates(ctx context.Context, span trace.Span, q *geocoder.Query) (float64, float64, bool) {
+	place, err := u.geocoder.Forward(ctx, q)
+	if err != nil {
+		if !errors.Is(err, geocoder.ErrDisabled) {
+			span.RecordError(fmt.Errorf("failed to suggest coordinates: %w", err))
+		}
+		return 0, 0, false
+	}
+
+	return place.Latitude, place.Longitude, true
+}
+
+// suggestSubdivisions reverse geocodes the location, failures are only recorded since auto-completion is best-effort
+func (u *addressUsecase) suggestSubdivisions(ctx context.Context, span trace.Span, country string, latitude, longitude float64, postcode string) []*string {
+	place, err := u.geocoder.Reverse(ctx, latitude, longitude)
+	if err != nil {
+		if !errors.Is(err, geocoder.ErrDisabled) {
+			span.RecordError(fmt.Errorf("failed to suggest subdivisions: %w", err))
+		}
+		return nil
+	}
+	if place.CountryCode != country {
+		return nil
+	}
+
+	suggested := u.toGeocodedAddress(ctx, place)
+	subdivisions := []*string{suggested.Subdivision1, suggested.Subdivision2, suggested.Subdivision3, suggested.Subdivision4}
+	if subdivisions[0] == nil {
+		return nil
+	}
+
+	// Suggestions that would fail validation are dropped rather than surfaced as errors the user did not cause
+	if country == constants.RegionCountryCode {
+		if _, why := u.resolveSubdivisions(ctx, subdivisions); why != "" {
+			return nil
+		}
+	}
+
+	return subdivisions
+}
+
+func (u *addressUsecase) toGeocodedAddress(ctx context.Context, place *geocoder.Place) *models.GeocodedAddress {
+	var subdivisions []*string
+	if place.CountryCode == constants.RegionCountryCode {
+		subdivisions = u.matchSubdivisions(ctx, place.Subdivisions)
+	} else {
+		subdivisions = make([]*string, 4)
+		for i := 0; i < len(subdivisions) && i < len(place.Subdivisions); i++ {
+			if place.Subdivisions[i] != "" {
+				name := utils.NormalizeString(place.Subdivisions[i])
+				subdivisions[i] = &name
+			}
+		}
+	}
+
+	return &models.GeocodedAddress{
+		Country:      place.CountryCode,
+		Subdivision1: subdivisions[0],
+		Subdivision2: subdivisions[1],
+		Subdivision3: subdivisions[2],
+		Subdivision4: subdivisions[3],
+		Street:       place.Street,
+		Postcode:     utils.NormalizePostcode(place.Postcode),
+		Latitude:     place.Latitude,
+		Longitude:    place.Longitude,
+		DisplayName:  place.DisplayName,
+	}
+}
+
+func (u *addressUsecase) geocodingError(span trace.Span, err error) *ce.Error {
+	if errors.Is(err, geocoder.ErrNoResult) {
+		return ce.NewError(span, ce.CodeLocationNotFound, ce.MsgLocationNotFound, err)
+	}
+	return ce.NewError(span, ce.CodeServiceUnavailable, ce.MsgServiceUnavailable, err)
+}
@@ -0,0 +1,320 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/database"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/publisher"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/storage"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
+	"github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"github.com/ritchieridanko/pasarly/backend/shared/events/v1"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const exportErrTracer string = "usecase.export"
+
+type ExportUsecase interface {
+	ExportMyData(ctx context.Context, authID int64) (export *models.DataExport, err *ce.Error)
+	GetDataExport(ctx context.Context, authID int64, exportID string) (export *models.DataExport, err *ce.Error)
+	ProcessExports(ctx context.Context) (count int, err *ce.Error)
+	ExpireExports(ctx context.Context) (count int, err *ce.Error)
+}
+
+type exportUsecase struct {
+	cfg        *configs.Export
+	er         repositories.ExportRepository
+	ur         repositories.UserRepository
+	ar         repositories.AddressRepository
+	as         apis.AuthServiceClient
+	ns         apis.NotificationServiceClient
+	storage    storage.Storage
+	transactor *database.Transactor
+	udp        *publisher.Publisher
+	validator  *utils.Validator
+	logger     *logger.Logger
+}
+
+func NewExportUsecase(
+	cfg *configs.Export,
+	er repositories.ExportRepository,
+	ur repositories.UserRepository,
+	ar repositories.AddressRepository,
+	as apis.AuthServiceClient,
+	ns apis.NotificationServiceClient,
+	s storage.Storage,
+	tx *database.Transactor,
+	udp *publisher.Publisher,
+	v *utils.Validator,
+	l *logger.Logger,
+) ExportUsecase {
+	return &exportUsecase{
+		cfg:        cfg,
+		er:         er,
+		ur:         ur,
+		ar:         ar,
+		as:         as,
+		ns:         ns,
+		storage:    s,
+		transactor: tx,
+		udp:        udp,
+		validator:  v,
+		logger:     l,
+	}
+}
+
+func (u *exportUsecase) ExportMyData(ctx context.Context, authID int64) (*models.DataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "ExportMyData")
+	defer span.End()
+
+	export, err := u.er.CreateExport(ctx, authID, utils.NewUUID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	// An export already in progress is returned instead of queueing another one
+	if export == nil {
+		return u.er.GetActiveExport(ctx, authID)
+	}
+
+	return export, nil
+}
+
+func (u *exportUsecase) GetDataExport(ctx context.Context, authID int64, exportID string) (*models.DataExport, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "GetDataExport")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.ExportID(exportID); !ok {
+		err := fmt.Errorf("failed to fetch data export: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("export_id", why)
+	}
+
+	export, err := u.er.GetExportByID(ctx, authID, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Status == constants.ExportStatusCompleted && export.ObjectKey != nil && export.ExpiresAt != nil {
+		if !time.Now().UTC().Before(*export.ExpiresAt) {
+			// Awaiting cleanup by the worker
+			export.Status = constants.ExportStatusExpired
+			return export, nil
+		}
+
+		url, err := u.storage.SignedURL(*export.ObjectKey, *export.ExpiresAt)
+		if err != nil {
+			e := fmt.Errorf("failed to fetch data export: %w", err)
+			return nil, ce.NewError(span, ce.CodeStorageFailed, ce.MsgInternalServer, e)
+		}
+
+		export.DownloadURL = &url
+	}
+
+	return export, nil
+}
+
+func (u *exportUsecase) ProcessExports(ctx context.Context) (int, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "ProcessExports")
+	defer span.End()
+
+	exports, err := u.er.ClaimExports(ctx, u.cfg.BatchSize, time.Now().UTC().Add(-u.cfg.StaleAfter))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, export := range exports {
+		if err := u.process(ctx, &export); err != nil {
+			u.logger.Sugar().Errorln(err.Error())
+
+			data := models.FailDataExport{
+				ExportID:    export.ID,
+				Error:       err.Err.Error(),
+				MaxAttempts: u.cfg.MaxAttempts,
+			}
+
+			status, err := u.er.FailExport(ctx, &data)
+			if err != nil {
+				u.logger.Sugar().Errorln(err.Error())
+				continue
+			}
+
+			u.logger.Sugar().Warnf("data export failed (export_id=%s, attempt=%d, status=%s)", export.ID, export.Attempts, status)
+		}
+	}
+
+	return len(exports), nil
+}
+
+func (u *exportUsecase) ExpireExports(ctx context.Context) (int, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "ExpireExports")
+	defer span.End()
+
+	var count int
+
+	// A failed archive deletion keeps the exports to be expired on the next run
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		exports, err := u.er.ExpireExports(ctx, u.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(exports))
+		for _, export := range exports {
+			keys = append(keys, export.ObjectKey)
+		}
+
+		if len(keys) > 0 {
+			if err := u.storage.Delete(ctx, keys...); err != nil {
+				e := fmt.Errorf("failed to expire exports: %w", err)
+				return ce.NewError(span, ce.CodeStorageFailed, ce.MsgInternalServer, e)
+			}
+		}
+
+		count = len(exports)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (u *exportUsecase) process(ctx context.Context, export *models.DataExport) *ce.Error {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "process")
+	defer span.End()
+
+	content, err := u.gather(ctx, export.AuthID)
+	if err != nil {
+		return err
+	}
+
+	archive, ea := utils.BuildExportArchive(content)
+	if ea != nil {
+		e := fmt.Errorf("failed to process export: %w", ea)
+		return ce.NewError(span, ce.CodeInternal, ce.MsgInternalServer, e)
+	}
+
+	key := fmt.Sprintf("exports/%d/%s.zip", export.AuthID, export.ID)
+	if err := u.storage.Put(ctx, key, "application/zip", archive); err != nil {
+		e := fmt.Errorf("failed to process export: %w", err)
+		return ce.NewError(span, ce.CodeStorageFailed, ce.MsgInternalServer, e)
+	}
+
+	expiresAt := time.Now().UTC().Add(u.cfg.TTL)
+
+	url, es := u.storage.SignedURL(key, expiresAt)
+	if es != nil {
+		e := fmt.Errorf("failed to process export: %w", es)
+		return ce.NewError(span, ce.CodeStorageFailed, ce.MsgInternalServer, e)
+	}
+
+	// A failed publish leaves the export processing to be retried
+	return u.transactor.WithTx(ctx, func(ctx context.Context) *ce.Error {
+		data := models.CompleteDataExport{
+			ExportID:  export.ID,
+			ObjectKey: key,
+			ExpiresAt: expiresAt,
+		}
+
+		if err := u.er.CompleteExport(ctx, &data); err != nil {
+			return err
+		}
+
+		evt := events.UserDataExported{
+			EventId:     utils.NewUUID().String(),
+			AuthId:      export.AuthID,
+			Email:       content.Auth.Email,
+			DownloadUrl: url,
+			ExpiresAt:   timestamppb.New(expiresAt),
+			Locale:      content.Auth.Locale,
+		}
+
+		if err := u.udp.Publish(ctx, export.ID, &evt); err != nil {
+			e := fmt.Errorf("failed to publish event: %w", err)
+			return ce.NewError(span, ce.CodeInternal, ce.MsgInternalServer, e)
+		}
+
+		return nil
+	})
+}
+
+// gather collects the account and notification history from their owning services, and the profile
+// and addresses stored here
+func (u *exportUsecase) gather(ctx context.Context, authID int64) (*models.DataExportContent, *ce.Error) {
+	ctx, span := otel.Tracer(exportErrTracer).Start(ctx, "gather")
+	defer span.End()
+
+	ar, err := u.as.ExportAuthData(ctx, &apis.ExportAuthDataRequest{AuthId: authID})
+	if err != nil {
+		e := fmt.Errorf("failed to gather auth data: %w", err)
+		return nil, ce.NewError(span, ce.CodeServiceUnavailable, ce.MsgServiceUnavailable, e)
+	}
+
+	nr, err := u.ns.ListNotificationHistory(ctx, &apis.ListNotificationHistoryRequest{AuthId: authID})
+	if err != nil {
+		e := fmt.Errorf("failed to gather notification history: %w", err)
+		return nil, ce.NewError(span, ce.CodeServiceUnavailable, ce.MsgServiceUnavailable, e)
+	}
+
+	profile, ec := u.ur.GetUserByAuthID(ctx, authID)
+	if ec != nil && ec.Code != ce.CodeUserNotFound {
+		return nil, ec
+	}
+
+	addresses, ec := u.ar.GetAllAddresses(ctx, authID)
+	if ec != nil {
+		return nil, ec
+	}
+
+	content := models.DataExportContent{
+		ExportedAt: time.Now().UTC(),
+		Auth: models.ExportedAuth{
+			ID:                  ar.GetAuth().GetId(),
+			Email:               ar.GetAuth().GetEmail(),
+			Role:                ar.GetAuth().GetRole(),
+			IsVerified:          ar.GetAuth().GetIsVerified(),
+			Locale:              ar.GetAuth().GetLocale(),
+			DeletionScheduledAt: utils.UnwrapTimestamp(ar.GetAuth().GetDeletionScheduledAt()),
+			CreatedAt:           ar.GetAuth().GetCreatedAt().AsTime(),
+			UpdatedAt:           ar.GetAuth().GetUpdatedAt().AsTime(),
+		},
+		Sessions:      make([]models.ExportedSession, 0, len(ar.GetSessions())),
+		Profile:       profile,
+		Addresses:     addresses,
+		Notifications: make([]models.ExportedNotification, 0, len(nr.GetRecords())),
+	}
+
+	for _, s := range ar.GetSessions() {
+		content.Sessions = append(content.Sessions, models.ExportedSession{
+			ID:        s.GetId(),
+			UserAgent: s.GetUserAgent(),
+			IPAddress: s.GetIpAddress(),
+			CreatedAt: s.GetCreatedAt().AsTime(),
+			ExpiresAt: s.GetExpiresAt().AsTime(),
+			RevokedAt: utils.UnwrapTimestamp(s.GetRevokedAt()),
+		})
+	}
+
+	for _, r := range nr.GetRecords() {
+		content.Notifications = append(content.Notifications, models.ExportedNotification{
+			ID:          r.GetId(),
+			Type:        r.GetType(),
+			ProcessedAt: r.GetProcessedAt().AsTime(),
+			CompletedAt: utils.UnwrapTimestamp(r.GetCompletedAt()),
+		})
+	}
+
+	return &content, nil
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/repositories"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const regionErrTracer string = "usecase.region"
+
+type RegionUsecase interface {
+	ListRegions(ctx context.Context, parentID string) (regions []models.Region, err *ce.Error)
+}
+
+type regionUsecase struct {
+	rr repositories.RegionRepository
+}
+
+func NewRegionUsecase(rr repositories.RegionRepository) RegionUsecase {
+	return &regionUsecase{rr: rr}
+}
+
+func (u *regionUsecase) ListRegions(ctx context.Context, parentID string) ([]models.Region, *ce.Error) {
+	ctx, span := otel.Tracer(regionErrTracer).Start(ctx, "ListRegions")
+	defer span.End()
+
+	return u.rr.GetRegions(ctx, parentID)
+}
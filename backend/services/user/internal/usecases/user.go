@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ritchieridanko/pasarly/backend/services/user/configs"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/storage"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/repositories"
 	"github.com/ritchieridanko/pasarly/backend/services/user/internal/utils"
 	"github.com/ritchieridanko/pasarly/backend/shared/ce"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const userErrTracer string = "usecase.user"
@@ -18,16 +21,28 @@ type UserUsecase interface {
 	UpsertUser(ctx context.Context, data *models.UpsertUser) (user *models.User, err *ce.Error)
 	GetUser(ctx context.Context, authID int64) (user *models.User, err *ce.Error)
 	UpdateUser(ctx context.Context, data *models.UpdateUser) (user *models.User, err *ce.Error)
-	UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (profilePicture string, err *ce.Error)
+	UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (profilePicture *models.ProfilePicture, err *ce.Error)
+	GetPublicProfile(ctx context.Context, userID string) (profile *models.PublicProfile, err *ce.Error)
+	GetProfileVisibility(ctx context.Context, authID int64) (visibility *models.ProfileVisibility, err *ce.Error)
+	UpdateProfileVisibility(ctx context.Context, data *models.UpdateProfileVisibility) (visibility *models.ProfileVisibility, err *ce.Error)
 }
 
 type userUsecase struct {
+	cfg       *configs.Image
 	ur        repositories.UserRepository
+	storage   storage.Storage
 	validator *utils.Validator
+	image     *utils.ImageProcessor
 }
 
-func NewUserUsecase(ur repositories.UserRepository, v *utils.Validator) UserUsecase {
-	return &userUsecase{ur: ur, validator: v}
+func NewUserUsecase(
+	cfg *configs.Image,
+	ur repositories.UserRepository,
+	s storage.Storage,
+	v *utils.Validator,
+	ip *utils.ImageProcessor,
+) UserUsecase {
+	return &userUsecase{cfg: cfg, ur: ur, storage: s, validator: v, image: ip}
 }
 
 func (u *userUsecase) UpsertUser(ctx context.Context, data *models.UpsertUser) (*models.User, *ce.Error) {
@@ -37,25 +52,28 @@ func (u *userUsecase) UpsertUser(ctx context.Context, data *models.UpsertUser) (
 	// Validations
 	if ok, why := u.validator.Name(&data.Name, false); !ok {
 		err := fmt.Errorf("failed to upsert user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("name", why)
 	}
 	if ok, why := u.validator.Bio(data.Bio); !ok {
 		err := fmt.Errorf("failed to upsert user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("bio", why)
 	}
 	if ok, why := u.validator.Sex(data.Sex); !ok {
 		err := fmt.Errorf("failed to upsert user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("sex", why)
 	}
 	if ok, why := u.validator.Birthdate(data.Birthdate); !ok {
 		err := fmt.Errorf("failed to upsert user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("birthdate", why)
 	}
-	if ok, why := u.validator.Phone(data.Phone); !ok {
+	if ok, why := u.validator.Phone(data.Phone, utils.DefaultCountryCode); !ok {
 		err := fmt.Errorf("failed to upsert user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("phone", why)
 	}
 
+	// Normalizations
+	data.Phone = utils.ToE164Ptr(data.Phone, utils.DefaultCountryCode)
+
 	data.UserID = utils.NewUUID().String()
 	return u.ur.UpsertUser(ctx, data)
 }
@@ -74,31 +92,118 @@ func (u *userUsecase) UpdateUser(ctx context.Context, data *models.UpdateUser) (
 	// Validations
 	if ok, why := u.validator.Name(data.Name, true); !ok {
 		err := fmt.Errorf("failed to update user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("name", why)
 	}
 	if ok, why := u.validator.Bio(data.Bio); !ok {
 		err := fmt.Errorf("failed to update user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("bio", why)
 	}
 	if ok, why := u.validator.Sex(data.Sex); !ok {
 		err := fmt.Errorf("failed to update user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("sex", why)
 	}
 	if ok, why := u.validator.Birthdate(data.Birthdate); !ok {
 		err := fmt.Errorf("failed to update user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("birthdate", why)
 	}
-	if ok, why := u.validator.Phone(data.Phone); !ok {
+	if ok, why := u.validator.Phone(data.Phone, utils.DefaultCountryCode); !ok {
 		err := fmt.Errorf("failed to update user: %w", errors.New(why))
-		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("phone", why)
 	}
 
+	// Normalizations
+	data.Phone = utils.ToE164Ptr(data.Phone, utils.DefaultCountryCode)
+
 	return u.ur.UpdateUser(ctx, data)
 }
 
-func (u *userUsecase) UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (string, *ce.Error) {
+func (u *userUsecase) UpdateProfilePicture(ctx context.Context, data *models.UpdateProfilePicture) (*models.ProfilePicture, *ce.Error) {
 	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateProfilePicture")
 	defer span.End()
 
-	return u.ur.UpdateProfilePicture(ctx, data)
+	// Validations
+	if ok, why := u.validator.ProfilePicture(data.Image, u.cfg.MaxSize); !ok {
+		err := fmt.Errorf("failed to update profile picture: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("image", why)
+	}
+
+	user, err := u.ur.GetUserByAuthID(ctx, data.AuthID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, e := u.image.Thumbnails(data.Image)
+	if e != nil {
+		err := fmt.Errorf("failed to update profile picture: %w", e)
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, "Image could not be processed", err)
+	}
+
+	prefix := fmt.Sprintf("profile-pictures/%s/%s", user.ID, utils.NewUUID().String())
+	pp := models.ProfilePicture{Thumbnails: make(map[int]string, len(variants))}
+	keys := make([]string, 0, len(variants))
+
+	for _, v := range variants {
+		key := u.image.VariantKey(prefix, v.Size)
+		if e := u.storage.Put(ctx, key, "image/jpeg", v.Data); e != nil {
+			u.discard(ctx, span, keys)
+			err := fmt.Errorf("failed to update profile picture: %w", e)
+			return nil, ce.NewError(span, ce.CodeStorageFailed, ce.MsgInternalServer, err)
+		}
+
+		keys = append(keys, key)
+		pp.Thumbnails[v.Size] = u.storage.URL(key)
+	}
+
+	// Variants are ordered largest first
+	pp.URL = pp.Thumbnails[variants[0].Size]
+	data.ProfilePicture = pp.URL
+	data.ProfilePictureKey = prefix
+
+	prevKey, err := u.ur.UpdateProfilePicture(ctx, data)
+	if err != nil {
+		u.discard(ctx, span, keys)
+		return nil, err
+	}
+	if prevKey != nil && *prevKey != "" {
+		u.discard(ctx, span, u.image.VariantKeys(*prevKey))
+	}
+
+	return &pp, nil
+}
+
+// discard deletes stored images on a best-effort basis, a failure only leaves orphans behind
+func (u *userUsecase) discard(ctx context.Context, span trace.Span, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := u.storage.Delete(context.WithoutCancel(ctx), keys...); err != nil {
+		span.RecordError(fmt.Errorf("failed to delete profile picture: %w", err))
+	}
+}
+
+func (u *userUsecase) GetPublicProfile(ctx context.Context, userID string) (*models.PublicProfile, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetPublicProfile")
+	defer span.End()
+
+	// Validations
+	if ok, why := u.validator.UserID(userID); !ok {
+		err := fmt.Errorf("failed to fetch public profile: %w", errors.New(why))
+		return nil, ce.NewError(span, ce.CodeInvalidPayload, why, err).WithField("user_id", why)
+	}
+
+	return u.ur.GetPublicProfile(ctx, userID)
+}
+
+func (u *userUsecase) GetProfileVisibility(ctx context.Context, authID int64) (*models.ProfileVisibility, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "GetProfileVisibility")
+	defer span.End()
+
+	return u.ur.GetProfileVisibility(ctx, authID)
+}
+
+func (u *userUsecase) UpdateProfileVisibility(ctx context.Context, data *models.UpdateProfileVisibility) (*models.ProfileVisibility, *ce.Error) {
+	ctx, span := otel.Tracer(userErrTracer).Start(ctx, "UpdateProfileVisibility")
+	defer span.End()
+
+	return u.ur.UpdateProfileVisibility(ctx, data)
 }
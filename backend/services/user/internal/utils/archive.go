@@ -0,0 +1,241 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
+)
+
+type exportDocument struct {
+	ExportedAt    time.Time            `json:"exported_at"`
+	Account       exportAccount        `json:"account"`
+	Sessions      []exportSession      `json:"sessions"`
+	Profile       *exportProfile       `json:"profile"`
+	Addresses     []exportAddress      `json:"addresses"`
+	Notifications []exportNotification `json:"notifications"`
+}
+
+type exportAccount struct {
+	ID                  int64      `json:"id"`
+	Email               string     `json:"email"`
+	Role                string     `json:"role"`
+	IsVerified          bool       `json:"is_verified"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type exportSession struct {
+	ID        int64      `json:"id"`
+	UserAgent string     `json:"user_agent"`
+	IPAddress string     `json:"ip_address"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+type exportProfile struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Bio            *string    `json:"bio"`
+	Sex            *string    `json:"sex"`
+	Birthdate      *time.Time `json:"birthdate"`
+	Phone          *string    `json:"phone"`
+	ProfilePicture *string    `json:"profile_picture"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type exportAddress struct {
+	ID           int64     `json:"id"`
+	Recipient    string    `json:"recipient"`
+	Phone        string    `json:"phone"`
+	Label        string    `json:"label"`
+	Notes        *string   `json:"notes"`
+	IsPrimary    bool      `json:"is_primary"`
+	Country      string    `json:"country"`
+	Subdivision1 *string   `json:"subdivision_1"`
+	Subdivision2 *string   `json:"subdivision_2"`
+	Subdivision3 *string   `json:"subdivision_3"`
+	Subdivision4 *string   `json:"subdivision_4"`
+	Street       string    `json:"street"`
+	Postcode     string    `json:"postcode"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type exportNotification struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	ProcessedAt time.Time  `json:"processed_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// BuildExportArchive zips the content as a single JSON document alongside a CSV file per collection
+func BuildExportArchive(c *models.DataExportContent) ([]byte, error) {
+	doc := toExportDocument(c)
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build export archive: %w", err)
+	}
+
+	sessions := [][]string{{"id", "user_agent", "ip_address", "created_at", "expires_at", "revoked_at"}}
+	for _, s := range doc.Sessions {
+		sessions = append(sessions, []string{
+			strconv.FormatInt(s.ID, 10), s.UserAgent, s.IPAddress,
+			formatTime(&s.CreatedAt), formatTime(&s.ExpiresAt), formatTime(s.RevokedAt),
+		})
+	}
+
+	addresses := [][]string{{
+		"id", "recipient", "phone", "label", "notes", "is_primary", "country",
+		"subdivision_1", "subdivision_2", "subdivision_3", "subdivision_4",
+		"street", "postcode", "latitude", "longitude", "created_at", "updated_at",
+	}}
+	for _, a := range doc.Addresses {
+		addresses = append(addresses, []string{
+			strconv.FormatInt(a.ID, 10), a.Recipient, a.Phone, a.Label, deref(a.Notes),
+			strconv.FormatBool(a.IsPrimary), a.Country,
+			deref(a.Subdivision1), deref(a.Subdivision2), deref(a.Subdivision3), deref(a.Subdivision4),
+			a.Street, a.Postcode,
+			strconv.FormatFloat(a.Latitude, 'f', -1, 64), strconv.FormatFloat(a.Longitude, 'f', -1, 64),
+			formatTime(&a.CreatedAt), formatTime(&a.UpdatedAt),
+		})
+	}
+
+	notifications := [][]string{{"id", "type", "processed_at", "completed_at"}}
+	for _, n := range doc.Notifications {
+		notifications = append(notifications, []string{
+			n.ID, n.Type, formatTime(&n.ProcessedAt), formatTime(n.CompletedAt),
+		})
+	}
+
+	var b bytes.Buffer
+	zw := zip.NewWriter(&b)
+
+	if err := writeZipFile(zw, "data.json", c.ExportedAt, data); err != nil {
+		return nil, fmt.Errorf("failed to build export archive: %w", err)
+	}
+
+	csvs := []struct {
+		name    string
+		records [][]string
+	}{
+		{name: "sessions.csv", records: sessions},
+		{name: "addresses.csv", records: addresses},
+		{name: "notifications.csv", records: notifications},
+	}
+	for _, f := range csvs {
+		var cb bytes.Buffer
+		cw := csv.NewWriter(&cb)
+		if err := cw.WriteAll(f.records); err != nil {
+			return nil, fmt.Errorf("failed to build export archive: %w", err)
+		}
+		if err := writeZipFile(zw, f.name, c.ExportedAt, cb.Bytes()); err != nil {
+			return nil, fmt.Errorf("failed to build export archive: %w", err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build export archive: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+func toExportDocument(c *models.DataExportContent) *exportDocument {
+	doc := exportDocument{
+		ExportedAt: c.ExportedAt,
+		Account: exportAccount{
+			ID:                  c.Auth.ID,
+			Email:               c.Auth.Email,
+			Role:                c.Auth.Role,
+			IsVerified:          c.Auth.IsVerified,
+			DeletionScheduledAt: c.Auth.DeletionScheduledAt,
+			CreatedAt:           c.Auth.CreatedAt,
+			UpdatedAt:           c.Auth.UpdatedAt,
+		},
+		Sessions:      make([]exportSession, 0, len(c.Sessions)),
+		Addresses:     make([]exportAddress, 0, len(c.Addresses)),
+		Notifications: make([]exportNotification, 0, len(c.Notifications)),
+	}
+
+	for _, s := range c.Sessions {
+		doc.Sessions = append(doc.Sessions, exportSession(s))
+	}
+
+	if c.Profile != nil {
+		doc.Profile = &exportProfile{
+			ID:             c.Profile.ID,
+			Name:           c.Profile.Name,
+			Bio:            c.Profile.Bio,
+			Sex:            c.Profile.Sex,
+			Birthdate:      c.Profile.Birthdate,
+			Phone:          c.Profile.Phone,
+			ProfilePicture: c.Profile.ProfilePicture,
+			CreatedAt:      c.Profile.CreatedAt,
+			UpdatedAt:      c.Profile.UpdatedAt,
+		}
+	}
+
+	for _, a := range c.Addresses {
+		doc.Addresses = append(doc.Addresses, exportAddress{
+			ID:           a.ID,
+			Recipient:    a.Recipient,
+			Phone:        a.Phone,
+			Label:        a.Label,
+			Notes:        a.Notes,
+			IsPrimary:    a.IsPrimary,
+			Country:      a.Country,
+			Subdivision1: a.Subdivision1,
+			Subdivision2: a.Subdivision2,
+			Subdivision3: a.Subdivision3,
+			Subdivision4: a.Subdivision4,
+			Street:       a.Street,
+			Postcode:     a.Postcode,
+			Latitude:     a.Latitude,
+			Longitude:    a.Longitude,
+			CreatedAt:    a.CreatedAt,
+			UpdatedAt:    a.UpdatedAt,
+		})
+	}
+
+	for _, n := range c.Notifications {
+		doc.Notifications = append(doc.Notifications, exportNotification(n))
+	}
+
+	return &doc
+}
+
+func writeZipFile(zw *zip.Writer, name string, modified time.Time, data []byte) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+const DefaultCountryCode string = "ID"
+
+type Country struct {
+	Code string // ISO 3166-1 alpha-2
+	Name string
+}
+
+var (
+	countries       = map[string]Country{}
+	countryAliases  = map[string]string{}
+	rgxPostcodeAny  = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,8}[A-Z0-9]$`)
+	rgxWhitespaces  = regexp.MustCompile(`\s+`)
+	postcodeFormats = map[string]*regexp.Regexp{
+		"AU": regexp.MustCompile(`^\d{4}$`),
+		"BN": regexp.MustCompile(`^[A-Z]{2} ?\d{4}$`),
+		"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+		"CA": regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+		"CN": regexp.MustCompile(`^\d{6}$`),
+		"DE": regexp.MustCompile(`^\d{5}$`),
+		"ES": regexp.MustCompile(`^\d{5}$`),
+		"FR": regexp.MustCompile(`^\d{5}$`),
+		"GB": regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+		"ID": regexp.MustCompile(`^\d{5}$`),
+		"IN": regexp.MustCompile(`^\d{6}$`),
+		"IT": regexp.MustCompile(`^\d{5}$`),
+		"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+		"KR": regexp.MustCompile(`^\d{5}$`),
+		"MY": regexp.MustCompile(`^\d{5}$`),
+		"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+		"NZ": regexp.MustCompile(`^\d{4}$`),
+		"PH": regexp.MustCompile(`^\d{4}$`),
+		"SA": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		"SG": regexp.MustCompile(`^\d{6}$`),
+		"TH": regexp.MustCompile(`^\d{5}$`),
+		"TW": regexp.MustCompile(`^\d{3}(\d{2,3})?$`),
+		"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		"VN": regexp.MustCompile(`^\d{6}$`),
+	}
+)
+
+// Countries without a postal code system
+var postcodeless = map[string]bool{
+	"AE": true, "AG": true, "AO": true, "AW": true, "BF": true, "BI": true, "BJ": true,
+	"BO": true, "BS": true, "BW": true, "BZ": true, "CD": true, "CF": true, "CG": true,
+	"CI": true, "CK": true, "CM": true, "DJ": true, "DM": true, "ER": true, "FJ": true,
+	"GA": true, "GD": true, "GH": true, "GM": true, "GQ": true, "GY": true, "HK": true,
+	"KI": true, "KM": true, "KN": true, "KP": true, "LY": true, "ML": true, "MO": true,
+	"MR": true, "MW": true, "NR": true, "NU": true, "QA": true, "RW": true, "SB": true,
+	"SC": true, "SL": true, "SR": true, "SS": true, "ST": true, "SY": true, "TD": true,
+	"TG": true, "TK": true, "TL": true, "TO": true, "TV": true, "UG": true, "VU": true,
+	"YE": true, "ZW": true,
+}
+
+func init() {
+	id := display.Regions(language.Indonesian)
+
+	for code := range phonenumbers.GetSupportedRegions() {
+		r, err := language.ParseRegion(code)
+		if err != nil || !r.IsCountry() {
+			continue
+		}
+
+		c := Country{Code: r.String(), Name: display.English.Regions().Name(r)}
+		countries[c.Code] = c
+
+		for _, alias := range []string{c.Code, r.ISO3(), c.Name, id.Name(r)} {
+			if alias != "" {
+				countryAliases[NormalizeString(alias)] = c.Code
+			}
+		}
+	}
+
+	for alias, code := range map[string]string{
+		"america":       "US",
+		"england":       "GB",
+		"great britain": "GB",
+		"korea":         "KR",
+		"uk":            "GB",
+		"usa":           "US",
+	} {
+		countryAliases[alias] = code
+	}
+}
+
+// LookupCountry resolves an ISO 3166 alpha-2/alpha-3 code or an English/Indonesian country name
+func LookupCountry(value string) (Country, bool) {
+	code, ok := countryAliases[NormalizeString(value)]
+	if !ok {
+		return Country{}, false
+	}
+	return countries[code], true
+}
+
+func CountryCode(value string) string {
+	if c, ok := LookupCountry(value); ok {
+		return c.Code
+	}
+	return value
+}
+
+func ParsePhone(value, countryCode string) (*phonenumbers.PhoneNumber, bool) {
+	num, err := phonenumbers.Parse(value, countryCode)
+	if err != nil {
+		return nil, false
+	}
+	return num, phonenumbers.IsValidNumber(num)
+}
+
+func ToE164(value, countryCode string) string {
+	num, ok := ParsePhone(value, countryCode)
+	if !ok {
+		return value
+	}
+	return phonenumbers.Format(num, phonenumbers.E164)
+}
+
+func NormalizePostcode(value string) string {
+	return rgxWhitespaces.ReplaceAllString(strings.ToUpper(strings.TrimSpace(value)), " ")
+}
+
+func HasPostcode(countryCode string) bool {
+	return !postcodeless[countryCode]
+}
+
+func MatchPostcode(value, countryCode string) bool {
+	if rgx, ok := postcodeFormats[countryCode]; ok {
+		return rgx.MatchString(value)
+	}
+	return rgxPostcodeAny.MatchString(value)
+}
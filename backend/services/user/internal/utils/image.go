@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"sort"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+type ImageVariant struct {
+	Size int
+	Data []byte
+}
+
+type ImageProcessor struct {
+	maxPixels int
+	sizes     []int
+	quality   int
+}
+
+func NewImageProcessor(maxPixels int, sizes []int, quality int) *ImageProcessor {
+	s := append([]int(nil), sizes...)
+	sort.Sort(sort.Reverse(sort.IntSlice(s)))
+	return &ImageProcessor{maxPixels: maxPixels, sizes: s, quality: quality}
+}
+
+// VariantKey is the storage key of the variant of the given size under prefix
+func (p *ImageProcessor) VariantKey(prefix string, size int) string {
+	return fmt.Sprintf("%s/%d.jpg", prefix, size)
+}
+
+func (p *ImageProcessor) VariantKeys(prefix string) []string {
+	keys := make([]string, 0, len(p.sizes))
+	for _, size := range p.sizes {
+		keys = append(keys, p.VariantKey(prefix, size))
+	}
+	return keys
+}
+
+// Thumbnails decodes the image and re-encodes it as square JPEGs, largest first.
+// Re-encoding drops every metadata segment, EXIF included.
+func (p *ImageProcessor) Thumbnails(data []byte) ([]ImageVariant, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > p.maxPixels {
+		return nil, fmt.Errorf("image dimensions are not allowed: %dx%d", cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if format == "jpeg" {
+		src = orient(src, exifOrientation(data))
+	}
+
+	// Center crop to a square before scaling
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	crop := image.Rect(x0, y0, x0+side, y0+side)
+
+	variants := make([]ImageVariant, 0, len(p.sizes))
+	for _, size := range p.sizes {
+		dst := image.NewRGBA(image.Rect(0, 0, size, size))
+		draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Over, nil)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: p.quality}); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+
+		variants = append(variants, ImageVariant{Size: size, Data: buf.Bytes()})
+	}
+
+	return variants, nil
+}
+
+// exifOrientation returns the EXIF orientation tag of a JPEG, or 1 when absent
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && len(segment) >= 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+
+		i += 2 + size
+	}
+
+	return 1
+}
+
+func tiffOrientation(t []byte) int {
+	if len(t) < 8 {
+		return 1
+	}
+
+	var bo binary.ByteOrder
+	switch string(t[:2]) {
+	case "II":
+		bo = binary.LittleEndian
+	case "MM":
+		bo = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(bo.Uint32(t[4:]))
+	if offset < 0 || offset+2 > len(t) {
+		return 1
+	}
+
+	entries := int(bo.Uint16(t[offset:]))
+	for n := 0; n < entries; n++ {
+		e := offset + 2 + n*12
+		if e+12 > len(t) {
+			return 1
+		}
+		if bo.Uint16(t[e:]) == 0x0112 {
+			return int(bo.Uint16(t[e+8:]))
+		}
+	}
+
+	return 1
+}
+
+func orient(src image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, src.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+
+	return dst
+}
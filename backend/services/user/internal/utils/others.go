@@ -17,6 +17,23 @@ import (
 
 var titlecaser = cases.Title(language.English)
 
+func CoalesceString(s, fallback *string) *string {
+	if s != nil {
+		return s
+	}
+	return fallback
+}
+
+func DerefStrings(ss []*string) []string {
+	res := make([]string, len(ss))
+	for i, s := range ss {
+		if s != nil {
+			res[i] = *s
+		}
+	}
+	return res
+}
+
 func NewUUID() uuid.UUID {
 	return uuid.New()
 }
@@ -55,6 +72,14 @@ func ToTitlecasePtr(s *string) *string {
 	return &res
 }
 
+func ToE164Ptr(s *string, countryCode string) *string {
+	if s == nil {
+		return nil
+	}
+	res := ToE164(*s, countryCode)
+	return &res
+}
+
 func TraceErr(s trace.Span, err error, message string) {
 	s.RecordError(err)
 	s.SetStatus(codes.Error, message)
@@ -68,6 +93,13 @@ func TrimSpacePtr(s *string) *string {
 	return &res
 }
 
+func UnwrapBool(bv *wrappers.BoolValue) *bool {
+	if bv != nil {
+		return &bv.Value
+	}
+	return nil
+}
+
 func UnwrapDouble(dv *wrappers.DoubleValue) *float64 {
 	if dv != nil {
 		return &dv.Value
@@ -2,8 +2,14 @@ package utils
 
 import (
 	"fmt"
-	"regexp"
+	"net/http"
+	"slices"
+	"strconv"
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/constants"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/models"
 )
 
 const (
@@ -22,9 +28,15 @@ const (
 	minLatitude  float64 = -90
 	maxLongitude float64 = 180
 	minLongitude float64 = -180
+	maxRadiusKm  float64 = 100
 )
 
-var rgxPhone = regexp.MustCompile(`^(?:0|\+62|62)8\d{8,11}$`)
+var imageTypes = map[string]bool{
+	"image/gif":  true,
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
 
 type Validator struct{}
 
@@ -98,20 +110,33 @@ func (u *Validator) Birthdate(value *time.Time) (bool, string) {
 	return true, ""
 }
 
-func (u *Validator) Phone(value *string) (bool, string) {
+func (u *Validator) Phone(value *string, countryCode string) (bool, string) {
 	if value == nil {
 		return true, ""
 	}
 	if *value == "" {
 		return true, ""
 	}
-	if !rgxPhone.MatchString(*value) {
+	if _, ok := ParsePhone(*value, countryCode); !ok {
 		return false, fmt.Sprintf("Phone is invalid: %s", *value)
 	}
 	return true, ""
 }
 
-func (u *Validator) AddrPhone(value *string, optional bool) (bool, string) {
+func (u *Validator) ProfilePicture(value []byte, maxSize int) (bool, string) {
+	if len(value) == 0 {
+		return false, "Image is empty"
+	}
+	if len(value) > maxSize {
+		return false, fmt.Sprintf("Image must not exceed %d bytes", maxSize)
+	}
+	if t := http.DetectContentType(value); !imageTypes[t] {
+		return false, fmt.Sprintf("Image type is not supported: %s", t)
+	}
+	return true, ""
+}
+
+func (u *Validator) AddrPhone(value *string, optional bool, countryCode string) (bool, string) {
 	if optional && value == nil {
 		return true, ""
 	}
@@ -121,7 +146,7 @@ func (u *Validator) AddrPhone(value *string, optional bool) (bool, string) {
 	if *value == "" {
 		return false, "Phone is empty"
 	}
-	if !rgxPhone.MatchString(*value) {
+	if _, ok := ParsePhone(*value, countryCode); !ok {
 		return false, fmt.Sprintf("Phone is invalid: %s", *value)
 	}
 	return true, ""
@@ -169,6 +194,9 @@ func (u *Validator) AddrCountry(value *string, optional bool) (bool, string) {
 	if len(*value) > countryMaxLength {
 		return false, fmt.Sprintf("Country must not exceed %d characters", countryMaxLength)
 	}
+	if _, ok := LookupCountry(*value); !ok {
+		return false, fmt.Sprintf("Country is invalid: %s", *value)
+	}
 	return true, ""
 }
 
@@ -201,7 +229,7 @@ func (u *Validator) AddrStreet(value *string, optional bool) (bool, string) {
 	return true, ""
 }
 
-func (u *Validator) AddrPostcode(value *string, optional bool) (bool, string) {
+func (u *Validator) AddrPostcode(value *string, optional bool, countryCode string) (bool, string) {
 	if optional && value == nil {
 		return true, ""
 	}
@@ -209,11 +237,17 @@ func (u *Validator) AddrPostcode(value *string, optional bool) (bool, string) {
 		return false, "Postcode is not provided"
 	}
 	if *value == "" {
+		if !HasPostcode(countryCode) {
+			return true, ""
+		}
 		return false, "Postcode is empty"
 	}
 	if len(*value) > postcodeMaxLength {
 		return false, fmt.Sprintf("Postcode must not exceed %d characters", postcodeMaxLength)
 	}
+	if HasPostcode(countryCode) && !MatchPostcode(NormalizePostcode(*value), countryCode) {
+		return false, fmt.Sprintf("Postcode is invalid for country %s: %s", countryCode, *value)
+	}
 	return true, ""
 }
 
@@ -242,3 +276,68 @@ func (u *Validator) AddrLongitude(value *float64, optional bool) (bool, string)
 	}
 	return true, ""
 }
+
+func (u *Validator) AddrRadius(value *float64) (bool, string) {
+	if value == nil {
+		return false, "Radius is not provided"
+	}
+	if *value <= 0 || *value > maxRadiusKm {
+		return false, fmt.Sprintf("Radius must be greater than 0 and at most %.0f km", maxRadiusKm)
+	}
+	return true, ""
+}
+
+func (u *Validator) Page(value *models.PageRequest, sortFields, filterFields []string) (bool, string) {
+	if value.PageSize < 0 || value.PageSize > constants.PageSizeMax {
+		return false, fmt.Sprintf("Page size must be between 1 and %d", constants.PageSizeMax)
+	}
+	if value.Page < 0 {
+		return false, "Page must be at least 1"
+	}
+	if value.Page > 0 && value.Cursor != "" {
+		return false, "Page and cursor must not be combined"
+	}
+	if len(value.Sort) > 1 {
+		return false, "Only one sort field is supported"
+	}
+	for _, s := range value.Sort {
+		if !slices.Contains(sortFields, s.Field) {
+			return false, fmt.Sprintf("Sort field is not supported: %s", s.Field)
+		}
+	}
+	for field := range value.Filters {
+		if !slices.Contains(filterFields, field) {
+			return false, fmt.Sprintf("Filter field is not supported: %s", field)
+		}
+	}
+	return true, ""
+}
+
+func (u *Validator) AddrFilters(value map[string]string) (bool, string) {
+	if v, ok := value["is_primary"]; ok {
+		if _, err := strconv.ParseBool(v); err != nil {
+			return false, fmt.Sprintf("Filter is_primary is invalid: %s", v)
+		}
+	}
+	return true, ""
+}
+
+func (u *Validator) UserID(value string) (bool, string) {
+	if value == "" {
+		return false, "User ID is not provided"
+	}
+	if err := uuid.Validate(value); err != nil {
+		return false, "User ID is invalid"
+	}
+	return true, ""
+}
+
+func (u *Validator) ExportID(value string) (bool, string) {
+	if value == "" {
+		return false, "Export ID is not provided"
+	}
+	if err := uuid.Validate(value); err != nil {
+		return false, "Export ID is invalid"
+	}
+	return true, ""
+}
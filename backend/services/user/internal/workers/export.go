@@ -0,0 +1,38 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/infra/logger"
+	"github.com/ritchieridanko/pasarly/backend/services/user/internal/usecases"
+	"github.com/ritchieridanko/pasarly/backend/shared/batch"
+)
+
+type ExportWorker struct {
+	interval  time.Duration
+	batchSize int
+	eu        usecases.ExportUsecase
+	logger    *logger.Logger
+}
+
+func NewExportWorker(interval time.Duration, batchSize int, eu usecases.ExportUsecase, l *logger.Logger) *ExportWorker {
+	return &ExportWorker{interval: interval, batchSize: batchSize, eu: eu, logger: l}
+}
+
+// Run builds queued data exports and removes expired ones until ctx is cancelled
+func (w *ExportWorker) Run(ctx context.Context) error {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+
+	for {
+		batch.Drain(ctx, w.logger.Base(), w.batchSize, "processed data exports", w.eu.ProcessExports)
+		batch.Drain(ctx, w.logger.Base(), w.batchSize, "expired data exports", w.eu.ExpireExports)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
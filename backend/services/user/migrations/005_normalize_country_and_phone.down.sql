@@ -0,0 +1,2 @@
+-- E.164 phones still satisfy the previous format, only the country code is reverted
+UPDATE addresses SET country = 'indonesia' WHERE country = 'ID';
@@ -0,0 +1,6 @@
+-- Existing rows were only ever validated as Indonesian, so they map onto "ID" and +62
+UPDATE addresses SET country = 'ID' WHERE LOWER(TRIM(country)) IN ('indonesia', 'id', 'idn');
+UPDATE addresses SET phone = '+62' || SUBSTRING(phone FROM 2) WHERE phone ~ '^08';
+UPDATE addresses SET phone = '+' || phone WHERE phone ~ '^628';
+UPDATE users SET phone = '+62' || SUBSTRING(phone FROM 2) WHERE phone ~ '^08';
+UPDATE users SET phone = '+' || phone WHERE phone ~ '^628';
@@ -0,0 +1,7 @@
+-- Backfill locations of addresses created before the column was populated
+UPDATE addresses
+SET location = ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)
+WHERE location IS NULL;
+
+-- Optimize spatial queries of addresses by location
+CREATE INDEX idx_addresses_location ON addresses USING GIST(location);
@@ -0,0 +1,26 @@
+CREATE TABLE data_exports(
+    export_id UUID PRIMARY KEY,
+    auth_id BIGINT NOT NULL,
+
+    status VARCHAR NOT NULL DEFAULT 'pending', -- "pending", "processing", "completed", "failed", and "expired"
+    object_key VARCHAR,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ
+);
+
+-- Optimize queries of user's exports by auth_id
+CREATE INDEX idx_data_exports_auth_id ON data_exports(auth_id);
+
+-- Allow a single export in progress per user
+CREATE UNIQUE INDEX idx_data_exports_active ON data_exports(auth_id) WHERE status IN ('pending', 'processing');
+
+-- Optimize claiming of exports waiting to be processed
+CREATE INDEX idx_data_exports_queue ON data_exports(updated_at) WHERE status IN ('pending', 'processing');
+
+-- Optimize lookups of completed exports past their expiry
+CREATE INDEX idx_data_exports_expires_at ON data_exports(expires_at) WHERE status = 'completed';
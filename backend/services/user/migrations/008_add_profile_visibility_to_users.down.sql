@@ -0,0 +1,4 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS show_bio,
+    DROP COLUMN IF EXISTS show_profile_picture,
+    DROP COLUMN IF EXISTS show_member_since;
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN show_bio BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN show_profile_picture BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN show_member_since BOOLEAN NOT NULL DEFAULT TRUE;
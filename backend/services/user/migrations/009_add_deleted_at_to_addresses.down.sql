@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_addresses_auth_id_active;
+ALTER TABLE addresses DROP COLUMN IF EXISTS deleted_at;
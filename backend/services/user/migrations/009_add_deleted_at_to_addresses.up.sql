@@ -0,0 +1,4 @@
+ALTER TABLE addresses ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- Optimize queries of user's active addresses by auth_id
+CREATE INDEX idx_addresses_auth_id_active ON addresses(auth_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE events(
+    event_id VARCHAR PRIMARY KEY,
+    event_type VARCHAR NOT NULL,
+    auth_id BIGINT,
+
+    -- The instance holding an unexpired lease is the only one allowed to process the event
+    lease_token VARCHAR,
+    lease_expires_at TIMESTAMPTZ,
+
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+-- Optimize queries of user's events by auth_id
+CREATE INDEX idx_events_auth_id ON events(auth_id) WHERE auth_id IS NOT NULL;
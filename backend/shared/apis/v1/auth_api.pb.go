@@ -24,15 +24,17 @@ const (
 )
 
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	IsVerified    bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email               string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role                string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	IsVerified          bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	CreatedAt           *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletionScheduledAt *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"`
+	Locale              string                 `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Auth) Reset() {
@@ -107,6 +109,104 @@ func (x *Auth) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *Auth) GetDeletionScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return nil
+}
+
+func (x *Auth) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_v1_auth_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Session) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetRevokedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
 type AuthToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Session       string                 `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
@@ -117,7 +217,7 @@ type AuthToken struct {
 
 func (x *AuthToken) Reset() {
 	*x = AuthToken{}
-	mi := &file_v1_auth_api_proto_msgTypes[1]
+	mi := &file_v1_auth_api_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -129,7 +229,7 @@ func (x *AuthToken) String() string {
 func (*AuthToken) ProtoMessage() {}
 
 func (x *AuthToken) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[1]
+	mi := &file_v1_auth_api_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -142,7 +242,7 @@ func (x *AuthToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthToken.ProtoReflect.Descriptor instead.
 func (*AuthToken) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{1}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AuthToken) GetSession() string {
@@ -159,17 +259,19 @@ func (x *AuthToken) GetAccess() string {
 	return ""
 }
 
+// An empty locale falls back to the default one
 type SignUpRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignUpRequest) Reset() {
 	*x = SignUpRequest{}
-	mi := &file_v1_auth_api_proto_msgTypes[2]
+	mi := &file_v1_auth_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -181,7 +283,7 @@ func (x *SignUpRequest) String() string {
 func (*SignUpRequest) ProtoMessage() {}
 
 func (x *SignUpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[2]
+	mi := &file_v1_auth_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +296,7 @@ func (x *SignUpRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignUpRequest.ProtoReflect.Descriptor instead.
 func (*SignUpRequest) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{2}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SignUpRequest) GetEmail() string {
@@ -211,6 +313,13 @@ func (x *SignUpRequest) GetPassword() string {
 	return ""
 }
 
+func (x *SignUpRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SignUpResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         *AuthToken             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -221,7 +330,7 @@ type SignUpResponse struct {
 
 func (x *SignUpResponse) Reset() {
 	*x = SignUpResponse{}
-	mi := &file_v1_auth_api_proto_msgTypes[3]
+	mi := &file_v1_auth_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +342,7 @@ func (x *SignUpResponse) String() string {
 func (*SignUpResponse) ProtoMessage() {}
 
 func (x *SignUpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[3]
+	mi := &file_v1_auth_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +355,7 @@ func (x *SignUpResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignUpResponse.ProtoReflect.Descriptor instead.
 func (*SignUpResponse) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{3}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SignUpResponse) GetToken() *AuthToken {
@@ -273,7 +382,7 @@ type SignInRequest struct {
 
 func (x *SignInRequest) Reset() {
 	*x = SignInRequest{}
-	mi := &file_v1_auth_api_proto_msgTypes[4]
+	mi := &file_v1_auth_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +394,7 @@ func (x *SignInRequest) String() string {
 func (*SignInRequest) ProtoMessage() {}
 
 func (x *SignInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[4]
+	mi := &file_v1_auth_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +407,7 @@ func (x *SignInRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignInRequest.ProtoReflect.Descriptor instead.
 func (*SignInRequest) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{4}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SignInRequest) GetEmail() string {
@@ -325,7 +434,7 @@ type SignInResponse struct {
 
 func (x *SignInResponse) Reset() {
 	*x = SignInResponse{}
-	mi := &file_v1_auth_api_proto_msgTypes[5]
+	mi := &file_v1_auth_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +446,7 @@ func (x *SignInResponse) String() string {
 func (*SignInResponse) ProtoMessage() {}
 
 func (x *SignInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[5]
+	mi := &file_v1_auth_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +459,7 @@ func (x *SignInResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignInResponse.ProtoReflect.Descriptor instead.
 func (*SignInResponse) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{5}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SignInResponse) GetToken() *AuthToken {
@@ -376,7 +485,7 @@ type SignOutRequest struct {
 
 func (x *SignOutRequest) Reset() {
 	*x = SignOutRequest{}
-	mi := &file_v1_auth_api_proto_msgTypes[6]
+	mi := &file_v1_auth_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +497,7 @@ func (x *SignOutRequest) String() string {
 func (*SignOutRequest) ProtoMessage() {}
 
 func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[6]
+	mi := &file_v1_auth_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +510,7 @@ func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
 func (*SignOutRequest) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{6}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SignOutRequest) GetSession() string {
@@ -420,7 +529,7 @@ type EmailAvailabilityRequest struct {
 
 func (x *EmailAvailabilityRequest) Reset() {
 	*x = EmailAvailabilityRequest{}
-	mi := &file_v1_auth_api_proto_msgTypes[7]
+	mi := &file_v1_auth_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +541,7 @@ func (x *EmailAvailabilityRequest) String() string {
 func (*EmailAvailabilityRequest) ProtoMessage() {}
 
 func (x *EmailAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[7]
+	mi := &file_v1_auth_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +554,7 @@ func (x *EmailAvailabilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmailAvailabilityRequest.ProtoReflect.Descriptor instead.
 func (*EmailAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{7}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EmailAvailabilityRequest) GetEmail() string {
@@ -464,7 +573,7 @@ type EmailAvailabilityResponse struct {
 
 func (x *EmailAvailabilityResponse) Reset() {
 	*x = EmailAvailabilityResponse{}
-	mi := &file_v1_auth_api_proto_msgTypes[8]
+	mi := &file_v1_auth_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +585,7 @@ func (x *EmailAvailabilityResponse) String() string {
 func (*EmailAvailabilityResponse) ProtoMessage() {}
 
 func (x *EmailAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_auth_api_proto_msgTypes[8]
+	mi := &file_v1_auth_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +598,7 @@ func (x *EmailAvailabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmailAvailabilityResponse.ProtoReflect.Descriptor instead.
 func (*EmailAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_v1_auth_api_proto_rawDescGZIP(), []int{8}
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EmailAvailabilityResponse) GetIsAvailable() bool {
@@ -499,11 +608,484 @@ func (x *EmailAvailabilityResponse) GetIsAvailable() bool {
 	return false
 }
 
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_v1_auth_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAccountRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledAt   *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_v1_auth_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteAccountResponse) GetScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	mi := &file_v1_auth_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelAccountDeletionRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type UpdateLocaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocaleRequest) Reset() {
+	*x = UpdateLocaleRequest{}
+	mi := &file_v1_auth_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocaleRequest) ProtoMessage() {}
+
+func (x *UpdateLocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocaleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocaleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateLocaleRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *UpdateLocaleRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type UpdateLocaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auth          *Auth                  `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocaleResponse) Reset() {
+	*x = UpdateLocaleResponse{}
+	mi := &file_v1_auth_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocaleResponse) ProtoMessage() {}
+
+func (x *UpdateLocaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocaleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocaleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateLocaleResponse) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type SecureAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecureAccountRequest) Reset() {
+	*x = SecureAccountRequest{}
+	mi := &file_v1_auth_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecureAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecureAccountRequest) ProtoMessage() {}
+
+func (x *SecureAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecureAccountRequest.ProtoReflect.Descriptor instead.
+func (*SecureAccountRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SecureAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// The reset token lets the holder of the alert link choose a new password
+type SecureAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecureAccountResponse) Reset() {
+	*x = SecureAccountResponse{}
+	mi := &file_v1_auth_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecureAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecureAccountResponse) ProtoMessage() {}
+
+func (x *SecureAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecureAccountResponse.ProtoReflect.Descriptor instead.
+func (*SecureAccountResponse) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SecureAccountResponse) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_v1_auth_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ExportAuthDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuthDataRequest) Reset() {
+	*x = ExportAuthDataRequest{}
+	mi := &file_v1_auth_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuthDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuthDataRequest) ProtoMessage() {}
+
+func (x *ExportAuthDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuthDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuthDataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportAuthDataRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type ExportAuthDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auth          *Auth                  `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	Sessions      []*Session             `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuthDataResponse) Reset() {
+	*x = ExportAuthDataResponse{}
+	mi := &file_v1_auth_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuthDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuthDataResponse) ProtoMessage() {}
+
+func (x *ExportAuthDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuthDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuthDataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_auth_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportAuthDataResponse) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *ExportAuthDataResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
 var File_v1_auth_api_proto protoreflect.FileDescriptor
 
 const file_v1_auth_api_proto_rawDesc = "" +
 	"\n" +
-	"\x11v1/auth_api.proto\x12\aauth.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x01\n" +
+	"\x11v1/auth_api.proto\x12\aauth.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x02\n" +
 	"\x04Auth\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
@@ -513,13 +1095,28 @@ const file_v1_auth_api_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"=\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12N\n" +
+	"\x15deletion_scheduled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x13deletionScheduledAt\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\"\x88\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"=\n" +
 	"\tAuthToken\x12\x18\n" +
 	"\asession\x18\x01 \x01(\tR\asession\x12\x16\n" +
-	"\x06access\x18\x02 \x01(\tR\x06access\"A\n" +
+	"\x06access\x18\x02 \x01(\tR\x06access\"Y\n" +
 	"\rSignUpRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"]\n" +
 	"\x0eSignUpResponse\x12(\n" +
 	"\x05token\x18\x01 \x01(\v2\x12.auth.v1.AuthTokenR\x05token\x12!\n" +
 	"\x04auth\x18\x02 \x01(\v2\r.auth.v1.AuthR\x04auth\"A\n" +
@@ -534,12 +1131,43 @@ const file_v1_auth_api_proto_rawDesc = "" +
 	"\x18EmailAvailabilityRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\">\n" +
 	"\x19EmailAvailabilityResponse\x12!\n" +
-	"\fis_available\x18\x01 \x01(\bR\visAvailable2\x9a\x02\n" +
+	"\fis_available\x18\x01 \x01(\bR\visAvailable\"K\n" +
+	"\x14DeleteAccountRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"V\n" +
+	"\x15DeleteAccountResponse\x12=\n" +
+	"\fscheduled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"7\n" +
+	"\x1cCancelAccountDeletionRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"F\n" +
+	"\x13UpdateLocaleRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"9\n" +
+	"\x14UpdateLocaleResponse\x12!\n" +
+	"\x04auth\x18\x01 \x01(\v2\r.auth.v1.AuthR\x04auth\",\n" +
+	"\x14SecureAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"8\n" +
+	"\x15SecureAccountResponse\x12\x1f\n" +
+	"\vreset_token\x18\x01 \x01(\tR\n" +
+	"resetToken\"H\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"0\n" +
+	"\x15ExportAuthDataRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"i\n" +
+	"\x16ExportAuthDataResponse\x12!\n" +
+	"\x04auth\x18\x01 \x01(\v2\r.auth.v1.AuthR\x04auth\x12,\n" +
+	"\bsessions\x18\x02 \x03(\v2\x10.auth.v1.SessionR\bsessions2\xfa\x05\n" +
 	"\vAuthService\x129\n" +
 	"\x06SignUp\x12\x16.auth.v1.SignUpRequest\x1a\x17.auth.v1.SignUpResponse\x129\n" +
 	"\x06SignIn\x12\x16.auth.v1.SignInRequest\x1a\x17.auth.v1.SignInResponse\x12:\n" +
 	"\aSignOut\x12\x17.auth.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
-	"\x10IsEmailAvailable\x12!.auth.v1.EmailAvailabilityRequest\x1a\".auth.v1.EmailAvailabilityResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+	"\x10IsEmailAvailable\x12!.auth.v1.EmailAvailabilityRequest\x1a\".auth.v1.EmailAvailabilityResponse\x12N\n" +
+	"\rDeleteAccount\x12\x1d.auth.v1.DeleteAccountRequest\x1a\x1e.auth.v1.DeleteAccountResponse\x12V\n" +
+	"\x15CancelAccountDeletion\x12%.auth.v1.CancelAccountDeletionRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\fUpdateLocale\x12\x1c.auth.v1.UpdateLocaleRequest\x1a\x1d.auth.v1.UpdateLocaleResponse\x12Q\n" +
+	"\x0eExportAuthData\x12\x1e.auth.v1.ExportAuthDataRequest\x1a\x1f.auth.v1.ExportAuthDataResponse\x12N\n" +
+	"\rSecureAccount\x12\x1d.auth.v1.SecureAccountRequest\x1a\x1e.auth.v1.SecureAccountResponse\x12F\n" +
+	"\rResetPassword\x12\x1d.auth.v1.ResetPasswordRequest\x1a\x16.google.protobuf.EmptyB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
 
 var (
 	file_v1_auth_api_proto_rawDescOnce sync.Once
@@ -553,40 +1181,71 @@ func file_v1_auth_api_proto_rawDescGZIP() []byte {
 	return file_v1_auth_api_proto_rawDescData
 }
 
-var file_v1_auth_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_auth_api_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_v1_auth_api_proto_goTypes = []any{
-	(*Auth)(nil),                      // 0: auth.v1.Auth
-	(*AuthToken)(nil),                 // 1: auth.v1.AuthToken
-	(*SignUpRequest)(nil),             // 2: auth.v1.SignUpRequest
-	(*SignUpResponse)(nil),            // 3: auth.v1.SignUpResponse
-	(*SignInRequest)(nil),             // 4: auth.v1.SignInRequest
-	(*SignInResponse)(nil),            // 5: auth.v1.SignInResponse
-	(*SignOutRequest)(nil),            // 6: auth.v1.SignOutRequest
-	(*EmailAvailabilityRequest)(nil),  // 7: auth.v1.EmailAvailabilityRequest
-	(*EmailAvailabilityResponse)(nil), // 8: auth.v1.EmailAvailabilityResponse
-	(*timestamp.Timestamp)(nil),       // 9: google.protobuf.Timestamp
-	(*empty.Empty)(nil),               // 10: google.protobuf.Empty
+	(*Auth)(nil),                         // 0: auth.v1.Auth
+	(*Session)(nil),                      // 1: auth.v1.Session
+	(*AuthToken)(nil),                    // 2: auth.v1.AuthToken
+	(*SignUpRequest)(nil),                // 3: auth.v1.SignUpRequest
+	(*SignUpResponse)(nil),               // 4: auth.v1.SignUpResponse
+	(*SignInRequest)(nil),                // 5: auth.v1.SignInRequest
+	(*SignInResponse)(nil),               // 6: auth.v1.SignInResponse
+	(*SignOutRequest)(nil),               // 7: auth.v1.SignOutRequest
+	(*EmailAvailabilityRequest)(nil),     // 8: auth.v1.EmailAvailabilityRequest
+	(*EmailAvailabilityResponse)(nil),    // 9: auth.v1.EmailAvailabilityResponse
+	(*DeleteAccountRequest)(nil),         // 10: auth.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),        // 11: auth.v1.DeleteAccountResponse
+	(*CancelAccountDeletionRequest)(nil), // 12: auth.v1.CancelAccountDeletionRequest
+	(*UpdateLocaleRequest)(nil),          // 13: auth.v1.UpdateLocaleRequest
+	(*UpdateLocaleResponse)(nil),         // 14: auth.v1.UpdateLocaleResponse
+	(*SecureAccountRequest)(nil),         // 15: auth.v1.SecureAccountRequest
+	(*SecureAccountResponse)(nil),        // 16: auth.v1.SecureAccountResponse
+	(*ResetPasswordRequest)(nil),         // 17: auth.v1.ResetPasswordRequest
+	(*ExportAuthDataRequest)(nil),        // 18: auth.v1.ExportAuthDataRequest
+	(*ExportAuthDataResponse)(nil),       // 19: auth.v1.ExportAuthDataResponse
+	(*timestamp.Timestamp)(nil),          // 20: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                  // 21: google.protobuf.Empty
 }
 var file_v1_auth_api_proto_depIdxs = []int32{
-	9,  // 0: auth.v1.Auth.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 1: auth.v1.Auth.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: auth.v1.SignUpResponse.token:type_name -> auth.v1.AuthToken
-	0,  // 3: auth.v1.SignUpResponse.auth:type_name -> auth.v1.Auth
-	1,  // 4: auth.v1.SignInResponse.token:type_name -> auth.v1.AuthToken
-	0,  // 5: auth.v1.SignInResponse.auth:type_name -> auth.v1.Auth
-	2,  // 6: auth.v1.AuthService.SignUp:input_type -> auth.v1.SignUpRequest
-	4,  // 7: auth.v1.AuthService.SignIn:input_type -> auth.v1.SignInRequest
-	6,  // 8: auth.v1.AuthService.SignOut:input_type -> auth.v1.SignOutRequest
-	7,  // 9: auth.v1.AuthService.IsEmailAvailable:input_type -> auth.v1.EmailAvailabilityRequest
-	3,  // 10: auth.v1.AuthService.SignUp:output_type -> auth.v1.SignUpResponse
-	5,  // 11: auth.v1.AuthService.SignIn:output_type -> auth.v1.SignInResponse
-	10, // 12: auth.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
-	8,  // 13: auth.v1.AuthService.IsEmailAvailable:output_type -> auth.v1.EmailAvailabilityResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	20, // 0: auth.v1.Auth.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: auth.v1.Auth.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 2: auth.v1.Auth.deletion_scheduled_at:type_name -> google.protobuf.Timestamp
+	20, // 3: auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	20, // 4: auth.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 5: auth.v1.Session.revoked_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: auth.v1.SignUpResponse.token:type_name -> auth.v1.AuthToken
+	0,  // 7: auth.v1.SignUpResponse.auth:type_name -> auth.v1.Auth
+	2,  // 8: auth.v1.SignInResponse.token:type_name -> auth.v1.AuthToken
+	0,  // 9: auth.v1.SignInResponse.auth:type_name -> auth.v1.Auth
+	20, // 10: auth.v1.DeleteAccountResponse.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: auth.v1.UpdateLocaleResponse.auth:type_name -> auth.v1.Auth
+	0,  // 12: auth.v1.ExportAuthDataResponse.auth:type_name -> auth.v1.Auth
+	1,  // 13: auth.v1.ExportAuthDataResponse.sessions:type_name -> auth.v1.Session
+	3,  // 14: auth.v1.AuthService.SignUp:input_type -> auth.v1.SignUpRequest
+	5,  // 15: auth.v1.AuthService.SignIn:input_type -> auth.v1.SignInRequest
+	7,  // 16: auth.v1.AuthService.SignOut:input_type -> auth.v1.SignOutRequest
+	8,  // 17: auth.v1.AuthService.IsEmailAvailable:input_type -> auth.v1.EmailAvailabilityRequest
+	10, // 18: auth.v1.AuthService.DeleteAccount:input_type -> auth.v1.DeleteAccountRequest
+	12, // 19: auth.v1.AuthService.CancelAccountDeletion:input_type -> auth.v1.CancelAccountDeletionRequest
+	13, // 20: auth.v1.AuthService.UpdateLocale:input_type -> auth.v1.UpdateLocaleRequest
+	18, // 21: auth.v1.AuthService.ExportAuthData:input_type -> auth.v1.ExportAuthDataRequest
+	15, // 22: auth.v1.AuthService.SecureAccount:input_type -> auth.v1.SecureAccountRequest
+	17, // 23: auth.v1.AuthService.ResetPassword:input_type -> auth.v1.ResetPasswordRequest
+	4,  // 24: auth.v1.AuthService.SignUp:output_type -> auth.v1.SignUpResponse
+	6,  // 25: auth.v1.AuthService.SignIn:output_type -> auth.v1.SignInResponse
+	21, // 26: auth.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
+	9,  // 27: auth.v1.AuthService.IsEmailAvailable:output_type -> auth.v1.EmailAvailabilityResponse
+	11, // 28: auth.v1.AuthService.DeleteAccount:output_type -> auth.v1.DeleteAccountResponse
+	21, // 29: auth.v1.AuthService.CancelAccountDeletion:output_type -> google.protobuf.Empty
+	14, // 30: auth.v1.AuthService.UpdateLocale:output_type -> auth.v1.UpdateLocaleResponse
+	19, // 31: auth.v1.AuthService.ExportAuthData:output_type -> auth.v1.ExportAuthDataResponse
+	16, // 32: auth.v1.AuthService.SecureAccount:output_type -> auth.v1.SecureAccountResponse
+	21, // 33: auth.v1.AuthService.ResetPassword:output_type -> google.protobuf.Empty
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_v1_auth_api_proto_init() }
@@ -600,7 +1259,7 @@ func file_v1_auth_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_auth_api_proto_rawDesc), len(file_v1_auth_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
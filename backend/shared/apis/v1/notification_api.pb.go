@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/notification_api.proto
+
+package apis
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ProcessedAt   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	CompletedAt   *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationRecord) Reset() {
+	*x = NotificationRecord{}
+	mi := &file_v1_notification_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRecord) ProtoMessage() {}
+
+func (x *NotificationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRecord.ProtoReflect.Descriptor instead.
+func (*NotificationRecord) Descriptor() ([]byte, []int) {
+	return file_v1_notification_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationRecord) GetProcessedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+func (x *NotificationRecord) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ListNotificationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationHistoryRequest) Reset() {
+	*x = ListNotificationHistoryRequest{}
+	mi := &file_v1_notification_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationHistoryRequest) ProtoMessage() {}
+
+func (x *ListNotificationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotificationHistoryRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type ListNotificationHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*NotificationRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationHistoryResponse) Reset() {
+	*x = ListNotificationHistoryResponse{}
+	mi := &file_v1_notification_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationHistoryResponse) ProtoMessage() {}
+
+func (x *ListNotificationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationHistoryResponse) GetRecords() []*NotificationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+var File_v1_notification_api_proto protoreflect.FileDescriptor
+
+const file_v1_notification_api_proto_rawDesc = "" +
+	"\n" +
+	"\x19v1/notification_api.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x01\n" +
+	"\x12NotificationRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12=\n" +
+	"\fprocessed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x12=\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"9\n" +
+	"\x1eListNotificationHistoryRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"`\n" +
+	"\x1fListNotificationHistoryResponse\x12=\n" +
+	"\arecords\x18\x01 \x03(\v2#.notification.v1.NotificationRecordR\arecords2\x93\x01\n" +
+	"\x13NotificationService\x12|\n" +
+	"\x17ListNotificationHistory\x12/.notification.v1.ListNotificationHistoryRequest\x1a0.notification.v1.ListNotificationHistoryResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+
+var (
+	file_v1_notification_api_proto_rawDescOnce sync.Once
+	file_v1_notification_api_proto_rawDescData []byte
+)
+
+func file_v1_notification_api_proto_rawDescGZIP() []byte {
+	file_v1_notification_api_proto_rawDescOnce.Do(func() {
+		file_v1_notification_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_notification_api_proto_rawDesc), len(file_v1_notification_api_proto_rawDesc)))
+	})
+	return file_v1_notification_api_proto_rawDescData
+}
+
+var file_v1_notification_api_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v1_notification_api_proto_goTypes = []any{
+	(*NotificationRecord)(nil),              // 0: notification.v1.NotificationRecord
+	(*ListNotificationHistoryRequest)(nil),  // 1: notification.v1.ListNotificationHistoryRequest
+	(*ListNotificationHistoryResponse)(nil), // 2: notification.v1.ListNotificationHistoryResponse
+	(*timestamp.Timestamp)(nil),             // 3: google.protobuf.Timestamp
+}
+var file_v1_notification_api_proto_depIdxs = []int32{
+	3, // 0: notification.v1.NotificationRecord.processed_at:type_name -> google.protobuf.Timestamp
+	3, // 1: notification.v1.NotificationRecord.completed_at:type_name -> google.protobuf.Timestamp
+	0, // 2: notification.v1.ListNotificationHistoryResponse.records:type_name -> notification.v1.NotificationRecord
+	1, // 3: notification.v1.NotificationService.ListNotificationHistory:input_type -> notification.v1.ListNotificationHistoryRequest
+	2, // 4: notification.v1.NotificationService.ListNotificationHistory:output_type -> notification.v1.ListNotificationHistoryResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_api_proto_init() }
+func file_v1_notification_api_proto_init() {
+	if File_v1_notification_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_notification_api_proto_rawDesc), len(file_v1_notification_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_notification_api_proto_goTypes,
+		DependencyIndexes: file_v1_notification_api_proto_depIdxs,
+		MessageInfos:      file_v1_notification_api_proto_msgTypes,
+	}.Build()
+	File_v1_notification_api_proto = out.File
+	file_v1_notification_api_proto_goTypes = nil
+	file_v1_notification_api_proto_depIdxs = nil
+}
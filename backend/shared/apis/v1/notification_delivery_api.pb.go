@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/notification_delivery_api.proto
+
+package apis
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Status is one of "queued", "sent", "failed", "bounced", or "complained"
+type Delivery struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId           string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Channel           string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Template          string                 `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Recipient         string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	ProviderMessageId *wrappers.StringValue  `protobuf:"bytes,6,opt,name=provider_message_id,json=providerMessageId,proto3" json:"provider_message_id,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts          int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Error             *wrappers.StringValue  `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt         *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_v1_notification_delivery_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Delivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Delivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Delivery) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Delivery) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Delivery) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Delivery) GetProviderMessageId() *wrappers.StringValue {
+	if x != nil {
+		return x.ProviderMessageId
+	}
+	return nil
+}
+
+func (x *Delivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Delivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Delivery) GetError() *wrappers.StringValue {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Delivery) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Filters take "channel" and "status"
+type ListDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_delivery_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDeliveriesRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*Delivery            `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Page          *PageInfo              `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesResponse) Reset() {
+	*x = ListDeliveriesResponse{}
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_delivery_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDeliveriesResponse) GetDeliveries() []*Delivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListDeliveriesResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Type is either "bounce" or "complaint", hard bounces and complaints suppress the recipient
+type ReportDeliveryEventRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProviderMessageId string                 `protobuf:"bytes,1,opt,name=provider_message_id,json=providerMessageId,proto3" json:"provider_message_id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	BounceType        string                 `protobuf:"bytes,3,opt,name=bounce_type,json=bounceType,proto3" json:"bounce_type,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReportDeliveryEventRequest) Reset() {
+	*x = ReportDeliveryEventRequest{}
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDeliveryEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDeliveryEventRequest) ProtoMessage() {}
+
+func (x *ReportDeliveryEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDeliveryEventRequest.ProtoReflect.Descriptor instead.
+func (*ReportDeliveryEventRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_delivery_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportDeliveryEventRequest) GetProviderMessageId() string {
+	if x != nil {
+		return x.ProviderMessageId
+	}
+	return ""
+}
+
+func (x *ReportDeliveryEventRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReportDeliveryEventRequest) GetBounceType() string {
+	if x != nil {
+		return x.BounceType
+	}
+	return ""
+}
+
+func (x *ReportDeliveryEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportDeliveryEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *Delivery              `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDeliveryEventResponse) Reset() {
+	*x = ReportDeliveryEventResponse{}
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDeliveryEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDeliveryEventResponse) ProtoMessage() {}
+
+func (x *ReportDeliveryEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_delivery_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDeliveryEventResponse.ProtoReflect.Descriptor instead.
+func (*ReportDeliveryEventResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_delivery_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReportDeliveryEventResponse) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+var File_v1_notification_delivery_api_proto protoreflect.FileDescriptor
+
+const file_v1_notification_delivery_api_proto_rawDesc = "" +
+	"\n" +
+	"\"v1/notification_delivery_api.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17v1/pagination_api.proto\"\xb5\x03\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1a\n" +
+	"\btemplate\x18\x04 \x01(\tR\btemplate\x12\x1c\n" +
+	"\trecipient\x18\x05 \x01(\tR\trecipient\x12L\n" +
+	"\x13provider_message_id\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x11providerMessageId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x122\n" +
+	"\x05error\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\\\n" +
+	"\x15ListDeliveriesRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.common.v1.PageRequestR\x04page\"|\n" +
+	"\x16ListDeliveriesResponse\x129\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x19.notification.v1.DeliveryR\n" +
+	"deliveries\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\x04page\"\x99\x01\n" +
+	"\x1aReportDeliveryEventRequest\x12.\n" +
+	"\x13provider_message_id\x18\x01 \x01(\tR\x11providerMessageId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1f\n" +
+	"\vbounce_type\x18\x03 \x01(\tR\n" +
+	"bounceType\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"T\n" +
+	"\x1bReportDeliveryEventResponse\x125\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x19.notification.v1.DeliveryR\bdelivery2\xf2\x01\n" +
+	"\x1bNotificationDeliveryService\x12a\n" +
+	"\x0eListDeliveries\x12&.notification.v1.ListDeliveriesRequest\x1a'.notification.v1.ListDeliveriesResponse\x12p\n" +
+	"\x13ReportDeliveryEvent\x12+.notification.v1.ReportDeliveryEventRequest\x1a,.notification.v1.ReportDeliveryEventResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+
+var (
+	file_v1_notification_delivery_api_proto_rawDescOnce sync.Once
+	file_v1_notification_delivery_api_proto_rawDescData []byte
+)
+
+func file_v1_notification_delivery_api_proto_rawDescGZIP() []byte {
+	file_v1_notification_delivery_api_proto_rawDescOnce.Do(func() {
+		file_v1_notification_delivery_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_notification_delivery_api_proto_rawDesc), len(file_v1_notification_delivery_api_proto_rawDesc)))
+	})
+	return file_v1_notification_delivery_api_proto_rawDescData
+}
+
+var file_v1_notification_delivery_api_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_notification_delivery_api_proto_goTypes = []any{
+	(*Delivery)(nil),                    // 0: notification.v1.Delivery
+	(*ListDeliveriesRequest)(nil),       // 1: notification.v1.ListDeliveriesRequest
+	(*ListDeliveriesResponse)(nil),      // 2: notification.v1.ListDeliveriesResponse
+	(*ReportDeliveryEventRequest)(nil),  // 3: notification.v1.ReportDeliveryEventRequest
+	(*ReportDeliveryEventResponse)(nil), // 4: notification.v1.ReportDeliveryEventResponse
+	(*wrappers.StringValue)(nil),        // 5: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),         // 6: google.protobuf.Timestamp
+	(*PageRequest)(nil),                 // 7: common.v1.PageRequest
+	(*PageInfo)(nil),                    // 8: common.v1.PageInfo
+}
+var file_v1_notification_delivery_api_proto_depIdxs = []int32{
+	5,  // 0: notification.v1.Delivery.provider_message_id:type_name -> google.protobuf.StringValue
+	5,  // 1: notification.v1.Delivery.error:type_name -> google.protobuf.StringValue
+	6,  // 2: notification.v1.Delivery.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: notification.v1.Delivery.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: notification.v1.ListDeliveriesRequest.page:type_name -> common.v1.PageRequest
+	0,  // 5: notification.v1.ListDeliveriesResponse.deliveries:type_name -> notification.v1.Delivery
+	8,  // 6: notification.v1.ListDeliveriesResponse.page:type_name -> common.v1.PageInfo
+	0,  // 7: notification.v1.ReportDeliveryEventResponse.delivery:type_name -> notification.v1.Delivery
+	1,  // 8: notification.v1.NotificationDeliveryService.ListDeliveries:input_type -> notification.v1.ListDeliveriesRequest
+	3,  // 9: notification.v1.NotificationDeliveryService.ReportDeliveryEvent:input_type -> notification.v1.ReportDeliveryEventRequest
+	2,  // 10: notification.v1.NotificationDeliveryService.ListDeliveries:output_type -> notification.v1.ListDeliveriesResponse
+	4,  // 11: notification.v1.NotificationDeliveryService.ReportDeliveryEvent:output_type -> notification.v1.ReportDeliveryEventResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_delivery_api_proto_init() }
+func file_v1_notification_delivery_api_proto_init() {
+	if File_v1_notification_delivery_api_proto != nil {
+		return
+	}
+	file_v1_pagination_api_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_notification_delivery_api_proto_rawDesc), len(file_v1_notification_delivery_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_notification_delivery_api_proto_goTypes,
+		DependencyIndexes: file_v1_notification_delivery_api_proto_depIdxs,
+		MessageInfos:      file_v1_notification_delivery_api_proto_msgTypes,
+	}.Build()
+	File_v1_notification_delivery_api_proto = out.File
+	file_v1_notification_delivery_api_proto_goTypes = nil
+	file_v1_notification_delivery_api_proto_depIdxs = nil
+}
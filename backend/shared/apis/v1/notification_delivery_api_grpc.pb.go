@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.12.4
+// source: v1/notification_delivery_api.proto
+
+package apis
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationDeliveryService_ListDeliveries_FullMethodName      = "/notification.v1.NotificationDeliveryService/ListDeliveries"
+	NotificationDeliveryService_ReportDeliveryEvent_FullMethodName = "/notification.v1.NotificationDeliveryService/ReportDeliveryEvent"
+)
+
+// NotificationDeliveryServiceClient is the client API for NotificationDeliveryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationDeliveryServiceClient interface {
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error)
+	ReportDeliveryEvent(ctx context.Context, in *ReportDeliveryEventRequest, opts ...grpc.CallOption) (*ReportDeliveryEventResponse, error)
+}
+
+type notificationDeliveryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationDeliveryServiceClient(cc grpc.ClientConnInterface) NotificationDeliveryServiceClient {
+	return &notificationDeliveryServiceClient{cc}
+}
+
+func (c *notificationDeliveryServiceClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveriesResponse)
+	err := c.cc.Invoke(ctx, NotificationDeliveryService_ListDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationDeliveryServiceClient) ReportDeliveryEvent(ctx context.Context, in *ReportDeliveryEventRequest, opts ...grpc.CallOption) (*ReportDeliveryEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDeliveryEventResponse)
+	err := c.cc.Invoke(ctx, NotificationDeliveryService_ReportDeliveryEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationDeliveryServiceServer is the server API for NotificationDeliveryService service.
+// All implementations must embed UnimplementedNotificationDeliveryServiceServer
+// for forward compatibility.
+type NotificationDeliveryServiceServer interface {
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
+	ReportDeliveryEvent(context.Context, *ReportDeliveryEventRequest) (*ReportDeliveryEventResponse, error)
+	mustEmbedUnimplementedNotificationDeliveryServiceServer()
+}
+
+// UnimplementedNotificationDeliveryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationDeliveryServiceServer struct{}
+
+func (UnimplementedNotificationDeliveryServiceServer) ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (UnimplementedNotificationDeliveryServiceServer) ReportDeliveryEvent(context.Context, *ReportDeliveryEventRequest) (*ReportDeliveryEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDeliveryEvent not implemented")
+}
+func (UnimplementedNotificationDeliveryServiceServer) mustEmbedUnimplementedNotificationDeliveryServiceServer() {
+}
+func (UnimplementedNotificationDeliveryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationDeliveryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationDeliveryServiceServer will
+// result in compilation errors.
+type UnsafeNotificationDeliveryServiceServer interface {
+	mustEmbedUnimplementedNotificationDeliveryServiceServer()
+}
+
+func RegisterNotificationDeliveryServiceServer(s grpc.ServiceRegistrar, srv NotificationDeliveryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationDeliveryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationDeliveryService_ServiceDesc, srv)
+}
+
+func _NotificationDeliveryService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationDeliveryServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationDeliveryService_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationDeliveryServiceServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationDeliveryService_ReportDeliveryEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDeliveryEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationDeliveryServiceServer).ReportDeliveryEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationDeliveryService_ReportDeliveryEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationDeliveryServiceServer).ReportDeliveryEvent(ctx, req.(*ReportDeliveryEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationDeliveryService_ServiceDesc is the grpc.ServiceDesc for NotificationDeliveryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationDeliveryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.NotificationDeliveryService",
+	HandlerType: (*NotificationDeliveryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _NotificationDeliveryService_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "ReportDeliveryEvent",
+			Handler:    _NotificationDeliveryService_ReportDeliveryEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/notification_delivery_api.proto",
+}
@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/notification_inbox_api.proto
+
+package apis
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InboxNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Url           *wrappers.StringValue  `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	ArchivedAt    *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxNotification) Reset() {
+	*x = InboxNotification{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxNotification) ProtoMessage() {}
+
+func (x *InboxNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxNotification.ProtoReflect.Descriptor instead.
+func (*InboxNotification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InboxNotification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboxNotification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InboxNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *InboxNotification) GetUrl() *wrappers.StringValue {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *InboxNotification) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InboxNotification) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InboxNotification) GetReadAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+func (x *InboxNotification) GetArchivedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+// Filters take "status" (unread, read, or archived) and "type", archived notifications are only listed on request
+type ListInboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxRequest) Reset() {
+	*x = ListInboxRequest{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxRequest) ProtoMessage() {}
+
+func (x *ListInboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListInboxRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *ListInboxRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListInboxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*InboxNotification   `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Page          *PageInfo              `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxResponse) Reset() {
+	*x = ListInboxResponse{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxResponse) ProtoMessage() {}
+
+func (x *ListInboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInboxResponse) GetNotifications() []*InboxNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListInboxResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUnreadCountRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type GetUnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	ByType        map[string]int64       `protobuf:"bytes,2,rep,name=by_type,json=byType,proto3" json:"by_type,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUnreadCountResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUnreadCountResponse) GetByType() map[string]int64 {
+	if x != nil {
+		return x.ByType
+	}
+	return nil
+}
+
+type MarkNotificationReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthId         int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	NotificationId int64                  `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadRequest) Reset() {
+	*x = MarkNotificationReadRequest{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarkNotificationReadRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *MarkNotificationReadRequest) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+type MarkNotificationReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *InboxNotification     `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadResponse) Reset() {
+	*x = MarkNotificationReadResponse{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkNotificationReadResponse) GetNotification() *InboxNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+type MarkAllNotificationsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllNotificationsReadRequest) Reset() {
+	*x = MarkAllNotificationsReadRequest{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarkAllNotificationsReadRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type MarkAllNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int64                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllNotificationsReadResponse) Reset() {
+	*x = MarkAllNotificationsReadResponse{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkAllNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MarkAllNotificationsReadResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type ArchiveNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthId         int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	NotificationId int64                  `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchiveNotificationRequest) Reset() {
+	*x = ArchiveNotificationRequest{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNotificationRequest) ProtoMessage() {}
+
+func (x *ArchiveNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchiveNotificationRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *ArchiveNotificationRequest) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+type ArchiveNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *InboxNotification     `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveNotificationResponse) Reset() {
+	*x = ArchiveNotificationResponse{}
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveNotificationResponse) ProtoMessage() {}
+
+func (x *ArchiveNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_inbox_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_inbox_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ArchiveNotificationResponse) GetNotification() *InboxNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+var File_v1_notification_inbox_api_proto protoreflect.FileDescriptor
+
+const file_v1_notification_inbox_api_proto_rawDesc = "" +
+	"\n" +
+	"\x1fv1/notification_inbox_api.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17v1/pagination_api.proto\"\xb9\x03\n" +
+	"\x11InboxNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12.\n" +
+	"\x03url\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x03url\x12@\n" +
+	"\x04data\x18\x06 \x03(\v2,.notification.v1.InboxNotification.DataEntryR\x04data\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\aread_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\x12;\n" +
+	"\varchived_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\x10ListInboxRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.common.v1.PageRequestR\x04page\"\x86\x01\n" +
+	"\x11ListInboxResponse\x12H\n" +
+	"\rnotifications\x18\x01 \x03(\v2\".notification.v1.InboxNotificationR\rnotifications\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\x04page\"0\n" +
+	"\x15GetUnreadCountRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"\xb7\x01\n" +
+	"\x16GetUnreadCountResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12L\n" +
+	"\aby_type\x18\x02 \x03(\v23.notification.v1.GetUnreadCountResponse.ByTypeEntryR\x06byType\x1a9\n" +
+	"\vByTypeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"_\n" +
+	"\x1bMarkNotificationReadRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\"f\n" +
+	"\x1cMarkNotificationReadResponse\x12F\n" +
+	"\fnotification\x18\x01 \x01(\v2\".notification.v1.InboxNotificationR\fnotification\":\n" +
+	"\x1fMarkAllNotificationsReadRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"<\n" +
+	" MarkAllNotificationsReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x03R\aupdated\"^\n" +
+	"\x1aArchiveNotificationRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\"e\n" +
+	"\x1bArchiveNotificationResponse\x12F\n" +
+	"\fnotification\x18\x01 \x01(\v2\".notification.v1.InboxNotificationR\fnotification2\xb9\x04\n" +
+	"\x18NotificationInboxService\x12R\n" +
+	"\tListInbox\x12!.notification.v1.ListInboxRequest\x1a\".notification.v1.ListInboxResponse\x12a\n" +
+	"\x0eGetUnreadCount\x12&.notification.v1.GetUnreadCountRequest\x1a'.notification.v1.GetUnreadCountResponse\x12s\n" +
+	"\x14MarkNotificationRead\x12,.notification.v1.MarkNotificationReadRequest\x1a-.notification.v1.MarkNotificationReadResponse\x12\x7f\n" +
+	"\x18MarkAllNotificationsRead\x120.notification.v1.MarkAllNotificationsReadRequest\x1a1.notification.v1.MarkAllNotificationsReadResponse\x12p\n" +
+	"\x13ArchiveNotification\x12+.notification.v1.ArchiveNotificationRequest\x1a,.notification.v1.ArchiveNotificationResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+
+var (
+	file_v1_notification_inbox_api_proto_rawDescOnce sync.Once
+	file_v1_notification_inbox_api_proto_rawDescData []byte
+)
+
+func file_v1_notification_inbox_api_proto_rawDescGZIP() []byte {
+	file_v1_notification_inbox_api_proto_rawDescOnce.Do(func() {
+		file_v1_notification_inbox_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_notification_inbox_api_proto_rawDesc), len(file_v1_notification_inbox_api_proto_rawDesc)))
+	})
+	return file_v1_notification_inbox_api_proto_rawDescData
+}
+
+var file_v1_notification_inbox_api_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_v1_notification_inbox_api_proto_goTypes = []any{
+	(*InboxNotification)(nil),                // 0: notification.v1.InboxNotification
+	(*ListInboxRequest)(nil),                 // 1: notification.v1.ListInboxRequest
+	(*ListInboxResponse)(nil),                // 2: notification.v1.ListInboxResponse
+	(*GetUnreadCountRequest)(nil),            // 3: notification.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),           // 4: notification.v1.GetUnreadCountResponse
+	(*MarkNotificationReadRequest)(nil),      // 5: notification.v1.MarkNotificationReadRequest
+	(*MarkNotificationReadResponse)(nil),     // 6: notification.v1.MarkNotificationReadResponse
+	(*MarkAllNotificationsReadRequest)(nil),  // 7: notification.v1.MarkAllNotificationsReadRequest
+	(*MarkAllNotificationsReadResponse)(nil), // 8: notification.v1.MarkAllNotificationsReadResponse
+	(*ArchiveNotificationRequest)(nil),       // 9: notification.v1.ArchiveNotificationRequest
+	(*ArchiveNotificationResponse)(nil),      // 10: notification.v1.ArchiveNotificationResponse
+	nil,                                      // 11: notification.v1.InboxNotification.DataEntry
+	nil,                                      // 12: notification.v1.GetUnreadCountResponse.ByTypeEntry
+	(*wrappers.StringValue)(nil),             // 13: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),              // 14: google.protobuf.Timestamp
+	(*PageRequest)(nil),                      // 15: common.v1.PageRequest
+	(*PageInfo)(nil),                         // 16: common.v1.PageInfo
+}
+var file_v1_notification_inbox_api_proto_depIdxs = []int32{
+	13, // 0: notification.v1.InboxNotification.url:type_name -> google.protobuf.StringValue
+	11, // 1: notification.v1.InboxNotification.data:type_name -> notification.v1.InboxNotification.DataEntry
+	14, // 2: notification.v1.InboxNotification.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: notification.v1.InboxNotification.read_at:type_name -> google.protobuf.Timestamp
+	14, // 4: notification.v1.InboxNotification.archived_at:type_name -> google.protobuf.Timestamp
+	15, // 5: notification.v1.ListInboxRequest.page:type_name -> common.v1.PageRequest
+	0,  // 6: notification.v1.ListInboxResponse.notifications:type_name -> notification.v1.InboxNotification
+	16, // 7: notification.v1.ListInboxResponse.page:type_name -> common.v1.PageInfo
+	12, // 8: notification.v1.GetUnreadCountResponse.by_type:type_name -> notification.v1.GetUnreadCountResponse.ByTypeEntry
+	0,  // 9: notification.v1.MarkNotificationReadResponse.notification:type_name -> notification.v1.InboxNotification
+	0,  // 10: notification.v1.ArchiveNotificationResponse.notification:type_name -> notification.v1.InboxNotification
+	1,  // 11: notification.v1.NotificationInboxService.ListInbox:input_type -> notification.v1.ListInboxRequest
+	3,  // 12: notification.v1.NotificationInboxService.GetUnreadCount:input_type -> notification.v1.GetUnreadCountRequest
+	5,  // 13: notification.v1.NotificationInboxService.MarkNotificationRead:input_type -> notification.v1.MarkNotificationReadRequest
+	7,  // 14: notification.v1.NotificationInboxService.MarkAllNotificationsRead:input_type -> notification.v1.MarkAllNotificationsReadRequest
+	9,  // 15: notification.v1.NotificationInboxService.ArchiveNotification:input_type -> notification.v1.ArchiveNotificationRequest
+	2,  // 16: notification.v1.NotificationInboxService.ListInbox:output_type -> notification.v1.ListInboxResponse
+	4,  // 17: notification.v1.NotificationInboxService.GetUnreadCount:output_type -> notification.v1.GetUnreadCountResponse
+	6,  // 18: notification.v1.NotificationInboxService.MarkNotificationRead:output_type -> notification.v1.MarkNotificationReadResponse
+	8,  // 19: notification.v1.NotificationInboxService.MarkAllNotificationsRead:output_type -> notification.v1.MarkAllNotificationsReadResponse
+	10, // 20: notification.v1.NotificationInboxService.ArchiveNotification:output_type -> notification.v1.ArchiveNotificationResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_inbox_api_proto_init() }
+func file_v1_notification_inbox_api_proto_init() {
+	if File_v1_notification_inbox_api_proto != nil {
+		return
+	}
+	file_v1_pagination_api_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_notification_inbox_api_proto_rawDesc), len(file_v1_notification_inbox_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_notification_inbox_api_proto_goTypes,
+		DependencyIndexes: file_v1_notification_inbox_api_proto_depIdxs,
+		MessageInfos:      file_v1_notification_inbox_api_proto_msgTypes,
+	}.Build()
+	File_v1_notification_inbox_api_proto = out.File
+	file_v1_notification_inbox_api_proto_goTypes = nil
+	file_v1_notification_inbox_api_proto_depIdxs = nil
+}
@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/notification_preference_api.proto
+
+package apis
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Mandatory categories are always enabled and cannot be updated
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Mandatory     bool                   `protobuf:"varint,4,opt,name=mandatory,proto3" json:"mandatory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationPreference) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationPreference) GetMandatory() bool {
+	if x != nil {
+		return x.Mandatory
+	}
+	return false
+}
+
+type PreferenceUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferenceUpdate) Reset() {
+	*x = PreferenceUpdate{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferenceUpdate) ProtoMessage() {}
+
+func (x *PreferenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferenceUpdate.ProtoReflect.Descriptor instead.
+func (*PreferenceUpdate) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreferenceUpdate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PreferenceUpdate) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PreferenceUpdate) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPreferencesRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type GetPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesResponse) ProtoMessage() {}
+
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdatePreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Preferences   []*PreferenceUpdate    `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePreferencesRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *UpdatePreferencesRequest) GetPreferences() []*PreferenceUpdate {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdatePreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesResponse) Reset() {
+	*x = UpdatePreferencesResponse{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesResponse) ProtoMessage() {}
+
+func (x *UpdatePreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Quiet hours are "HH:MM" in the time zone and are either both set or both empty, digest is "off", "daily", or "weekly"
+type NotificationSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone        string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	QuietHoursStart string                 `protobuf:"bytes,2,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string                 `protobuf:"bytes,3,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	Digest          string                 `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotificationSchedule) Reset() {
+	*x = NotificationSchedule{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSchedule) ProtoMessage() {}
+
+func (x *NotificationSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSchedule.ProtoReflect.Descriptor instead.
+func (*NotificationSchedule) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NotificationSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *NotificationSchedule) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *NotificationSchedule) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *NotificationSchedule) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type GetScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetScheduleRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type GetScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *NotificationSchedule  `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleResponse) Reset() {
+	*x = GetScheduleResponse{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleResponse) ProtoMessage() {}
+
+func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetScheduleResponse) GetSchedule() *NotificationSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Schedule      *NotificationSchedule  `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateScheduleRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetSchedule() *NotificationSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *NotificationSchedule  `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateScheduleResponse) GetSchedule() *NotificationSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnsubscribeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnsubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	mi := &file_v1_notification_preference_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_preference_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_preference_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnsubscribeResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UnsubscribeResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+var File_v1_notification_preference_api_proto protoreflect.FileDescriptor
+
+const file_v1_notification_preference_api_proto_rawDesc = "" +
+	"\n" +
+	"$v1/notification_preference_api.proto\x12\x0fnotification.v1\"\x86\x01\n" +
+	"\x16NotificationPreference\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1c\n" +
+	"\tmandatory\x18\x04 \x01(\bR\tmandatory\"b\n" +
+	"\x10PreferenceUpdate\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"0\n" +
+	"\x15GetPreferencesRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"c\n" +
+	"\x16GetPreferencesResponse\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.notification.v1.NotificationPreferenceR\vpreferences\"x\n" +
+	"\x18UpdatePreferencesRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12C\n" +
+	"\vpreferences\x18\x02 \x03(\v2!.notification.v1.PreferenceUpdateR\vpreferences\"f\n" +
+	"\x19UpdatePreferencesResponse\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.notification.v1.NotificationPreferenceR\vpreferences\"\x9f\x01\n" +
+	"\x14NotificationSchedule\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12*\n" +
+	"\x11quiet_hours_start\x18\x02 \x01(\tR\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\x03 \x01(\tR\rquietHoursEnd\x12\x16\n" +
+	"\x06digest\x18\x04 \x01(\tR\x06digest\"-\n" +
+	"\x12GetScheduleRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"X\n" +
+	"\x13GetScheduleResponse\x12A\n" +
+	"\bschedule\x18\x01 \x01(\v2%.notification.v1.NotificationScheduleR\bschedule\"s\n" +
+	"\x15UpdateScheduleRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12A\n" +
+	"\bschedule\x18\x02 \x01(\v2%.notification.v1.NotificationScheduleR\bschedule\"[\n" +
+	"\x16UpdateScheduleResponse\x12A\n" +
+	"\bschedule\x18\x01 \x01(\v2%.notification.v1.NotificationScheduleR\bschedule\"*\n" +
+	"\x12UnsubscribeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"K\n" +
+	"\x13UnsubscribeResponse\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel2\x85\x04\n" +
+	"\x1dNotificationPreferenceService\x12a\n" +
+	"\x0eGetPreferences\x12&.notification.v1.GetPreferencesRequest\x1a'.notification.v1.GetPreferencesResponse\x12j\n" +
+	"\x11UpdatePreferences\x12).notification.v1.UpdatePreferencesRequest\x1a*.notification.v1.UpdatePreferencesResponse\x12X\n" +
+	"\vUnsubscribe\x12#.notification.v1.UnsubscribeRequest\x1a$.notification.v1.UnsubscribeResponse\x12X\n" +
+	"\vGetSchedule\x12#.notification.v1.GetScheduleRequest\x1a$.notification.v1.GetScheduleResponse\x12a\n" +
+	"\x0eUpdateSchedule\x12&.notification.v1.UpdateScheduleRequest\x1a'.notification.v1.UpdateScheduleResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+
+var (
+	file_v1_notification_preference_api_proto_rawDescOnce sync.Once
+	file_v1_notification_preference_api_proto_rawDescData []byte
+)
+
+func file_v1_notification_preference_api_proto_rawDescGZIP() []byte {
+	file_v1_notification_preference_api_proto_rawDescOnce.Do(func() {
+		file_v1_notification_preference_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_notification_preference_api_proto_rawDesc), len(file_v1_notification_preference_api_proto_rawDesc)))
+	})
+	return file_v1_notification_preference_api_proto_rawDescData
+}
+
+var file_v1_notification_preference_api_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_v1_notification_preference_api_proto_goTypes = []any{
+	(*NotificationPreference)(nil),    // 0: notification.v1.NotificationPreference
+	(*PreferenceUpdate)(nil),          // 1: notification.v1.PreferenceUpdate
+	(*GetPreferencesRequest)(nil),     // 2: notification.v1.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),    // 3: notification.v1.GetPreferencesResponse
+	(*UpdatePreferencesRequest)(nil),  // 4: notification.v1.UpdatePreferencesRequest
+	(*UpdatePreferencesResponse)(nil), // 5: notification.v1.UpdatePreferencesResponse
+	(*NotificationSchedule)(nil),      // 6: notification.v1.NotificationSchedule
+	(*GetScheduleRequest)(nil),        // 7: notification.v1.GetScheduleRequest
+	(*GetScheduleResponse)(nil),       // 8: notification.v1.GetScheduleResponse
+	(*UpdateScheduleRequest)(nil),     // 9: notification.v1.UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),    // 10: notification.v1.UpdateScheduleResponse
+	(*UnsubscribeRequest)(nil),        // 11: notification.v1.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),       // 12: notification.v1.UnsubscribeResponse
+}
+var file_v1_notification_preference_api_proto_depIdxs = []int32{
+	0,  // 0: notification.v1.GetPreferencesResponse.preferences:type_name -> notification.v1.NotificationPreference
+	1,  // 1: notification.v1.UpdatePreferencesRequest.preferences:type_name -> notification.v1.PreferenceUpdate
+	0,  // 2: notification.v1.UpdatePreferencesResponse.preferences:type_name -> notification.v1.NotificationPreference
+	6,  // 3: notification.v1.GetScheduleResponse.schedule:type_name -> notification.v1.NotificationSchedule
+	6,  // 4: notification.v1.UpdateScheduleRequest.schedule:type_name -> notification.v1.NotificationSchedule
+	6,  // 5: notification.v1.UpdateScheduleResponse.schedule:type_name -> notification.v1.NotificationSchedule
+	2,  // 6: notification.v1.NotificationPreferenceService.GetPreferences:input_type -> notification.v1.GetPreferencesRequest
+	4,  // 7: notification.v1.NotificationPreferenceService.UpdatePreferences:input_type -> notification.v1.UpdatePreferencesRequest
+	11, // 8: notification.v1.NotificationPreferenceService.Unsubscribe:input_type -> notification.v1.UnsubscribeRequest
+	7,  // 9: notification.v1.NotificationPreferenceService.GetSchedule:input_type -> notification.v1.GetScheduleRequest
+	9,  // 10: notification.v1.NotificationPreferenceService.UpdateSchedule:input_type -> notification.v1.UpdateScheduleRequest
+	3,  // 11: notification.v1.NotificationPreferenceService.GetPreferences:output_type -> notification.v1.GetPreferencesResponse
+	5,  // 12: notification.v1.NotificationPreferenceService.UpdatePreferences:output_type -> notification.v1.UpdatePreferencesResponse
+	12, // 13: notification.v1.NotificationPreferenceService.Unsubscribe:output_type -> notification.v1.UnsubscribeResponse
+	8,  // 14: notification.v1.NotificationPreferenceService.GetSchedule:output_type -> notification.v1.GetScheduleResponse
+	10, // 15: notification.v1.NotificationPreferenceService.UpdateSchedule:output_type -> notification.v1.UpdateScheduleResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_preference_api_proto_init() }
+func file_v1_notification_preference_api_proto_init() {
+	if File_v1_notification_preference_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_notification_preference_api_proto_rawDesc), len(file_v1_notification_preference_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_notification_preference_api_proto_goTypes,
+		DependencyIndexes: file_v1_notification_preference_api_proto_depIdxs,
+		MessageInfos:      file_v1_notification_preference_api_proto_msgTypes,
+	}.Build()
+	File_v1_notification_preference_api_proto = out.File
+	file_v1_notification_preference_api_proto_goTypes = nil
+	file_v1_notification_preference_api_proto_depIdxs = nil
+}
@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/pagination_api.proto
+
+package apis
+
+import (
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_pagination_api_proto_enumTypes[0].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_v1_pagination_api_proto_enumTypes[0]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_v1_pagination_api_proto_rawDescGZIP(), []int{0}
+}
+
+type SortField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=common.v1.SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	mi := &file_v1_pagination_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_pagination_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_v1_pagination_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SortField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortField) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_v1_pagination_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_pagination_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_v1_pagination_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Filter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Filter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Cursor mode is used unless a page number is given, cursors are only valid for the sort they were issued with
+type PageRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Types that are valid to be assigned to Mode:
+	//
+	//	*PageRequest_Cursor
+	//	*PageRequest_Page
+	Mode          isPageRequest_Mode `protobuf_oneof:"mode"`
+	Sort          []*SortField       `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
+	Filters       []*Filter          `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_v1_pagination_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_pagination_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_pagination_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageRequest) GetMode() isPageRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *PageRequest) GetCursor() string {
+	if x != nil {
+		if x, ok := x.Mode.(*PageRequest_Cursor); ok {
+			return x.Cursor
+		}
+	}
+	return ""
+}
+
+func (x *PageRequest) GetPage() int32 {
+	if x != nil {
+		if x, ok := x.Mode.(*PageRequest_Page); ok {
+			return x.Page
+		}
+	}
+	return 0
+}
+
+func (x *PageRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *PageRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type isPageRequest_Mode interface {
+	isPageRequest_Mode()
+}
+
+type PageRequest_Cursor struct {
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3,oneof"`
+}
+
+type PageRequest_Page struct {
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3,oneof"`
+}
+
+func (*PageRequest_Cursor) isPageRequest_Mode() {}
+
+func (*PageRequest_Page) isPageRequest_Mode() {}
+
+// Total is only counted in offset mode
+type PageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Total         *wrappers.Int32Value   `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_v1_pagination_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_pagination_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_v1_pagination_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PageInfo) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageInfo) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *PageInfo) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *PageInfo) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PageInfo) GetTotal() *wrappers.Int32Value {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+var File_v1_pagination_api_proto protoreflect.FileDescriptor
+
+const file_v1_pagination_api_proto_rawDesc = "" +
+	"\n" +
+	"\x17v1/pagination_api.proto\x12\tcommon.v1\x1a\x1egoogle/protobuf/wrappers.proto\"Y\n" +
+	"\tSortField\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x126\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x18.common.v1.SortDirectionR\tdirection\"4\n" +
+	"\x06Filter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xb9\x01\n" +
+	"\vPageRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x00R\x06cursor\x12\x14\n" +
+	"\x04page\x18\x03 \x01(\x05H\x00R\x04page\x12(\n" +
+	"\x04sort\x18\x04 \x03(\v2\x14.common.v1.SortFieldR\x04sort\x12+\n" +
+	"\afilters\x18\x05 \x03(\v2\x11.common.v1.FilterR\afiltersB\x06\n" +
+	"\x04mode\"\xaa\x01\n" +
+	"\bPageInfo\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x121\n" +
+	"\x05total\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05total*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x01\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x02B?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+
+var (
+	file_v1_pagination_api_proto_rawDescOnce sync.Once
+	file_v1_pagination_api_proto_rawDescData []byte
+)
+
+func file_v1_pagination_api_proto_rawDescGZIP() []byte {
+	file_v1_pagination_api_proto_rawDescOnce.Do(func() {
+		file_v1_pagination_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_pagination_api_proto_rawDesc), len(file_v1_pagination_api_proto_rawDesc)))
+	})
+	return file_v1_pagination_api_proto_rawDescData
+}
+
+var file_v1_pagination_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_pagination_api_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_pagination_api_proto_goTypes = []any{
+	(SortDirection)(0),          // 0: common.v1.SortDirection
+	(*SortField)(nil),           // 1: common.v1.SortField
+	(*Filter)(nil),              // 2: common.v1.Filter
+	(*PageRequest)(nil),         // 3: common.v1.PageRequest
+	(*PageInfo)(nil),            // 4: common.v1.PageInfo
+	(*wrappers.Int32Value)(nil), // 5: google.protobuf.Int32Value
+}
+var file_v1_pagination_api_proto_depIdxs = []int32{
+	0, // 0: common.v1.SortField.direction:type_name -> common.v1.SortDirection
+	1, // 1: common.v1.PageRequest.sort:type_name -> common.v1.SortField
+	2, // 2: common.v1.PageRequest.filters:type_name -> common.v1.Filter
+	5, // 3: common.v1.PageInfo.total:type_name -> google.protobuf.Int32Value
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v1_pagination_api_proto_init() }
+func file_v1_pagination_api_proto_init() {
+	if File_v1_pagination_api_proto != nil {
+		return
+	}
+	file_v1_pagination_api_proto_msgTypes[2].OneofWrappers = []any{
+		(*PageRequest_Cursor)(nil),
+		(*PageRequest_Page)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_pagination_api_proto_rawDesc), len(file_v1_pagination_api_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_pagination_api_proto_goTypes,
+		DependencyIndexes: file_v1_pagination_api_proto_depIdxs,
+		EnumInfos:         file_v1_pagination_api_proto_enumTypes,
+		MessageInfos:      file_v1_pagination_api_proto_msgTypes,
+	}.Build()
+	File_v1_pagination_api_proto = out.File
+	file_v1_pagination_api_proto_goTypes = nil
+	file_v1_pagination_api_proto_depIdxs = nil
+}
@@ -406,6 +406,7 @@ func (x *CreateUserAddressResponse) GetOldPrimaryAddress() *UserAddress {
 type GetAllUserAddressesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -447,9 +448,17 @@ func (x *GetAllUserAddressesRequest) GetAuthId() int64 {
 	return 0
 }
 
+func (x *GetAllUserAddressesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 type GetAllUserAddressesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addresses     []*UserAddress         `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Page          *PageInfo              `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -491,6 +500,13 @@ func (x *GetAllUserAddressesResponse) GetAddresses() []*UserAddress {
 	return nil
 }
 
+func (x *GetAllUserAddressesResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 type UpdateUserAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
@@ -891,97 +907,978 @@ func (x *DeleteAddressResponse) GetNewPrimaryAddress() *UserAddress {
 	return nil
 }
 
-var File_v1_user_address_api_proto protoreflect.FileDescriptor
+type RestoreAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_v1_user_address_api_proto_rawDesc = "" +
-	"\n" +
-	"\x19v1/user_address_api.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xc4\x05\n" +
-	"\vUserAddress\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
-	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
-	"\x05label\x18\x04 \x01(\tR\x05label\x122\n" +
-	"\x05notes\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x12\x1d\n" +
-	"\n" +
-	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12\x18\n" +
-	"\acountry\x18\a \x01(\tR\acountry\x12A\n" +
-	"\rsubdivision_1\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
-	"\rsubdivision_2\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
-	"\rsubdivision_3\x18\n" +
-	" \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
-	"\rsubdivision_4\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x12\x16\n" +
-	"\x06street\x18\f \x01(\tR\x06street\x12\x1a\n" +
-	"\bpostcode\x18\r \x01(\tR\bpostcode\x12\x1a\n" +
-	"\blatitude\x18\x0e \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x0f \x01(\x01R\tlongitude\x129\n" +
-	"\n" +
-	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x04\n" +
-	"\x18CreateUserAddressRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1c\n" +
-	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
-	"\x05label\x18\x04 \x01(\tR\x05label\x122\n" +
-	"\x05notes\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x12\x1d\n" +
-	"\n" +
-	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12\x18\n" +
-	"\acountry\x18\a \x01(\tR\acountry\x12A\n" +
-	"\rsubdivision_1\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
-	"\rsubdivision_2\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
-	"\rsubdivision_3\x18\n" +
-	" \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
-	"\rsubdivision_4\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x12\x16\n" +
-	"\x06street\x18\f \x01(\tR\x06street\x12\x1a\n" +
-	"\bpostcode\x18\r \x01(\tR\bpostcode\x12\x1a\n" +
-	"\blatitude\x18\x0e \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x0f \x01(\x01R\tlongitude\"\x91\x01\n" +
-	"\x19CreateUserAddressResponse\x12.\n" +
-	"\aaddress\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\aaddress\x12D\n" +
-	"\x13old_primary_address\x18\x02 \x01(\v2\x14.user.v1.UserAddressR\x11oldPrimaryAddress\"5\n" +
-	"\x1aGetAllUserAddressesRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"Q\n" +
-	"\x1bGetAllUserAddressesResponse\x122\n" +
-	"\taddresses\x18\x01 \x03(\v2\x14.user.v1.UserAddressR\taddresses\"\xd4\x06\n" +
-	"\x18UpdateUserAddressRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
-	"\n" +
-	"address_id\x18\x02 \x01(\x03R\taddressId\x12:\n" +
-	"\trecipient\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\trecipient\x122\n" +
-	"\x05phone\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x122\n" +
-	"\x05label\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05label\x122\n" +
-	"\x05notes\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x126\n" +
-	"\acountry\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\acountry\x12A\n" +
-	"\rsubdivision_1\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
-	"\rsubdivision_2\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
-	"\rsubdivision_3\x18\n" +
-	" \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
-	"\rsubdivision_4\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x124\n" +
-	"\x06street\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\x06street\x128\n" +
-	"\bpostcode\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\bpostcode\x128\n" +
-	"\blatitude\x18\x0e \x01(\v2\x1c.google.protobuf.DoubleValueR\blatitude\x12:\n" +
-	"\tlongitude\x18\x0f \x01(\v2\x1c.google.protobuf.DoubleValueR\tlongitude\"K\n" +
-	"\x19UpdateUserAddressResponse\x12.\n" +
-	"\aaddress\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\aaddress\"R\n" +
-	"\x18SetPrimaryAddressRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
-	"\n" +
-	"address_id\x18\x02 \x01(\x03R\taddressId\"\xa7\x01\n" +
-	"\x19SetPrimaryAddressResponse\x12D\n" +
-	"\x13new_primary_address\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\x11newPrimaryAddress\x12D\n" +
-	"\x13old_primary_address\x18\x02 \x01(\v2\x14.user.v1.UserAddressR\x11oldPrimaryAddress\"N\n" +
-	"\x14DeleteAddressRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
-	"\n" +
-	"address_id\x18\x02 \x01(\x03R\taddressId\"]\n" +
-	"\x15DeleteAddressResponse\x12D\n" +
-	"\x13new_primary_address\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\x11newPrimaryAddress2\xce\x03\n" +
+func (x *RestoreAddressRequest) Reset() {
+	*x = RestoreAddressRequest{}
+	mi := &file_v1_user_address_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAddressRequest) ProtoMessage() {}
+
+func (x *RestoreAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAddressRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAddressRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreAddressRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *RestoreAddressRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+type RestoreAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *UserAddress           `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAddressResponse) Reset() {
+	*x = RestoreAddressResponse{}
+	mi := &file_v1_user_address_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAddressResponse) ProtoMessage() {}
+
+func (x *RestoreAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAddressResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAddressResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreAddressResponse) GetAddress() *UserAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UserAddressDistance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *UserAddress           `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAddressDistance) Reset() {
+	*x = UserAddressDistance{}
+	mi := &file_v1_user_address_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAddressDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAddressDistance) ProtoMessage() {}
+
+func (x *UserAddressDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAddressDistance.ProtoReflect.Descriptor instead.
+func (*UserAddressDistance) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserAddressDistance) GetAddress() *UserAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UserAddressDistance) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type GetAddressesWithinRadiusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,4,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressesWithinRadiusRequest) Reset() {
+	*x = GetAddressesWithinRadiusRequest{}
+	mi := &file_v1_user_address_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressesWithinRadiusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressesWithinRadiusRequest) ProtoMessage() {}
+
+func (x *GetAddressesWithinRadiusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressesWithinRadiusRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressesWithinRadiusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAddressesWithinRadiusRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *GetAddressesWithinRadiusRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetAddressesWithinRadiusRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetAddressesWithinRadiusRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type GetAddressesWithinRadiusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*UserAddressDistance `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressesWithinRadiusResponse) Reset() {
+	*x = GetAddressesWithinRadiusResponse{}
+	mi := &file_v1_user_address_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressesWithinRadiusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressesWithinRadiusResponse) ProtoMessage() {}
+
+func (x *GetAddressesWithinRadiusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressesWithinRadiusResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressesWithinRadiusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAddressesWithinRadiusResponse) GetAddresses() []*UserAddressDistance {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type GetNearestAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearestAddressRequest) Reset() {
+	*x = GetNearestAddressRequest{}
+	mi := &file_v1_user_address_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearestAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearestAddressRequest) ProtoMessage() {}
+
+func (x *GetNearestAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearestAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetNearestAddressRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetNearestAddressRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *GetNearestAddressRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetNearestAddressRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetNearestAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *UserAddressDistance   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearestAddressResponse) Reset() {
+	*x = GetNearestAddressResponse{}
+	mi := &file_v1_user_address_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearestAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearestAddressResponse) ProtoMessage() {}
+
+func (x *GetNearestAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearestAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetNearestAddressResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetNearestAddressResponse) GetAddress() *UserAddressDistance {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type GetAddressDistanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	FromAddressId int64                  `protobuf:"varint,2,opt,name=from_address_id,json=fromAddressId,proto3" json:"from_address_id,omitempty"`
+	ToAddressId   int64                  `protobuf:"varint,3,opt,name=to_address_id,json=toAddressId,proto3" json:"to_address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressDistanceRequest) Reset() {
+	*x = GetAddressDistanceRequest{}
+	mi := &file_v1_user_address_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressDistanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressDistanceRequest) ProtoMessage() {}
+
+func (x *GetAddressDistanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressDistanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressDistanceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAddressDistanceRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *GetAddressDistanceRequest) GetFromAddressId() int64 {
+	if x != nil {
+		return x.FromAddressId
+	}
+	return 0
+}
+
+func (x *GetAddressDistanceRequest) GetToAddressId() int64 {
+	if x != nil {
+		return x.ToAddressId
+	}
+	return 0
+}
+
+type GetAddressDistanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DistanceKm    float64                `protobuf:"fixed64,1,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressDistanceResponse) Reset() {
+	*x = GetAddressDistanceResponse{}
+	mi := &file_v1_user_address_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressDistanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressDistanceResponse) ProtoMessage() {}
+
+func (x *GetAddressDistanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressDistanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressDistanceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAddressDistanceResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type GeocodedAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Subdivision_1 *wrappers.StringValue  `protobuf:"bytes,2,opt,name=subdivision_1,json=subdivision1,proto3" json:"subdivision_1,omitempty"`
+	Subdivision_2 *wrappers.StringValue  `protobuf:"bytes,3,opt,name=subdivision_2,json=subdivision2,proto3" json:"subdivision_2,omitempty"`
+	Subdivision_3 *wrappers.StringValue  `protobuf:"bytes,4,opt,name=subdivision_3,json=subdivision3,proto3" json:"subdivision_3,omitempty"`
+	Subdivision_4 *wrappers.StringValue  `protobuf:"bytes,5,opt,name=subdivision_4,json=subdivision4,proto3" json:"subdivision_4,omitempty"`
+	Street        string                 `protobuf:"bytes,6,opt,name=street,proto3" json:"street,omitempty"`
+	Postcode      string                 `protobuf:"bytes,7,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,10,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeocodedAddress) Reset() {
+	*x = GeocodedAddress{}
+	mi := &file_v1_user_address_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeocodedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodedAddress) ProtoMessage() {}
+
+func (x *GeocodedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodedAddress.ProtoReflect.Descriptor instead.
+func (*GeocodedAddress) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GeocodedAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GeocodedAddress) GetSubdivision_1() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_1
+	}
+	return nil
+}
+
+func (x *GeocodedAddress) GetSubdivision_2() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_2
+	}
+	return nil
+}
+
+func (x *GeocodedAddress) GetSubdivision_3() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_3
+	}
+	return nil
+}
+
+func (x *GeocodedAddress) GetSubdivision_4() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_4
+	}
+	return nil
+}
+
+func (x *GeocodedAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *GeocodedAddress) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+func (x *GeocodedAddress) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeocodedAddress) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeocodedAddress) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type GeocodeAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Subdivision_1 *wrappers.StringValue  `protobuf:"bytes,2,opt,name=subdivision_1,json=subdivision1,proto3" json:"subdivision_1,omitempty"`
+	Subdivision_2 *wrappers.StringValue  `protobuf:"bytes,3,opt,name=subdivision_2,json=subdivision2,proto3" json:"subdivision_2,omitempty"`
+	Subdivision_3 *wrappers.StringValue  `protobuf:"bytes,4,opt,name=subdivision_3,json=subdivision3,proto3" json:"subdivision_3,omitempty"`
+	Subdivision_4 *wrappers.StringValue  `protobuf:"bytes,5,opt,name=subdivision_4,json=subdivision4,proto3" json:"subdivision_4,omitempty"`
+	Street        string                 `protobuf:"bytes,6,opt,name=street,proto3" json:"street,omitempty"`
+	Postcode      string                 `protobuf:"bytes,7,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeocodeAddressRequest) Reset() {
+	*x = GeocodeAddressRequest{}
+	mi := &file_v1_user_address_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeocodeAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeAddressRequest) ProtoMessage() {}
+
+func (x *GeocodeAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeAddressRequest.ProtoReflect.Descriptor instead.
+func (*GeocodeAddressRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GeocodeAddressRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *GeocodeAddressRequest) GetSubdivision_1() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_1
+	}
+	return nil
+}
+
+func (x *GeocodeAddressRequest) GetSubdivision_2() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_2
+	}
+	return nil
+}
+
+func (x *GeocodeAddressRequest) GetSubdivision_3() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_3
+	}
+	return nil
+}
+
+func (x *GeocodeAddressRequest) GetSubdivision_4() *wrappers.StringValue {
+	if x != nil {
+		return x.Subdivision_4
+	}
+	return nil
+}
+
+func (x *GeocodeAddressRequest) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *GeocodeAddressRequest) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+type GeocodeAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *GeocodedAddress       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeocodeAddressResponse) Reset() {
+	*x = GeocodeAddressResponse{}
+	mi := &file_v1_user_address_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeocodeAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeAddressResponse) ProtoMessage() {}
+
+func (x *GeocodeAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeAddressResponse.ProtoReflect.Descriptor instead.
+func (*GeocodeAddressResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GeocodeAddressResponse) GetAddress() *GeocodedAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ReverseGeocodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseGeocodeRequest) Reset() {
+	*x = ReverseGeocodeRequest{}
+	mi := &file_v1_user_address_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseGeocodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseGeocodeRequest) ProtoMessage() {}
+
+func (x *ReverseGeocodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseGeocodeRequest.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReverseGeocodeRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ReverseGeocodeRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type ReverseGeocodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *GeocodedAddress       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseGeocodeResponse) Reset() {
+	*x = ReverseGeocodeResponse{}
+	mi := &file_v1_user_address_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseGeocodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseGeocodeResponse) ProtoMessage() {}
+
+func (x *ReverseGeocodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_address_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseGeocodeResponse.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_address_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReverseGeocodeResponse) GetAddress() *GeocodedAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+var File_v1_user_address_api_proto protoreflect.FileDescriptor
+
+const file_v1_user_address_api_proto_rawDesc = "" +
+	"\n" +
+	"\x19v1/user_address_api.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17v1/pagination_api.proto\"\xc4\x05\n" +
+	"\vUserAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x122\n" +
+	"\x05notes\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12A\n" +
+	"\rsubdivision_1\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
+	"\rsubdivision_2\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
+	"\rsubdivision_3\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
+	"\rsubdivision_4\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x12\x16\n" +
+	"\x06street\x18\f \x01(\tR\x06street\x12\x1a\n" +
+	"\bpostcode\x18\r \x01(\tR\bpostcode\x12\x1a\n" +
+	"\blatitude\x18\x0e \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x0f \x01(\x01R\tlongitude\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x04\n" +
+	"\x18CreateUserAddressRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x122\n" +
+	"\x05notes\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12A\n" +
+	"\rsubdivision_1\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
+	"\rsubdivision_2\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
+	"\rsubdivision_3\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
+	"\rsubdivision_4\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x12\x16\n" +
+	"\x06street\x18\f \x01(\tR\x06street\x12\x1a\n" +
+	"\bpostcode\x18\r \x01(\tR\bpostcode\x12\x1a\n" +
+	"\blatitude\x18\x0e \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x0f \x01(\x01R\tlongitude\"\x91\x01\n" +
+	"\x19CreateUserAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\aaddress\x12D\n" +
+	"\x13old_primary_address\x18\x02 \x01(\v2\x14.user.v1.UserAddressR\x11oldPrimaryAddress\"a\n" +
+	"\x1aGetAllUserAddressesRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.common.v1.PageRequestR\x04page\"z\n" +
+	"\x1bGetAllUserAddressesResponse\x122\n" +
+	"\taddresses\x18\x01 \x03(\v2\x14.user.v1.UserAddressR\taddresses\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.common.v1.PageInfoR\x04page\"\xd4\x06\n" +
+	"\x18UpdateUserAddressRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\x12:\n" +
+	"\trecipient\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\trecipient\x122\n" +
+	"\x05phone\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x122\n" +
+	"\x05label\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05label\x122\n" +
+	"\x05notes\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x126\n" +
+	"\acountry\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\acountry\x12A\n" +
+	"\rsubdivision_1\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
+	"\rsubdivision_2\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
+	"\rsubdivision_3\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
+	"\rsubdivision_4\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x124\n" +
+	"\x06street\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\x06street\x128\n" +
+	"\bpostcode\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\bpostcode\x128\n" +
+	"\blatitude\x18\x0e \x01(\v2\x1c.google.protobuf.DoubleValueR\blatitude\x12:\n" +
+	"\tlongitude\x18\x0f \x01(\v2\x1c.google.protobuf.DoubleValueR\tlongitude\"K\n" +
+	"\x19UpdateUserAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\aaddress\"R\n" +
+	"\x18SetPrimaryAddressRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"\xa7\x01\n" +
+	"\x19SetPrimaryAddressResponse\x12D\n" +
+	"\x13new_primary_address\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\x11newPrimaryAddress\x12D\n" +
+	"\x13old_primary_address\x18\x02 \x01(\v2\x14.user.v1.UserAddressR\x11oldPrimaryAddress\"N\n" +
+	"\x14DeleteAddressRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"]\n" +
+	"\x15DeleteAddressResponse\x12D\n" +
+	"\x13new_primary_address\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\x11newPrimaryAddress\"O\n" +
+	"\x15RestoreAddressRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"H\n" +
+	"\x16RestoreAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\aaddress\"f\n" +
+	"\x13UserAddressDistance\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.user.v1.UserAddressR\aaddress\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"\x91\x01\n" +
+	"\x1fGetAddressesWithinRadiusRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\tradius_km\x18\x04 \x01(\x01R\bradiusKm\"^\n" +
+	" GetAddressesWithinRadiusResponse\x12:\n" +
+	"\taddresses\x18\x01 \x03(\v2\x1c.user.v1.UserAddressDistanceR\taddresses\"m\n" +
+	"\x18GetNearestAddressRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\"S\n" +
+	"\x19GetNearestAddressResponse\x126\n" +
+	"\aaddress\x18\x01 \x01(\v2\x1c.user.v1.UserAddressDistanceR\aaddress\"\x80\x01\n" +
+	"\x19GetAddressDistanceRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12&\n" +
+	"\x0ffrom_address_id\x18\x02 \x01(\x03R\rfromAddressId\x12\"\n" +
+	"\rto_address_id\x18\x03 \x01(\x03R\vtoAddressId\"=\n" +
+	"\x1aGetAddressDistanceResponse\x12\x1f\n" +
+	"\vdistance_km\x18\x01 \x01(\x01R\n" +
+	"distanceKm\"\xc8\x03\n" +
+	"\x0fGeocodedAddress\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12A\n" +
+	"\rsubdivision_1\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
+	"\rsubdivision_2\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
+	"\rsubdivision_3\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
+	"\rsubdivision_4\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x12\x16\n" +
+	"\x06street\x18\x06 \x01(\tR\x06street\x12\x1a\n" +
+	"\bpostcode\x18\a \x01(\tR\bpostcode\x12\x1a\n" +
+	"\blatitude\x18\b \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\t \x01(\x01R\tlongitude\x12!\n" +
+	"\fdisplay_name\x18\n" +
+	" \x01(\tR\vdisplayName\"\xf1\x02\n" +
+	"\x15GeocodeAddressRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12A\n" +
+	"\rsubdivision_1\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision1\x12A\n" +
+	"\rsubdivision_2\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision2\x12A\n" +
+	"\rsubdivision_3\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision3\x12A\n" +
+	"\rsubdivision_4\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\fsubdivision4\x12\x16\n" +
+	"\x06street\x18\x06 \x01(\tR\x06street\x12\x1a\n" +
+	"\bpostcode\x18\a \x01(\tR\bpostcode\"L\n" +
+	"\x16GeocodeAddressResponse\x122\n" +
+	"\aaddress\x18\x01 \x01(\v2\x18.user.v1.GeocodedAddressR\aaddress\"Q\n" +
+	"\x15ReverseGeocodeRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"L\n" +
+	"\x16ReverseGeocodeResponse\x122\n" +
+	"\aaddress\x18\x01 \x01(\v2\x18.user.v1.GeocodedAddressR\aaddress2\xf3\a\n" +
 	"\x12UserAddressService\x12V\n" +
 	"\rCreateAddress\x12!.user.v1.CreateUserAddressRequest\x1a\".user.v1.CreateUserAddressResponse\x12\\\n" +
 	"\x0fGetAllAddresses\x12#.user.v1.GetAllUserAddressesRequest\x1a$.user.v1.GetAllUserAddressesResponse\x12V\n" +
 	"\rUpdateAddress\x12!.user.v1.UpdateUserAddressRequest\x1a\".user.v1.UpdateUserAddressResponse\x12Z\n" +
 	"\x11SetPrimaryAddress\x12!.user.v1.SetPrimaryAddressRequest\x1a\".user.v1.SetPrimaryAddressResponse\x12N\n" +
-	"\rDeleteAddress\x12\x1d.user.v1.DeleteAddressRequest\x1a\x1e.user.v1.DeleteAddressResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+	"\rDeleteAddress\x12\x1d.user.v1.DeleteAddressRequest\x1a\x1e.user.v1.DeleteAddressResponse\x12Q\n" +
+	"\x0eRestoreAddress\x12\x1e.user.v1.RestoreAddressRequest\x1a\x1f.user.v1.RestoreAddressResponse\x12o\n" +
+	"\x18GetAddressesWithinRadius\x12(.user.v1.GetAddressesWithinRadiusRequest\x1a).user.v1.GetAddressesWithinRadiusResponse\x12Z\n" +
+	"\x11GetNearestAddress\x12!.user.v1.GetNearestAddressRequest\x1a\".user.v1.GetNearestAddressResponse\x12]\n" +
+	"\x12GetAddressDistance\x12\".user.v1.GetAddressDistanceRequest\x1a#.user.v1.GetAddressDistanceResponse\x12Q\n" +
+	"\x0eGeocodeAddress\x12\x1e.user.v1.GeocodeAddressRequest\x1a\x1f.user.v1.GeocodeAddressResponse\x12Q\n" +
+	"\x0eReverseGeocode\x12\x1e.user.v1.ReverseGeocodeRequest\x1a\x1f.user.v1.ReverseGeocodeResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
 
 var (
 	file_v1_user_address_api_proto_rawDescOnce sync.Once
@@ -995,71 +1892,115 @@ func file_v1_user_address_api_proto_rawDescGZIP() []byte {
 	return file_v1_user_address_api_proto_rawDescData
 }
 
-var file_v1_user_address_api_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_user_address_api_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_v1_user_address_api_proto_goTypes = []any{
-	(*UserAddress)(nil),                 // 0: user.v1.UserAddress
-	(*CreateUserAddressRequest)(nil),    // 1: user.v1.CreateUserAddressRequest
-	(*CreateUserAddressResponse)(nil),   // 2: user.v1.CreateUserAddressResponse
-	(*GetAllUserAddressesRequest)(nil),  // 3: user.v1.GetAllUserAddressesRequest
-	(*GetAllUserAddressesResponse)(nil), // 4: user.v1.GetAllUserAddressesResponse
-	(*UpdateUserAddressRequest)(nil),    // 5: user.v1.UpdateUserAddressRequest
-	(*UpdateUserAddressResponse)(nil),   // 6: user.v1.UpdateUserAddressResponse
-	(*SetPrimaryAddressRequest)(nil),    // 7: user.v1.SetPrimaryAddressRequest
-	(*SetPrimaryAddressResponse)(nil),   // 8: user.v1.SetPrimaryAddressResponse
-	(*DeleteAddressRequest)(nil),        // 9: user.v1.DeleteAddressRequest
-	(*DeleteAddressResponse)(nil),       // 10: user.v1.DeleteAddressResponse
-	(*wrappers.StringValue)(nil),        // 11: google.protobuf.StringValue
-	(*timestamp.Timestamp)(nil),         // 12: google.protobuf.Timestamp
-	(*wrappers.DoubleValue)(nil),        // 13: google.protobuf.DoubleValue
+	(*UserAddress)(nil),                      // 0: user.v1.UserAddress
+	(*CreateUserAddressRequest)(nil),         // 1: user.v1.CreateUserAddressRequest
+	(*CreateUserAddressResponse)(nil),        // 2: user.v1.CreateUserAddressResponse
+	(*GetAllUserAddressesRequest)(nil),       // 3: user.v1.GetAllUserAddressesRequest
+	(*GetAllUserAddressesResponse)(nil),      // 4: user.v1.GetAllUserAddressesResponse
+	(*UpdateUserAddressRequest)(nil),         // 5: user.v1.UpdateUserAddressRequest
+	(*UpdateUserAddressResponse)(nil),        // 6: user.v1.UpdateUserAddressResponse
+	(*SetPrimaryAddressRequest)(nil),         // 7: user.v1.SetPrimaryAddressRequest
+	(*SetPrimaryAddressResponse)(nil),        // 8: user.v1.SetPrimaryAddressResponse
+	(*DeleteAddressRequest)(nil),             // 9: user.v1.DeleteAddressRequest
+	(*DeleteAddressResponse)(nil),            // 10: user.v1.DeleteAddressResponse
+	(*RestoreAddressRequest)(nil),            // 11: user.v1.RestoreAddressRequest
+	(*RestoreAddressResponse)(nil),           // 12: user.v1.RestoreAddressResponse
+	(*UserAddressDistance)(nil),              // 13: user.v1.UserAddressDistance
+	(*GetAddressesWithinRadiusRequest)(nil),  // 14: user.v1.GetAddressesWithinRadiusRequest
+	(*GetAddressesWithinRadiusResponse)(nil), // 15: user.v1.GetAddressesWithinRadiusResponse
+	(*GetNearestAddressRequest)(nil),         // 16: user.v1.GetNearestAddressRequest
+	(*GetNearestAddressResponse)(nil),        // 17: user.v1.GetNearestAddressResponse
+	(*GetAddressDistanceRequest)(nil),        // 18: user.v1.GetAddressDistanceRequest
+	(*GetAddressDistanceResponse)(nil),       // 19: user.v1.GetAddressDistanceResponse
+	(*GeocodedAddress)(nil),                  // 20: user.v1.GeocodedAddress
+	(*GeocodeAddressRequest)(nil),            // 21: user.v1.GeocodeAddressRequest
+	(*GeocodeAddressResponse)(nil),           // 22: user.v1.GeocodeAddressResponse
+	(*ReverseGeocodeRequest)(nil),            // 23: user.v1.ReverseGeocodeRequest
+	(*ReverseGeocodeResponse)(nil),           // 24: user.v1.ReverseGeocodeResponse
+	(*wrappers.StringValue)(nil),             // 25: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),              // 26: google.protobuf.Timestamp
+	(*PageRequest)(nil),                      // 27: common.v1.PageRequest
+	(*PageInfo)(nil),                         // 28: common.v1.PageInfo
+	(*wrappers.DoubleValue)(nil),             // 29: google.protobuf.DoubleValue
 }
 var file_v1_user_address_api_proto_depIdxs = []int32{
-	11, // 0: user.v1.UserAddress.notes:type_name -> google.protobuf.StringValue
-	11, // 1: user.v1.UserAddress.subdivision_1:type_name -> google.protobuf.StringValue
-	11, // 2: user.v1.UserAddress.subdivision_2:type_name -> google.protobuf.StringValue
-	11, // 3: user.v1.UserAddress.subdivision_3:type_name -> google.protobuf.StringValue
-	11, // 4: user.v1.UserAddress.subdivision_4:type_name -> google.protobuf.StringValue
-	12, // 5: user.v1.UserAddress.created_at:type_name -> google.protobuf.Timestamp
-	12, // 6: user.v1.UserAddress.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 7: user.v1.CreateUserAddressRequest.notes:type_name -> google.protobuf.StringValue
-	11, // 8: user.v1.CreateUserAddressRequest.subdivision_1:type_name -> google.protobuf.StringValue
-	11, // 9: user.v1.CreateUserAddressRequest.subdivision_2:type_name -> google.protobuf.StringValue
-	11, // 10: user.v1.CreateUserAddressRequest.subdivision_3:type_name -> google.protobuf.StringValue
-	11, // 11: user.v1.CreateUserAddressRequest.subdivision_4:type_name -> google.protobuf.StringValue
+	25, // 0: user.v1.UserAddress.notes:type_name -> google.protobuf.StringValue
+	25, // 1: user.v1.UserAddress.subdivision_1:type_name -> google.protobuf.StringValue
+	25, // 2: user.v1.UserAddress.subdivision_2:type_name -> google.protobuf.StringValue
+	25, // 3: user.v1.UserAddress.subdivision_3:type_name -> google.protobuf.StringValue
+	25, // 4: user.v1.UserAddress.subdivision_4:type_name -> google.protobuf.StringValue
+	26, // 5: user.v1.UserAddress.created_at:type_name -> google.protobuf.Timestamp
+	26, // 6: user.v1.UserAddress.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 7: user.v1.CreateUserAddressRequest.notes:type_name -> google.protobuf.StringValue
+	25, // 8: user.v1.CreateUserAddressRequest.subdivision_1:type_name -> google.protobuf.StringValue
+	25, // 9: user.v1.CreateUserAddressRequest.subdivision_2:type_name -> google.protobuf.StringValue
+	25, // 10: user.v1.CreateUserAddressRequest.subdivision_3:type_name -> google.protobuf.StringValue
+	25, // 11: user.v1.CreateUserAddressRequest.subdivision_4:type_name -> google.protobuf.StringValue
 	0,  // 12: user.v1.CreateUserAddressResponse.address:type_name -> user.v1.UserAddress
 	0,  // 13: user.v1.CreateUserAddressResponse.old_primary_address:type_name -> user.v1.UserAddress
-	0,  // 14: user.v1.GetAllUserAddressesResponse.addresses:type_name -> user.v1.UserAddress
-	11, // 15: user.v1.UpdateUserAddressRequest.recipient:type_name -> google.protobuf.StringValue
-	11, // 16: user.v1.UpdateUserAddressRequest.phone:type_name -> google.protobuf.StringValue
-	11, // 17: user.v1.UpdateUserAddressRequest.label:type_name -> google.protobuf.StringValue
-	11, // 18: user.v1.UpdateUserAddressRequest.notes:type_name -> google.protobuf.StringValue
-	11, // 19: user.v1.UpdateUserAddressRequest.country:type_name -> google.protobuf.StringValue
-	11, // 20: user.v1.UpdateUserAddressRequest.subdivision_1:type_name -> google.protobuf.StringValue
-	11, // 21: user.v1.UpdateUserAddressRequest.subdivision_2:type_name -> google.protobuf.StringValue
-	11, // 22: user.v1.UpdateUserAddressRequest.subdivision_3:type_name -> google.protobuf.StringValue
-	11, // 23: user.v1.UpdateUserAddressRequest.subdivision_4:type_name -> google.protobuf.StringValue
-	11, // 24: user.v1.UpdateUserAddressRequest.street:type_name -> google.protobuf.StringValue
-	11, // 25: user.v1.UpdateUserAddressRequest.postcode:type_name -> google.protobuf.StringValue
-	13, // 26: user.v1.UpdateUserAddressRequest.latitude:type_name -> google.protobuf.DoubleValue
-	13, // 27: user.v1.UpdateUserAddressRequest.longitude:type_name -> google.protobuf.DoubleValue
-	0,  // 28: user.v1.UpdateUserAddressResponse.address:type_name -> user.v1.UserAddress
-	0,  // 29: user.v1.SetPrimaryAddressResponse.new_primary_address:type_name -> user.v1.UserAddress
-	0,  // 30: user.v1.SetPrimaryAddressResponse.old_primary_address:type_name -> user.v1.UserAddress
-	0,  // 31: user.v1.DeleteAddressResponse.new_primary_address:type_name -> user.v1.UserAddress
-	1,  // 32: user.v1.UserAddressService.CreateAddress:input_type -> user.v1.CreateUserAddressRequest
-	3,  // 33: user.v1.UserAddressService.GetAllAddresses:input_type -> user.v1.GetAllUserAddressesRequest
-	5,  // 34: user.v1.UserAddressService.UpdateAddress:input_type -> user.v1.UpdateUserAddressRequest
-	7,  // 35: user.v1.UserAddressService.SetPrimaryAddress:input_type -> user.v1.SetPrimaryAddressRequest
-	9,  // 36: user.v1.UserAddressService.DeleteAddress:input_type -> user.v1.DeleteAddressRequest
-	2,  // 37: user.v1.UserAddressService.CreateAddress:output_type -> user.v1.CreateUserAddressResponse
-	4,  // 38: user.v1.UserAddressService.GetAllAddresses:output_type -> user.v1.GetAllUserAddressesResponse
-	6,  // 39: user.v1.UserAddressService.UpdateAddress:output_type -> user.v1.UpdateUserAddressResponse
-	8,  // 40: user.v1.UserAddressService.SetPrimaryAddress:output_type -> user.v1.SetPrimaryAddressResponse
-	10, // 41: user.v1.UserAddressService.DeleteAddress:output_type -> user.v1.DeleteAddressResponse
-	37, // [37:42] is the sub-list for method output_type
-	32, // [32:37] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	27, // 14: user.v1.GetAllUserAddressesRequest.page:type_name -> common.v1.PageRequest
+	0,  // 15: user.v1.GetAllUserAddressesResponse.addresses:type_name -> user.v1.UserAddress
+	28, // 16: user.v1.GetAllUserAddressesResponse.page:type_name -> common.v1.PageInfo
+	25, // 17: user.v1.UpdateUserAddressRequest.recipient:type_name -> google.protobuf.StringValue
+	25, // 18: user.v1.UpdateUserAddressRequest.phone:type_name -> google.protobuf.StringValue
+	25, // 19: user.v1.UpdateUserAddressRequest.label:type_name -> google.protobuf.StringValue
+	25, // 20: user.v1.UpdateUserAddressRequest.notes:type_name -> google.protobuf.StringValue
+	25, // 21: user.v1.UpdateUserAddressRequest.country:type_name -> google.protobuf.StringValue
+	25, // 22: user.v1.UpdateUserAddressRequest.subdivision_1:type_name -> google.protobuf.StringValue
+	25, // 23: user.v1.UpdateUserAddressRequest.subdivision_2:type_name -> google.protobuf.StringValue
+	25, // 24: user.v1.UpdateUserAddressRequest.subdivision_3:type_name -> google.protobuf.StringValue
+	25, // 25: user.v1.UpdateUserAddressRequest.subdivision_4:type_name -> google.protobuf.StringValue
+	25, // 26: user.v1.UpdateUserAddressRequest.street:type_name -> google.protobuf.StringValue
+	25, // 27: user.v1.UpdateUserAddressRequest.postcode:type_name -> google.protobuf.StringValue
+	29, // 28: user.v1.UpdateUserAddressRequest.latitude:type_name -> google.protobuf.DoubleValue
+	29, // 29: user.v1.UpdateUserAddressRequest.longitude:type_name -> google.protobuf.DoubleValue
+	0,  // 30: user.v1.UpdateUserAddressResponse.address:type_name -> user.v1.UserAddress
+	0,  // 31: user.v1.SetPrimaryAddressResponse.new_primary_address:type_name -> user.v1.UserAddress
+	0,  // 32: user.v1.SetPrimaryAddressResponse.old_primary_address:type_name -> user.v1.UserAddress
+	0,  // 33: user.v1.DeleteAddressResponse.new_primary_address:type_name -> user.v1.UserAddress
+	0,  // 34: user.v1.RestoreAddressResponse.address:type_name -> user.v1.UserAddress
+	0,  // 35: user.v1.UserAddressDistance.address:type_name -> user.v1.UserAddress
+	13, // 36: user.v1.GetAddressesWithinRadiusResponse.addresses:type_name -> user.v1.UserAddressDistance
+	13, // 37: user.v1.GetNearestAddressResponse.address:type_name -> user.v1.UserAddressDistance
+	25, // 38: user.v1.GeocodedAddress.subdivision_1:type_name -> google.protobuf.StringValue
+	25, // 39: user.v1.GeocodedAddress.subdivision_2:type_name -> google.protobuf.StringValue
+	25, // 40: user.v1.GeocodedAddress.subdivision_3:type_name -> google.protobuf.StringValue
+	25, // 41: user.v1.GeocodedAddress.subdivision_4:type_name -> google.protobuf.StringValue
+	25, // 42: user.v1.GeocodeAddressRequest.subdivision_1:type_name -> google.protobuf.StringValue
+	25, // 43: user.v1.GeocodeAddressRequest.subdivision_2:type_name -> google.protobuf.StringValue
+	25, // 44: user.v1.GeocodeAddressRequest.subdivision_3:type_name -> google.protobuf.StringValue
+	25, // 45: user.v1.GeocodeAddressRequest.subdivision_4:type_name -> google.protobuf.StringValue
+	20, // 46: user.v1.GeocodeAddressResponse.address:type_name -> user.v1.GeocodedAddress
+	20, // 47: user.v1.ReverseGeocodeResponse.address:type_name -> user.v1.GeocodedAddress
+	1,  // 48: user.v1.UserAddressService.CreateAddress:input_type -> user.v1.CreateUserAddressRequest
+	3,  // 49: user.v1.UserAddressService.GetAllAddresses:input_type -> user.v1.GetAllUserAddressesRequest
+	5,  // 50: user.v1.UserAddressService.UpdateAddress:input_type -> user.v1.UpdateUserAddressRequest
+	7,  // 51: user.v1.UserAddressService.SetPrimaryAddress:input_type -> user.v1.SetPrimaryAddressRequest
+	9,  // 52: user.v1.UserAddressService.DeleteAddress:input_type -> user.v1.DeleteAddressRequest
+	11, // 53: user.v1.UserAddressService.RestoreAddress:input_type -> user.v1.RestoreAddressRequest
+	14, // 54: user.v1.UserAddressService.GetAddressesWithinRadius:input_type -> user.v1.GetAddressesWithinRadiusRequest
+	16, // 55: user.v1.UserAddressService.GetNearestAddress:input_type -> user.v1.GetNearestAddressRequest
+	18, // 56: user.v1.UserAddressService.GetAddressDistance:input_type -> user.v1.GetAddressDistanceRequest
+	21, // 57: user.v1.UserAddressService.GeocodeAddress:input_type -> user.v1.GeocodeAddressRequest
+	23, // 58: user.v1.UserAddressService.ReverseGeocode:input_type -> user.v1.ReverseGeocodeRequest
+	2,  // 59: user.v1.UserAddressService.CreateAddress:output_type -> user.v1.CreateUserAddressResponse
+	4,  // 60: user.v1.UserAddressService.GetAllAddresses:output_type -> user.v1.GetAllUserAddressesResponse
+	6,  // 61: user.v1.UserAddressService.UpdateAddress:output_type -> user.v1.UpdateUserAddressResponse
+	8,  // 62: user.v1.UserAddressService.SetPrimaryAddress:output_type -> user.v1.SetPrimaryAddressResponse
+	10, // 63: user.v1.UserAddressService.DeleteAddress:output_type -> user.v1.DeleteAddressResponse
+	12, // 64: user.v1.UserAddressService.RestoreAddress:output_type -> user.v1.RestoreAddressResponse
+	15, // 65: user.v1.UserAddressService.GetAddressesWithinRadius:output_type -> user.v1.GetAddressesWithinRadiusResponse
+	17, // 66: user.v1.UserAddressService.GetNearestAddress:output_type -> user.v1.GetNearestAddressResponse
+	19, // 67: user.v1.UserAddressService.GetAddressDistance:output_type -> user.v1.GetAddressDistanceResponse
+	22, // 68: user.v1.UserAddressService.GeocodeAddress:output_type -> user.v1.GeocodeAddressResponse
+	24, // 69: user.v1.UserAddressService.ReverseGeocode:output_type -> user.v1.ReverseGeocodeResponse
+	59, // [59:70] is the sub-list for method output_type
+	48, // [48:59] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_v1_user_address_api_proto_init() }
@@ -1067,13 +2008,14 @@ func file_v1_user_address_api_proto_init() {
 	if File_v1_user_address_api_proto != nil {
 		return
 	}
+	file_v1_pagination_api_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_address_api_proto_rawDesc), len(file_v1_user_address_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
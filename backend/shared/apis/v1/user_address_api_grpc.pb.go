@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserAddressService_CreateAddress_FullMethodName     = "/user.v1.UserAddressService/CreateAddress"
-	UserAddressService_GetAllAddresses_FullMethodName   = "/user.v1.UserAddressService/GetAllAddresses"
-	UserAddressService_UpdateAddress_FullMethodName     = "/user.v1.UserAddressService/UpdateAddress"
-	UserAddressService_SetPrimaryAddress_FullMethodName = "/user.v1.UserAddressService/SetPrimaryAddress"
-	UserAddressService_DeleteAddress_FullMethodName     = "/user.v1.UserAddressService/DeleteAddress"
+	UserAddressService_CreateAddress_FullMethodName            = "/user.v1.UserAddressService/CreateAddress"
+	UserAddressService_GetAllAddresses_FullMethodName          = "/user.v1.UserAddressService/GetAllAddresses"
+	UserAddressService_UpdateAddress_FullMethodName            = "/user.v1.UserAddressService/UpdateAddress"
+	UserAddressService_SetPrimaryAddress_FullMethodName        = "/user.v1.UserAddressService/SetPrimaryAddress"
+	UserAddressService_DeleteAddress_FullMethodName            = "/user.v1.UserAddressService/DeleteAddress"
+	UserAddressService_RestoreAddress_FullMethodName           = "/user.v1.UserAddressService/RestoreAddress"
+	UserAddressService_GetAddressesWithinRadius_FullMethodName = "/user.v1.UserAddressService/GetAddressesWithinRadius"
+	UserAddressService_GetNearestAddress_FullMethodName        = "/user.v1.UserAddressService/GetNearestAddress"
+	UserAddressService_GetAddressDistance_FullMethodName       = "/user.v1.UserAddressService/GetAddressDistance"
+	UserAddressService_GeocodeAddress_FullMethodName           = "/user.v1.UserAddressService/GeocodeAddress"
+	UserAddressService_ReverseGeocode_FullMethodName           = "/user.v1.UserAddressService/ReverseGeocode"
 )
 
 // UserAddressServiceClient is the client API for UserAddressService service.
@@ -35,6 +41,12 @@ type UserAddressServiceClient interface {
 	UpdateAddress(ctx context.Context, in *UpdateUserAddressRequest, opts ...grpc.CallOption) (*UpdateUserAddressResponse, error)
 	SetPrimaryAddress(ctx context.Context, in *SetPrimaryAddressRequest, opts ...grpc.CallOption) (*SetPrimaryAddressResponse, error)
 	DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*DeleteAddressResponse, error)
+	RestoreAddress(ctx context.Context, in *RestoreAddressRequest, opts ...grpc.CallOption) (*RestoreAddressResponse, error)
+	GetAddressesWithinRadius(ctx context.Context, in *GetAddressesWithinRadiusRequest, opts ...grpc.CallOption) (*GetAddressesWithinRadiusResponse, error)
+	GetNearestAddress(ctx context.Context, in *GetNearestAddressRequest, opts ...grpc.CallOption) (*GetNearestAddressResponse, error)
+	GetAddressDistance(ctx context.Context, in *GetAddressDistanceRequest, opts ...grpc.CallOption) (*GetAddressDistanceResponse, error)
+	GeocodeAddress(ctx context.Context, in *GeocodeAddressRequest, opts ...grpc.CallOption) (*GeocodeAddressResponse, error)
+	ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*ReverseGeocodeResponse, error)
 }
 
 type userAddressServiceClient struct {
@@ -95,6 +107,66 @@ func (c *userAddressServiceClient) DeleteAddress(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *userAddressServiceClient) RestoreAddress(ctx context.Context, in *RestoreAddressRequest, opts ...grpc.CallOption) (*RestoreAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreAddressResponse)
+	err := c.cc.Invoke(ctx, UserAddressService_RestoreAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAddressServiceClient) GetAddressesWithinRadius(ctx context.Context, in *GetAddressesWithinRadiusRequest, opts ...grpc.CallOption) (*GetAddressesWithinRadiusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddressesWithinRadiusResponse)
+	err := c.cc.Invoke(ctx, UserAddressService_GetAddressesWithinRadius_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAddressServiceClient) GetNearestAddress(ctx context.Context, in *GetNearestAddressRequest, opts ...grpc.CallOption) (*GetNearestAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearestAddressResponse)
+	err := c.cc.Invoke(ctx, UserAddressService_GetNearestAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAddressServiceClient) GetAddressDistance(ctx context.Context, in *GetAddressDistanceRequest, opts ...grpc.CallOption) (*GetAddressDistanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddressDistanceResponse)
+	err := c.cc.Invoke(ctx, UserAddressService_GetAddressDistance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAddressServiceClient) GeocodeAddress(ctx context.Context, in *GeocodeAddressRequest, opts ...grpc.CallOption) (*GeocodeAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeocodeAddressResponse)
+	err := c.cc.Invoke(ctx, UserAddressService_GeocodeAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAddressServiceClient) ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*ReverseGeocodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseGeocodeResponse)
+	err := c.cc.Invoke(ctx, UserAddressService_ReverseGeocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserAddressServiceServer is the server API for UserAddressService service.
 // All implementations must embed UnimplementedUserAddressServiceServer
 // for forward compatibility.
@@ -104,6 +176,12 @@ type UserAddressServiceServer interface {
 	UpdateAddress(context.Context, *UpdateUserAddressRequest) (*UpdateUserAddressResponse, error)
 	SetPrimaryAddress(context.Context, *SetPrimaryAddressRequest) (*SetPrimaryAddressResponse, error)
 	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error)
+	RestoreAddress(context.Context, *RestoreAddressRequest) (*RestoreAddressResponse, error)
+	GetAddressesWithinRadius(context.Context, *GetAddressesWithinRadiusRequest) (*GetAddressesWithinRadiusResponse, error)
+	GetNearestAddress(context.Context, *GetNearestAddressRequest) (*GetNearestAddressResponse, error)
+	GetAddressDistance(context.Context, *GetAddressDistanceRequest) (*GetAddressDistanceResponse, error)
+	GeocodeAddress(context.Context, *GeocodeAddressRequest) (*GeocodeAddressResponse, error)
+	ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*ReverseGeocodeResponse, error)
 	mustEmbedUnimplementedUserAddressServiceServer()
 }
 
@@ -129,6 +207,24 @@ func (UnimplementedUserAddressServiceServer) SetPrimaryAddress(context.Context,
 func (UnimplementedUserAddressServiceServer) DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
 }
+func (UnimplementedUserAddressServiceServer) RestoreAddress(context.Context, *RestoreAddressRequest) (*RestoreAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAddress not implemented")
+}
+func (UnimplementedUserAddressServiceServer) GetAddressesWithinRadius(context.Context, *GetAddressesWithinRadiusRequest) (*GetAddressesWithinRadiusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressesWithinRadius not implemented")
+}
+func (UnimplementedUserAddressServiceServer) GetNearestAddress(context.Context, *GetNearestAddressRequest) (*GetNearestAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearestAddress not implemented")
+}
+func (UnimplementedUserAddressServiceServer) GetAddressDistance(context.Context, *GetAddressDistanceRequest) (*GetAddressDistanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressDistance not implemented")
+}
+func (UnimplementedUserAddressServiceServer) GeocodeAddress(context.Context, *GeocodeAddressRequest) (*GeocodeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeocodeAddress not implemented")
+}
+func (UnimplementedUserAddressServiceServer) ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*ReverseGeocodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseGeocode not implemented")
+}
 func (UnimplementedUserAddressServiceServer) mustEmbedUnimplementedUserAddressServiceServer() {}
 func (UnimplementedUserAddressServiceServer) testEmbeddedByValue()                            {}
 
@@ -240,6 +336,114 @@ func _UserAddressService_DeleteAddress_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserAddressService_RestoreAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAddressServiceServer).RestoreAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAddressService_RestoreAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAddressServiceServer).RestoreAddress(ctx, req.(*RestoreAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAddressService_GetAddressesWithinRadius_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressesWithinRadiusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAddressServiceServer).GetAddressesWithinRadius(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAddressService_GetAddressesWithinRadius_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAddressServiceServer).GetAddressesWithinRadius(ctx, req.(*GetAddressesWithinRadiusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAddressService_GetNearestAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearestAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAddressServiceServer).GetNearestAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAddressService_GetNearestAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAddressServiceServer).GetNearestAddress(ctx, req.(*GetNearestAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAddressService_GetAddressDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressDistanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAddressServiceServer).GetAddressDistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAddressService_GetAddressDistance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAddressServiceServer).GetAddressDistance(ctx, req.(*GetAddressDistanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAddressService_GeocodeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeocodeAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAddressServiceServer).GeocodeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAddressService_GeocodeAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAddressServiceServer).GeocodeAddress(ctx, req.(*GeocodeAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAddressService_ReverseGeocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGeocodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAddressServiceServer).ReverseGeocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAddressService_ReverseGeocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAddressServiceServer).ReverseGeocode(ctx, req.(*ReverseGeocodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserAddressService_ServiceDesc is the grpc.ServiceDesc for UserAddressService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +471,30 @@ var UserAddressService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAddress",
 			Handler:    _UserAddressService_DeleteAddress_Handler,
 		},
+		{
+			MethodName: "RestoreAddress",
+			Handler:    _UserAddressService_RestoreAddress_Handler,
+		},
+		{
+			MethodName: "GetAddressesWithinRadius",
+			Handler:    _UserAddressService_GetAddressesWithinRadius_Handler,
+		},
+		{
+			MethodName: "GetNearestAddress",
+			Handler:    _UserAddressService_GetNearestAddress_Handler,
+		},
+		{
+			MethodName: "GetAddressDistance",
+			Handler:    _UserAddressService_GetAddressDistance_Handler,
+		},
+		{
+			MethodName: "GeocodeAddress",
+			Handler:    _UserAddressService_GeocodeAddress_Handler,
+		},
+		{
+			MethodName: "ReverseGeocode",
+			Handler:    _UserAddressService_ReverseGeocode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/user_address_api.proto",
@@ -476,11 +476,11 @@ func (x *UpdateUserResponse) GetUser() *User {
 }
 
 type UpdateProfilePictureRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	AuthId         int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
-	ProfilePicture string                 `protobuf:"bytes,2,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Image         []byte                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProfilePictureRequest) Reset() {
@@ -520,16 +520,17 @@ func (x *UpdateProfilePictureRequest) GetAuthId() int64 {
 	return 0
 }
 
-func (x *UpdateProfilePictureRequest) GetProfilePicture() string {
+func (x *UpdateProfilePictureRequest) GetImage() []byte {
 	if x != nil {
-		return x.ProfilePicture
+		return x.Image
 	}
-	return ""
+	return nil
 }
 
 type UpdateProfilePictureResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ProfilePicture string                 `protobuf:"bytes,1,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+	Thumbnails     map[int32]string       `protobuf:"bytes,2,rep,name=thumbnails,proto3" json:"thumbnails,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -571,6 +572,437 @@ func (x *UpdateProfilePictureResponse) GetProfilePicture() string {
 	return ""
 }
 
+func (x *UpdateProfilePictureResponse) GetThumbnails() map[int32]string {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+type ProfileVisibility struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Bio            bool                   `protobuf:"varint,1,opt,name=bio,proto3" json:"bio,omitempty"`
+	ProfilePicture bool                   `protobuf:"varint,2,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+	MemberSince    bool                   `protobuf:"varint,3,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProfileVisibility) Reset() {
+	*x = ProfileVisibility{}
+	mi := &file_v1_user_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileVisibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileVisibility) ProtoMessage() {}
+
+func (x *ProfileVisibility) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileVisibility.ProtoReflect.Descriptor instead.
+func (*ProfileVisibility) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProfileVisibility) GetBio() bool {
+	if x != nil {
+		return x.Bio
+	}
+	return false
+}
+
+func (x *ProfileVisibility) GetProfilePicture() bool {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return false
+}
+
+func (x *ProfileVisibility) GetMemberSince() bool {
+	if x != nil {
+		return x.MemberSince
+	}
+	return false
+}
+
+type PublicProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio            *wrappers.StringValue  `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	ProfilePicture *wrappers.StringValue  `protobuf:"bytes,4,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+	MemberSince    *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PublicProfile) Reset() {
+	*x = PublicProfile{}
+	mi := &file_v1_user_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfile) ProtoMessage() {}
+
+func (x *PublicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfile.ProtoReflect.Descriptor instead.
+func (*PublicProfile) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PublicProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetBio() *wrappers.StringValue {
+	if x != nil {
+		return x.Bio
+	}
+	return nil
+}
+
+func (x *PublicProfile) GetProfilePicture() *wrappers.StringValue {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return nil
+}
+
+func (x *PublicProfile) GetMemberSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.MemberSince
+	}
+	return nil
+}
+
+type GetPublicProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileRequest) Reset() {
+	*x = GetPublicProfileRequest{}
+	mi := &file_v1_user_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileRequest) ProtoMessage() {}
+
+func (x *GetPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPublicProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPublicProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *PublicProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileResponse) Reset() {
+	*x = GetPublicProfileResponse{}
+	mi := &file_v1_user_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileResponse) ProtoMessage() {}
+
+func (x *GetPublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPublicProfileResponse) GetProfile() *PublicProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetProfileVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileVisibilityRequest) Reset() {
+	*x = GetProfileVisibilityRequest{}
+	mi := &file_v1_user_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileVisibilityRequest) ProtoMessage() {}
+
+func (x *GetProfileVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetProfileVisibilityRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type GetProfileVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visibility    *ProfileVisibility     `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileVisibilityResponse) Reset() {
+	*x = GetProfileVisibilityResponse{}
+	mi := &file_v1_user_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileVisibilityResponse) ProtoMessage() {}
+
+func (x *GetProfileVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetProfileVisibilityResponse) GetVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+type UpdateProfileVisibilityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthId         int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Bio            *wrappers.BoolValue    `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	ProfilePicture *wrappers.BoolValue    `protobuf:"bytes,3,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+	MemberSince    *wrappers.BoolValue    `protobuf:"bytes,4,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProfileVisibilityRequest) Reset() {
+	*x = UpdateProfileVisibilityRequest{}
+	mi := &file_v1_user_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileVisibilityRequest) ProtoMessage() {}
+
+func (x *UpdateProfileVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateProfileVisibilityRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *UpdateProfileVisibilityRequest) GetBio() *wrappers.BoolValue {
+	if x != nil {
+		return x.Bio
+	}
+	return nil
+}
+
+func (x *UpdateProfileVisibilityRequest) GetProfilePicture() *wrappers.BoolValue {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return nil
+}
+
+func (x *UpdateProfileVisibilityRequest) GetMemberSince() *wrappers.BoolValue {
+	if x != nil {
+		return x.MemberSince
+	}
+	return nil
+}
+
+type UpdateProfileVisibilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visibility    *ProfileVisibility     `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileVisibilityResponse) Reset() {
+	*x = UpdateProfileVisibilityResponse{}
+	mi := &file_v1_user_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileVisibilityResponse) ProtoMessage() {}
+
+func (x *UpdateProfileVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateProfileVisibilityResponse) GetVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
 var File_v1_user_api_proto protoreflect.FileDescriptor
 
 const file_v1_user_api_proto_rawDesc = "" +
@@ -609,19 +1041,57 @@ const file_v1_user_api_proto_rawDesc = "" +
 	"\tbirthdate\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthdate\x122\n" +
 	"\x05phone\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\"7\n" +
 	"\x12UpdateUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"_\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"R\n" +
 	"\x1bUpdateProfilePictureRequest\x12\x17\n" +
-	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12'\n" +
-	"\x0fprofile_picture\x18\x02 \x01(\tR\x0eprofilePicture\"G\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\fR\x05imageJ\x04\b\x02\x10\x03\"\xdd\x01\n" +
 	"\x1cUpdateProfilePictureResponse\x12'\n" +
-	"\x0fprofile_picture\x18\x01 \x01(\tR\x0eprofilePicture2\xbe\x02\n" +
+	"\x0fprofile_picture\x18\x01 \x01(\tR\x0eprofilePicture\x12U\n" +
+	"\n" +
+	"thumbnails\x18\x02 \x03(\v25.user.v1.UpdateProfilePictureResponse.ThumbnailsEntryR\n" +
+	"thumbnails\x1a=\n" +
+	"\x0fThumbnailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\x11ProfileVisibility\x12\x10\n" +
+	"\x03bio\x18\x01 \x01(\bR\x03bio\x12'\n" +
+	"\x0fprofile_picture\x18\x02 \x01(\bR\x0eprofilePicture\x12!\n" +
+	"\fmember_since\x18\x03 \x01(\bR\vmemberSince\"\xe9\x01\n" +
+	"\rPublicProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x03bio\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x03bio\x12E\n" +
+	"\x0fprofile_picture\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x0eprofilePicture\x12=\n" +
+	"\fmember_since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vmemberSince\"2\n" +
+	"\x17GetPublicProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x18GetPublicProfileResponse\x120\n" +
+	"\aprofile\x18\x01 \x01(\v2\x16.user.v1.PublicProfileR\aprofile\"6\n" +
+	"\x1bGetProfileVisibilityRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"Z\n" +
+	"\x1cGetProfileVisibilityResponse\x12:\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\v2\x1a.user.v1.ProfileVisibilityR\n" +
+	"visibility\"\xeb\x01\n" +
+	"\x1eUpdateProfileVisibilityRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12,\n" +
+	"\x03bio\x18\x02 \x01(\v2\x1a.google.protobuf.BoolValueR\x03bio\x12C\n" +
+	"\x0fprofile_picture\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\x0eprofilePicture\x12=\n" +
+	"\fmember_since\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\vmemberSince\"]\n" +
+	"\x1fUpdateProfileVisibilityResponse\x12:\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\v2\x1a.user.v1.ProfileVisibilityR\n" +
+	"visibility2\xea\x04\n" +
 	"\vUserService\x12E\n" +
 	"\n" +
 	"UpsertUser\x12\x1a.user.v1.UpsertUserRequest\x1a\x1b.user.v1.UpsertUserResponse\x12<\n" +
 	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\x12E\n" +
 	"\n" +
 	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x1b.user.v1.UpdateUserResponse\x12c\n" +
-	"\x14UpdateProfilePicture\x12$.user.v1.UpdateProfilePictureRequest\x1a%.user.v1.UpdateProfilePictureResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+	"\x14UpdateProfilePicture\x12$.user.v1.UpdateProfilePictureRequest\x1a%.user.v1.UpdateProfilePictureResponse\x12W\n" +
+	"\x10GetPublicProfile\x12 .user.v1.GetPublicProfileRequest\x1a!.user.v1.GetPublicProfileResponse\x12c\n" +
+	"\x14GetProfileVisibility\x12$.user.v1.GetProfileVisibilityRequest\x1a%.user.v1.GetProfileVisibilityResponse\x12l\n" +
+	"\x17UpdateProfileVisibility\x12'.user.v1.UpdateProfileVisibilityRequest\x1a(.user.v1.UpdateProfileVisibilityResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
 
 var (
 	file_v1_user_api_proto_rawDescOnce sync.Once
@@ -635,53 +1105,79 @@ func file_v1_user_api_proto_rawDescGZIP() []byte {
 	return file_v1_user_api_proto_rawDescData
 }
 
-var file_v1_user_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_user_api_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_v1_user_api_proto_goTypes = []any{
-	(*User)(nil),                         // 0: user.v1.User
-	(*UpsertUserRequest)(nil),            // 1: user.v1.UpsertUserRequest
-	(*UpsertUserResponse)(nil),           // 2: user.v1.UpsertUserResponse
-	(*GetUserRequest)(nil),               // 3: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),              // 4: user.v1.GetUserResponse
-	(*UpdateUserRequest)(nil),            // 5: user.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),           // 6: user.v1.UpdateUserResponse
-	(*UpdateProfilePictureRequest)(nil),  // 7: user.v1.UpdateProfilePictureRequest
-	(*UpdateProfilePictureResponse)(nil), // 8: user.v1.UpdateProfilePictureResponse
-	(*wrappers.StringValue)(nil),         // 9: google.protobuf.StringValue
-	(*timestamp.Timestamp)(nil),          // 10: google.protobuf.Timestamp
+	(*User)(nil),                            // 0: user.v1.User
+	(*UpsertUserRequest)(nil),               // 1: user.v1.UpsertUserRequest
+	(*UpsertUserResponse)(nil),              // 2: user.v1.UpsertUserResponse
+	(*GetUserRequest)(nil),                  // 3: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 4: user.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),               // 5: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),              // 6: user.v1.UpdateUserResponse
+	(*UpdateProfilePictureRequest)(nil),     // 7: user.v1.UpdateProfilePictureRequest
+	(*UpdateProfilePictureResponse)(nil),    // 8: user.v1.UpdateProfilePictureResponse
+	(*ProfileVisibility)(nil),               // 9: user.v1.ProfileVisibility
+	(*PublicProfile)(nil),                   // 10: user.v1.PublicProfile
+	(*GetPublicProfileRequest)(nil),         // 11: user.v1.GetPublicProfileRequest
+	(*GetPublicProfileResponse)(nil),        // 12: user.v1.GetPublicProfileResponse
+	(*GetProfileVisibilityRequest)(nil),     // 13: user.v1.GetProfileVisibilityRequest
+	(*GetProfileVisibilityResponse)(nil),    // 14: user.v1.GetProfileVisibilityResponse
+	(*UpdateProfileVisibilityRequest)(nil),  // 15: user.v1.UpdateProfileVisibilityRequest
+	(*UpdateProfileVisibilityResponse)(nil), // 16: user.v1.UpdateProfileVisibilityResponse
+	nil,                                     // 17: user.v1.UpdateProfilePictureResponse.ThumbnailsEntry
+	(*wrappers.StringValue)(nil),            // 18: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),             // 19: google.protobuf.Timestamp
+	(*wrappers.BoolValue)(nil),              // 20: google.protobuf.BoolValue
 }
 var file_v1_user_api_proto_depIdxs = []int32{
-	9,  // 0: user.v1.User.bio:type_name -> google.protobuf.StringValue
-	9,  // 1: user.v1.User.sex:type_name -> google.protobuf.StringValue
-	10, // 2: user.v1.User.birthdate:type_name -> google.protobuf.Timestamp
-	9,  // 3: user.v1.User.phone:type_name -> google.protobuf.StringValue
-	9,  // 4: user.v1.User.profile_picture:type_name -> google.protobuf.StringValue
-	10, // 5: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	10, // 6: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 7: user.v1.UpsertUserRequest.bio:type_name -> google.protobuf.StringValue
-	9,  // 8: user.v1.UpsertUserRequest.sex:type_name -> google.protobuf.StringValue
-	10, // 9: user.v1.UpsertUserRequest.birthdate:type_name -> google.protobuf.Timestamp
-	9,  // 10: user.v1.UpsertUserRequest.phone:type_name -> google.protobuf.StringValue
+	18, // 0: user.v1.User.bio:type_name -> google.protobuf.StringValue
+	18, // 1: user.v1.User.sex:type_name -> google.protobuf.StringValue
+	19, // 2: user.v1.User.birthdate:type_name -> google.protobuf.Timestamp
+	18, // 3: user.v1.User.phone:type_name -> google.protobuf.StringValue
+	18, // 4: user.v1.User.profile_picture:type_name -> google.protobuf.StringValue
+	19, // 5: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	19, // 6: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 7: user.v1.UpsertUserRequest.bio:type_name -> google.protobuf.StringValue
+	18, // 8: user.v1.UpsertUserRequest.sex:type_name -> google.protobuf.StringValue
+	19, // 9: user.v1.UpsertUserRequest.birthdate:type_name -> google.protobuf.Timestamp
+	18, // 10: user.v1.UpsertUserRequest.phone:type_name -> google.protobuf.StringValue
 	0,  // 11: user.v1.UpsertUserResponse.user:type_name -> user.v1.User
 	0,  // 12: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	9,  // 13: user.v1.UpdateUserRequest.name:type_name -> google.protobuf.StringValue
-	9,  // 14: user.v1.UpdateUserRequest.bio:type_name -> google.protobuf.StringValue
-	9,  // 15: user.v1.UpdateUserRequest.sex:type_name -> google.protobuf.StringValue
-	10, // 16: user.v1.UpdateUserRequest.birthdate:type_name -> google.protobuf.Timestamp
-	9,  // 17: user.v1.UpdateUserRequest.phone:type_name -> google.protobuf.StringValue
+	18, // 13: user.v1.UpdateUserRequest.name:type_name -> google.protobuf.StringValue
+	18, // 14: user.v1.UpdateUserRequest.bio:type_name -> google.protobuf.StringValue
+	18, // 15: user.v1.UpdateUserRequest.sex:type_name -> google.protobuf.StringValue
+	19, // 16: user.v1.UpdateUserRequest.birthdate:type_name -> google.protobuf.Timestamp
+	18, // 17: user.v1.UpdateUserRequest.phone:type_name -> google.protobuf.StringValue
 	0,  // 18: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
-	1,  // 19: user.v1.UserService.UpsertUser:input_type -> user.v1.UpsertUserRequest
-	3,  // 20: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	5,  // 21: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	7,  // 22: user.v1.UserService.UpdateProfilePicture:input_type -> user.v1.UpdateProfilePictureRequest
-	2,  // 23: user.v1.UserService.UpsertUser:output_type -> user.v1.UpsertUserResponse
-	4,  // 24: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	6,  // 25: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	8,  // 26: user.v1.UserService.UpdateProfilePicture:output_type -> user.v1.UpdateProfilePictureResponse
-	23, // [23:27] is the sub-list for method output_type
-	19, // [19:23] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	17, // 19: user.v1.UpdateProfilePictureResponse.thumbnails:type_name -> user.v1.UpdateProfilePictureResponse.ThumbnailsEntry
+	18, // 20: user.v1.PublicProfile.bio:type_name -> google.protobuf.StringValue
+	18, // 21: user.v1.PublicProfile.profile_picture:type_name -> google.protobuf.StringValue
+	19, // 22: user.v1.PublicProfile.member_since:type_name -> google.protobuf.Timestamp
+	10, // 23: user.v1.GetPublicProfileResponse.profile:type_name -> user.v1.PublicProfile
+	9,  // 24: user.v1.GetProfileVisibilityResponse.visibility:type_name -> user.v1.ProfileVisibility
+	20, // 25: user.v1.UpdateProfileVisibilityRequest.bio:type_name -> google.protobuf.BoolValue
+	20, // 26: user.v1.UpdateProfileVisibilityRequest.profile_picture:type_name -> google.protobuf.BoolValue
+	20, // 27: user.v1.UpdateProfileVisibilityRequest.member_since:type_name -> google.protobuf.BoolValue
+	9,  // 28: user.v1.UpdateProfileVisibilityResponse.visibility:type_name -> user.v1.ProfileVisibility
+	1,  // 29: user.v1.UserService.UpsertUser:input_type -> user.v1.UpsertUserRequest
+	3,  // 30: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	5,  // 31: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	7,  // 32: user.v1.UserService.UpdateProfilePicture:input_type -> user.v1.UpdateProfilePictureRequest
+	11, // 33: user.v1.UserService.GetPublicProfile:input_type -> user.v1.GetPublicProfileRequest
+	13, // 34: user.v1.UserService.GetProfileVisibility:input_type -> user.v1.GetProfileVisibilityRequest
+	15, // 35: user.v1.UserService.UpdateProfileVisibility:input_type -> user.v1.UpdateProfileVisibilityRequest
+	2,  // 36: user.v1.UserService.UpsertUser:output_type -> user.v1.UpsertUserResponse
+	4,  // 37: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	6,  // 38: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	8,  // 39: user.v1.UserService.UpdateProfilePicture:output_type -> user.v1.UpdateProfilePictureResponse
+	12, // 40: user.v1.UserService.GetPublicProfile:output_type -> user.v1.GetPublicProfileResponse
+	14, // 41: user.v1.UserService.GetProfileVisibility:output_type -> user.v1.GetProfileVisibilityResponse
+	16, // 42: user.v1.UserService.UpdateProfileVisibility:output_type -> user.v1.UpdateProfileVisibilityResponse
+	36, // [36:43] is the sub-list for method output_type
+	29, // [29:36] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_v1_user_api_proto_init() }
@@ -695,7 +1191,7 @@ func file_v1_user_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_api_proto_rawDesc), len(file_v1_user_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
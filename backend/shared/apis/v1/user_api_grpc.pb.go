@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_UpsertUser_FullMethodName           = "/user.v1.UserService/UpsertUser"
-	UserService_GetUser_FullMethodName              = "/user.v1.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName           = "/user.v1.UserService/UpdateUser"
-	UserService_UpdateProfilePicture_FullMethodName = "/user.v1.UserService/UpdateProfilePicture"
+	UserService_UpsertUser_FullMethodName              = "/user.v1.UserService/UpsertUser"
+	UserService_GetUser_FullMethodName                 = "/user.v1.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName              = "/user.v1.UserService/UpdateUser"
+	UserService_UpdateProfilePicture_FullMethodName    = "/user.v1.UserService/UpdateProfilePicture"
+	UserService_GetPublicProfile_FullMethodName        = "/user.v1.UserService/GetPublicProfile"
+	UserService_GetProfileVisibility_FullMethodName    = "/user.v1.UserService/GetProfileVisibility"
+	UserService_UpdateProfileVisibility_FullMethodName = "/user.v1.UserService/UpdateProfileVisibility"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -33,6 +36,9 @@ type UserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	UpdateProfilePicture(ctx context.Context, in *UpdateProfilePictureRequest, opts ...grpc.CallOption) (*UpdateProfilePictureResponse, error)
+	GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error)
+	GetProfileVisibility(ctx context.Context, in *GetProfileVisibilityRequest, opts ...grpc.CallOption) (*GetProfileVisibilityResponse, error)
+	UpdateProfileVisibility(ctx context.Context, in *UpdateProfileVisibilityRequest, opts ...grpc.CallOption) (*UpdateProfileVisibilityResponse, error)
 }
 
 type userServiceClient struct {
@@ -83,6 +89,36 @@ func (c *userServiceClient) UpdateProfilePicture(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *userServiceClient) GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfileVisibility(ctx context.Context, in *GetProfileVisibilityRequest, opts ...grpc.CallOption) (*GetProfileVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileVisibilityResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProfileVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateProfileVisibility(ctx context.Context, in *UpdateProfileVisibilityRequest, opts ...grpc.CallOption) (*UpdateProfileVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileVisibilityResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateProfileVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -91,6 +127,9 @@ type UserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	UpdateProfilePicture(context.Context, *UpdateProfilePictureRequest) (*UpdateProfilePictureResponse, error)
+	GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error)
+	GetProfileVisibility(context.Context, *GetProfileVisibilityRequest) (*GetProfileVisibilityResponse, error)
+	UpdateProfileVisibility(context.Context, *UpdateProfileVisibilityRequest) (*UpdateProfileVisibilityResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -113,6 +152,15 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) UpdateProfilePicture(context.Context, *UpdateProfilePictureRequest) (*UpdateProfilePictureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfilePicture not implemented")
 }
+func (UnimplementedUserServiceServer) GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfileVisibility(context.Context, *GetProfileVisibilityRequest) (*GetProfileVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileVisibility not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateProfileVisibility(context.Context, *UpdateProfileVisibilityRequest) (*UpdateProfileVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfileVisibility not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +254,60 @@ func _UserService_UpdateProfilePicture_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, req.(*GetPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetProfileVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProfileVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProfileVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProfileVisibility(ctx, req.(*GetProfileVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateProfileVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateProfileVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateProfileVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateProfileVisibility(ctx, req.(*UpdateProfileVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +331,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfilePicture",
 			Handler:    _UserService_UpdateProfilePicture_Handler,
 		},
+		{
+			MethodName: "GetPublicProfile",
+			Handler:    _UserService_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "GetProfileVisibility",
+			Handler:    _UserService_GetProfileVisibility_Handler,
+		},
+		{
+			MethodName: "UpdateProfileVisibility",
+			Handler:    _UserService_UpdateProfileVisibility_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/user_api.proto",
@@ -0,0 +1,386 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/user_export_api.proto
+
+package apis
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	DownloadUrl   *wrappers.StringValue  `protobuf:"bytes,3,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_v1_user_export_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_export_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_v1_user_export_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DataExport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExport) GetDownloadUrl() *wrappers.StringValue {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return nil
+}
+
+func (x *DataExport) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_v1_user_export_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_export_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_export_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportMyDataRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataExport    *DataExport            `protobuf:"bytes,1,opt,name=data_export,json=dataExport,proto3" json:"data_export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_v1_user_export_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_export_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_export_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportMyDataResponse) GetDataExport() *DataExport {
+	if x != nil {
+		return x.DataExport
+	}
+	return nil
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	ExportId      string                 `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_v1_user_export_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_export_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_export_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDataExportRequest) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *GetDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataExport    *DataExport            `protobuf:"bytes,1,opt,name=data_export,json=dataExport,proto3" json:"data_export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_v1_user_export_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_export_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_export_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDataExportResponse) GetDataExport() *DataExport {
+	if x != nil {
+		return x.DataExport
+	}
+	return nil
+}
+
+var File_v1_user_export_api_proto protoreflect.FileDescriptor
+
+const file_v1_user_export_api_proto_rawDesc = "" +
+	"\n" +
+	"\x18v1/user_export_api.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xaa\x02\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12?\n" +
+	"\fdownload_url\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdownloadUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\".\n" +
+	"\x13ExportMyDataRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\"L\n" +
+	"\x14ExportMyDataResponse\x124\n" +
+	"\vdata_export\x18\x01 \x01(\v2\x13.user.v1.DataExportR\n" +
+	"dataExport\"L\n" +
+	"\x14GetDataExportRequest\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId\"M\n" +
+	"\x15GetDataExportResponse\x124\n" +
+	"\vdata_export\x18\x01 \x01(\v2\x13.user.v1.DataExportR\n" +
+	"dataExport2\xb0\x01\n" +
+	"\x11UserExportService\x12K\n" +
+	"\fExportMyData\x12\x1c.user.v1.ExportMyDataRequest\x1a\x1d.user.v1.ExportMyDataResponse\x12N\n" +
+	"\rGetDataExport\x12\x1d.user.v1.GetDataExportRequest\x1a\x1e.user.v1.GetDataExportResponseB?Z=github.com/ritchieridanko/pasarly/backend/shared/apis/v1;apisb\x06proto3"
+
+var (
+	file_v1_user_export_api_proto_rawDescOnce sync.Once
+	file_v1_user_export_api_proto_rawDescData []byte
+)
+
+func file_v1_user_export_api_proto_rawDescGZIP() []byte {
+	file_v1_user_export_api_proto_rawDescOnce.Do(func() {
+		file_v1_user_export_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_user_export_api_proto_rawDesc), len(file_v1_user_export_api_proto_rawDesc)))
+	})
+	return file_v1_user_export_api_proto_rawDescData
+}
+
+var file_v1_user_export_api_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_user_export_api_proto_goTypes = []any{
+	(*DataExport)(nil),            // 0: user.v1.DataExport
+	(*ExportMyDataRequest)(nil),   // 1: user.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),  // 2: user.v1.ExportMyDataResponse
+	(*GetDataExportRequest)(nil),  // 3: user.v1.GetDataExportRequest
+	(*GetDataExportResponse)(nil), // 4: user.v1.GetDataExportResponse
+	(*wrappers.StringValue)(nil),  // 5: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+}
+var file_v1_user_export_api_proto_depIdxs = []int32{
+	5, // 0: user.v1.DataExport.download_url:type_name -> google.protobuf.StringValue
+	6, // 1: user.v1.DataExport.expires_at:type_name -> google.protobuf.Timestamp
+	6, // 2: user.v1.DataExport.created_at:type_name -> google.protobuf.Timestamp
+	6, // 3: user.v1.DataExport.completed_at:type_name -> google.protobuf.Timestamp
+	0, // 4: user.v1.ExportMyDataResponse.data_export:type_name -> user.v1.DataExport
+	0, // 5: user.v1.GetDataExportResponse.data_export:type_name -> user.v1.DataExport
+	1, // 6: user.v1.UserExportService.ExportMyData:input_type -> user.v1.ExportMyDataRequest
+	3, // 7: user.v1.UserExportService.GetDataExport:input_type -> user.v1.GetDataExportRequest
+	2, // 8: user.v1.UserExportService.ExportMyData:output_type -> user.v1.ExportMyDataResponse
+	4, // 9: user.v1.UserExportService.GetDataExport:output_type -> user.v1.GetDataExportResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_user_export_api_proto_init() }
+func file_v1_user_export_api_proto_init() {
+	if File_v1_user_export_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_export_api_proto_rawDesc), len(file_v1_user_export_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_user_export_api_proto_goTypes,
+		DependencyIndexes: file_v1_user_export_api_proto_depIdxs,
+		MessageInfos:      file_v1_user_export_api_proto_msgTypes,
+	}.Build()
+	File_v1_user_export_api_proto = out.File
+	file_v1_user_export_api_proto_goTypes = nil
+	file_v1_user_export_api_proto_depIdxs = nil
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.12.4
+// source: v1/user_region_api.proto
+
+package apis
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserRegionService_ListRegions_FullMethodName = "/user.v1.UserRegionService/ListRegions"
+)
+
+// UserRegionServiceClient is the client API for UserRegionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserRegionServiceClient interface {
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error)
+}
+
+type userRegionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserRegionServiceClient(cc grpc.ClientConnInterface) UserRegionServiceClient {
+	return &userRegionServiceClient{cc}
+}
+
+func (c *userRegionServiceClient) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionsResponse)
+	err := c.cc.Invoke(ctx, UserRegionService_ListRegions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserRegionServiceServer is the server API for UserRegionService service.
+// All implementations must embed UnimplementedUserRegionServiceServer
+// for forward compatibility.
+type UserRegionServiceServer interface {
+	ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error)
+	mustEmbedUnimplementedUserRegionServiceServer()
+}
+
+// UnimplementedUserRegionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserRegionServiceServer struct{}
+
+func (UnimplementedUserRegionServiceServer) ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegions not implemented")
+}
+func (UnimplementedUserRegionServiceServer) mustEmbedUnimplementedUserRegionServiceServer() {}
+func (UnimplementedUserRegionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeUserRegionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserRegionServiceServer will
+// result in compilation errors.
+type UnsafeUserRegionServiceServer interface {
+	mustEmbedUnimplementedUserRegionServiceServer()
+}
+
+func RegisterUserRegionServiceServer(s grpc.ServiceRegistrar, srv UserRegionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserRegionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserRegionService_ServiceDesc, srv)
+}
+
+func _UserRegionService_ListRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegionServiceServer).ListRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRegionService_ListRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegionServiceServer).ListRegions(ctx, req.(*ListRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserRegionService_ServiceDesc is the grpc.ServiceDesc for UserRegionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserRegionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.UserRegionService",
+	HandlerType: (*UserRegionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRegions",
+			Handler:    _UserRegionService_ListRegions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/user_region_api.proto",
+}
@@ -0,0 +1,29 @@
+package batch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// Drain calls fn until it returns less than a full batch, fails or ctx is done, so that a backlog is worked off
+// without waiting for the next tick of the caller. Batch sizes below one make it call fn once.
+func Drain[E interface {
+	comparable
+	error
+}](ctx context.Context, l *zap.Logger, batchSize int, action string, fn func(context.Context) (int, E)) {
+	var none E
+	for ctx.Err() == nil {
+		count, err := fn(ctx)
+		if err != none {
+			l.Sugar().Errorln(err.Error())
+			return
+		}
+		if count > 0 {
+			l.Sugar().Infof("%s (count=%d)", action, count)
+		}
+		if count < batchSize || batchSize < 1 {
+			return
+		}
+	}
+}
@@ -7,58 +7,97 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrorDomain identifies the errors of this system in the ErrorInfo details of gRPC statuses
+const ErrorDomain string = "pasarly"
+
 // Internal error codes
 const (
-	CodeAddressNotFound    errCode = "ADDRESS_NOT_FOUND_ERR"
-	CodeAuthNotFound       errCode = "AUTH_NOT_FOUND_ERR"
-	CodeCacheQueryExec     errCode = "CACHE_QUERY_EXEC_ERR"
-	CodeCacheScriptExec    errCode = "CACHE_SCRIPT_EXEC_ERR"
-	CodeCookieNotFound     errCode = "COOKIE_NOT_FOUND_ERR"
-	CodeCtxValueNotFound   errCode = "CTX_VALUE_NOT_FOUND_ERR"
-	CodeDataConflict       errCode = "DATA_CONFLICT_ERR"
-	CodeDBQueryExec        errCode = "DB_QUERY_EXEC_ERR"
-	CodeDBTx               errCode = "DB_TX_ERR"
-	CodeJWTCreationFailed  errCode = "JWT_CREATION_FAILED_ERR"
-	CodeHashingFailed      errCode = "HASHING_FAILED_ERR"
-	CodeInternal           errCode = "INTERNAL_ERR"
-	CodeInvalidCredentials errCode = "INVALID_CREDENTIALS_ERR"
-	CodeInvalidParams      errCode = "INVALID_PARAMS_ERR"
-	CodeInvalidPayload     errCode = "INVALID_PAYLOAD_ERR"
-	CodeInvalidToken       errCode = "INVALID_TOKEN_ERR"
-	CodeNotFound           errCode = "NOT_FOUND_ERR"
-	CodeSessionNotFound    errCode = "SESSION_NOT_FOUND_ERR"
-	CodeTokenExpired       errCode = "TOKEN_EXPIRED_ERR"
-	CodeTokenMalformed     errCode = "TOKEN_MALFORMED_ERR"
-	CodeUnauthenticated    errCode = "UNAUTHENTICATED_ERR"
-	CodeUnauthorized       errCode = "UNAUTHORIZED_ERR"
-	CodeUnknown            errCode = "UNKNOWN_ERR"
-	CodeUserNotFound       errCode = "USER_NOT_FOUND_ERR"
-	CodeWrongSignInMethod  errCode = "WRONG_SIGN_IN_METHOD_ERR"
+	CodeAddressNotFound       errCode = "ADDRESS_NOT_FOUND_ERR"
+	CodeAuthNotFound          errCode = "AUTH_NOT_FOUND_ERR"
+	CodeCacheQueryExec        errCode = "CACHE_QUERY_EXEC_ERR"
+	CodeCacheScriptExec       errCode = "CACHE_SCRIPT_EXEC_ERR"
+	CodeCookieNotFound        errCode = "COOKIE_NOT_FOUND_ERR"
+	CodeCtxValueNotFound      errCode = "CTX_VALUE_NOT_FOUND_ERR"
+	CodeDataConflict          errCode = "DATA_CONFLICT_ERR"
+	CodeDBQueryExec           errCode = "DB_QUERY_EXEC_ERR"
+	CodeDBTx                  errCode = "DB_TX_ERR"
+	CodeDeadlineExceeded      errCode = "DEADLINE_EXCEEDED_ERR"
+	CodeDeliveryNotFound      errCode = "DELIVERY_NOT_FOUND_ERR"
+	CodeExportNotFound        errCode = "EXPORT_NOT_FOUND_ERR"
+	CodeJWTCreationFailed     errCode = "JWT_CREATION_FAILED_ERR"
+	CodeLimitExceeded         errCode = "LIMIT_EXCEEDED_ERR"
+	CodeNotificationNotFound  errCode = "NOTIFICATION_NOT_FOUND_ERR"
+	CodeHashingFailed         errCode = "HASHING_FAILED_ERR"
+	CodeInternal              errCode = "INTERNAL_ERR"
+	CodeInvalidCredentials    errCode = "INVALID_CREDENTIALS_ERR"
+	CodeInvalidParams         errCode = "INVALID_PARAMS_ERR"
+	CodeInvalidPayload        errCode = "INVALID_PAYLOAD_ERR"
+	CodeInvalidToken          errCode = "INVALID_TOKEN_ERR"
+	CodeLocationNotFound      errCode = "LOCATION_NOT_FOUND_ERR"
+	CodeNotFound              errCode = "NOT_FOUND_ERR"
+	CodePasswordResetRequired errCode = "PASSWORD_RESET_REQUIRED_ERR"
+	CodePayloadTooLarge       errCode = "PAYLOAD_TOO_LARGE_ERR"
+	CodePreconditionFailed    errCode = "PRECONDITION_FAILED_ERR"
+	CodeRateLimited           errCode = "RATE_LIMITED_ERR"
+	CodeRegionNotFound        errCode = "REGION_NOT_FOUND_ERR"
+	CodeServiceUnavailable    errCode = "SERVICE_UNAVAILABLE_ERR"
+	CodeSessionNotFound       errCode = "SESSION_NOT_FOUND_ERR"
+	CodeStorageFailed         errCode = "STORAGE_FAILED_ERR"
+	CodeTokenExpired          errCode = "TOKEN_EXPIRED_ERR"
+	CodeTokenMalformed        errCode = "TOKEN_MALFORMED_ERR"
+	CodeUnauthenticated       errCode = "UNAUTHENTICATED_ERR"
+	CodeUnauthorized          errCode = "UNAUTHORIZED_ERR"
+	CodeUnknown               errCode = "UNKNOWN_ERR"
+	CodeUnsupportedMedia      errCode = "UNSUPPORTED_MEDIA_ERR"
+	CodeUserNotFound          errCode = "USER_NOT_FOUND_ERR"
+	CodeWrongSignInMethod     errCode = "WRONG_SIGN_IN_METHOD_ERR"
 )
 
 // External error messages
 const (
+	MsgAddressAlreadyExists   string = "Address already exists"
+	MsgAddressLimitReached    string = "Address limit reached"
 	MsgAddressNotFound        string = "Address not found"
+	MsgDeliveryNotFound       string = "Delivery not found"
 	MsgEmailAlreadyRegistered string = "Email is already registered"
+	MsgExportNotFound         string = "Data export not found"
 	MsgInternalServer         string = "Internal server error"
 	MsgInvalidCredentials     string = "Invalid credentials"
 	MsgInvalidParams          string = "Invalid params"
 	MsgInvalidPayload         string = "Invalid payload"
+	MsgLocationNotFound       string = "Location not found"
+	MsgNotFound               string = "Not found"
+	MsgNotificationNotFound   string = "Notification not found"
+	MsgPasswordResetRequired  string = "Password reset is required"
+	MsgPayloadTooLarge        string = "Payload is too large"
+	MsgPreconditionFailed     string = "Request cannot be processed in the current state"
+	MsgRegionNotFound         string = "Region not found"
+	MsgRequestTimeout         string = "Request timed out"
+	MsgServiceUnavailable     string = "Service is temporarily unavailable"
+	MsgTooManyRequests        string = "Too many requests, please try again later"
 	MsgUnauthenticated        string = "Unauthenticated"
 	MsgUnauthorized           string = "Unauthorized"
+	MsgUnsupportedMedia       string = "Unsupported media type"
 	MsgUserNotFound           string = "User not found"
 )
 
 // Internal errors
 var (
 	ErrCacheNil               error = redis.Nil
+	ErrCircuitOpen            error = errors.New("circuit breaker is open")
 	ErrDBAffectNoRows         error = errors.New("no rows affected")
 	ErrDBReturnNoRows         error = pgx.ErrNoRows
 	ErrEmailAlreadyRegistered error = errors.New("email already registered")
 	ErrEmailReserved          error = errors.New("email reserved")
+	ErrEventLeaseLost         error = errors.New("message lease was taken over by another instance")
 	ErrEventOnProcess         error = errors.New("message is being processed on another instance")
+	ErrIdentityMismatch       error = errors.New("identity does not match the requested account")
+	ErrInvalidCursor          error = errors.New("invalid cursor")
 	ErrInvalidToken           error = errors.New("invalid token")
 	ErrNoFieldsToUpdate       error = errors.New("no fields to update")
+	ErrPasswordResetRequired  error = errors.New("password reset required")
 	ErrRoleUnauthorized       error = errors.New("role unauthorized")
+	ErrSecretNotConfigured    error = errors.New("signing secret is not configured")
+	ErrTokenExpired           error = errors.New("token expired")
 	ErrWrongSignInMethod      error = errors.New("wrong sign in method")
 )
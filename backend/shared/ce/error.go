@@ -7,8 +7,10 @@ import (
 
 	otc "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	gc "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 )
 
 type errCode string
@@ -16,10 +18,21 @@ type errCode string
 type Error struct {
 	Code      errCode
 	Message   string
+	Fields    []FieldViolation
 	Err       error
 	Timestamp time.Time
 }
 
+// FieldViolation names the field of a request that failed validation and why
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (f FieldViolation) Error() string {
+	return fmt.Sprintf("%s %s", f.Field, f.Description)
+}
+
 func NewError(s trace.Span, ec errCode, msg string, err error) *Error {
 	if s != nil {
 		s.RecordError(err)
@@ -29,26 +42,71 @@ func NewError(s trace.Span, ec errCode, msg string, err error) *Error {
 	return &Error{Code: ec, Message: msg, Err: err, Timestamp: time.Now().UTC()}
 }
 
+func (e *Error) WithField(field, description string) *Error {
+	e.Fields = append(e.Fields, FieldViolation{Field: field, Description: description})
+	return e
+}
+
 func (e *Error) Error() string {
 	return fmt.Sprintf("%s\t[%s]\t%v", e.Timestamp.Format("2006-01-02 15:04:05"), e.Code, e.Err)
 }
 
+// GRPCStatus carries the code of the error as ErrorInfo details and its field violations as BadRequest details,
+// FromGRPCErr restores both on the calling side. It also lets status.FromError recognize the error.
+func (e *Error) GRPCStatus() *status.Status {
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(e.Code), Domain: ErrorDomain}}
+	if len(e.Fields) > 0 {
+		br := errdetails.BadRequest{}
+		for _, f := range e.Fields {
+			br.FieldViolations = append(
+				br.FieldViolations,
+				&errdetails.BadRequest_FieldViolation{Field: f.Field, Description: f.Description},
+			)
+		}
+		details = append(details, &br)
+	}
+
+	st := status.New(e.grpcCode(), e.Message)
+	if sd, err := st.WithDetails(details...); err == nil {
+		return sd
+	}
+	return st
+}
+
 func (e *Error) ToGRPCStatus() error {
+	return e.GRPCStatus().Err()
+}
+
+func (e *Error) grpcCode() gc.Code {
 	switch e.Code {
 	case CodeInvalidPayload:
-		return status.Error(gc.InvalidArgument, e.Message)
-	case CodeAuthNotFound, CodeInvalidCredentials, CodeSessionNotFound, CodeWrongSignInMethod:
-		return status.Error(gc.Unauthenticated, e.Message)
-	case CodeAddressNotFound, CodeUserNotFound:
-		return status.Error(gc.NotFound, e.Message)
+		return gc.InvalidArgument
+	case
+		CodeAuthNotFound, CodeInvalidCredentials, CodeInvalidToken, CodePasswordResetRequired,
+		CodeSessionNotFound, CodeTokenExpired, CodeUnauthenticated, CodeWrongSignInMethod:
+		return gc.Unauthenticated
+	case CodeUnauthorized:
+		return gc.PermissionDenied
+	case
+		CodeAddressNotFound, CodeDeliveryNotFound, CodeExportNotFound, CodeLocationNotFound,
+		CodeNotificationNotFound, CodeRegionNotFound, CodeUserNotFound:
+		return gc.NotFound
 	case CodeDataConflict:
-		return status.Error(gc.AlreadyExists, e.Message)
+		return gc.AlreadyExists
+	case CodeLimitExceeded, CodePreconditionFailed:
+		return gc.FailedPrecondition
+	case CodeRateLimited:
+		return gc.ResourceExhausted
+	case CodeServiceUnavailable:
+		return gc.Unavailable
+	case CodeDeadlineExceeded:
+		return gc.DeadlineExceeded
 	case
 		CodeCacheQueryExec, CodeCacheScriptExec, CodeDBQueryExec,
-		CodeDBTx, CodeHashingFailed, CodeJWTCreationFailed:
-		return status.Error(gc.Internal, e.Message)
+		CodeDBTx, CodeHashingFailed, CodeJWTCreationFailed, CodeStorageFailed:
+		return gc.Internal
 	default:
-		return status.Error(gc.Internal, e.Message)
+		return gc.Internal
 	}
 }
 
@@ -57,17 +115,44 @@ func (e *Error) ToHTTPStatus() int {
 	case CodeInvalidParams, CodeInvalidPayload:
 		return http.StatusBadRequest
 	case
+		CodeAuthNotFound,
 		CodeCookieNotFound,
+		CodeInvalidCredentials,
 		CodeInvalidToken,
+		CodePasswordResetRequired,
+		CodeSessionNotFound,
 		CodeTokenExpired,
 		CodeTokenMalformed,
 		CodeUnauthenticated,
-		CodeUnauthorized:
+		CodeUnauthorized,
+		CodeWrongSignInMethod:
 		return http.StatusUnauthorized
-	case CodeAddressNotFound, CodeNotFound, CodeUserNotFound:
+	case
+		CodeAddressNotFound,
+		CodeDeliveryNotFound,
+		CodeExportNotFound,
+		CodeLocationNotFound,
+		CodeNotFound,
+		CodeNotificationNotFound,
+		CodeRegionNotFound,
+		CodeUserNotFound:
 		return http.StatusNotFound
 	case CodeDataConflict:
 		return http.StatusConflict
+	case CodeLimitExceeded:
+		return http.StatusUnprocessableEntity
+	case CodePreconditionFailed:
+		return http.StatusPreconditionFailed
+	case CodeRateLimited:
+		return http.StatusTooManyRequests
+	case CodePayloadTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case CodeUnsupportedMedia:
+		return http.StatusUnsupportedMediaType
+	case CodeServiceUnavailable:
+		return http.StatusServiceUnavailable
+	case CodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
 	case CodeCtxValueNotFound, CodeInternal, CodeUnknown:
 		return http.StatusInternalServerError
 	default:
@@ -4,10 +4,13 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// FromGRPCErr rebuilds the error a service returned, statuses carrying ErrorInfo details of this domain keep their
+// original code and field violations while anything else, like transport failures, is mapped by its gRPC code
 func FromGRPCErr(s trace.Span, err error) *Error {
 	st, ok := status.FromError(err)
 	e := fmt.Errorf("%s", st.Message())
@@ -15,15 +18,47 @@ func FromGRPCErr(s trace.Span, err error) *Error {
 		return NewError(s, CodeUnknown, MsgInternalServer, e)
 	}
 
+	var (
+		info   *errdetails.ErrorInfo
+		fields []FieldViolation
+	)
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			if d.GetDomain() == ErrorDomain {
+				info = d
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				fields = append(fields, FieldViolation{Field: v.GetField(), Description: v.GetDescription()})
+			}
+		}
+	}
+	if info != nil {
+		ne := NewError(s, errCode(info.GetReason()), st.Message(), e)
+		ne.Fields = fields
+		return ne
+	}
+
 	switch st.Code() {
 	case codes.AlreadyExists:
 		return NewError(s, CodeDataConflict, st.Message(), e)
+	case codes.FailedPrecondition:
+		return NewError(s, CodePreconditionFailed, st.Message(), e)
 	case codes.InvalidArgument:
 		return NewError(s, CodeInvalidPayload, st.Message(), e)
 	case codes.NotFound:
 		return NewError(s, CodeNotFound, st.Message(), e)
 	case codes.Unauthenticated:
 		return NewError(s, CodeUnauthenticated, st.Message(), e)
+	case codes.PermissionDenied:
+		return NewError(s, CodeUnauthorized, st.Message(), e)
+	case codes.ResourceExhausted:
+		return NewError(s, CodeRateLimited, MsgTooManyRequests, e)
+	case codes.Unavailable:
+		return NewError(s, CodeServiceUnavailable, MsgServiceUnavailable, e)
+	case codes.DeadlineExceeded:
+		return NewError(s, CodeDeadlineExceeded, MsgRequestTimeout, e)
 	case codes.Internal:
 		return NewError(s, CodeInternal, st.Message(), e)
 	default:
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// devcerts issues a local CA and one certificate per service for mutual TLS in development. It is safe to run
+// on every start, since existing certificates are only reissued when they are about to expire, were signed by
+// another CA, or no longer cover the configured hosts.
+func main() {
+	fo := flag.String("out", "./certs", "Directory to write the certificates to")
+	fs := flag.String("services", "", "Comma separated identity=host pairs, e.g. gateway=api-gateway,auth=auth-service")
+	fv := flag.Duration("validity", 30*24*time.Hour, "Validity of the service certificates")
+	fr := flag.Duration("renew-before", 7*24*time.Hour, "Reissue service certificates expiring within this window")
+	flag.Parse()
+
+	if env := os.Getenv("APP_ENV"); env != "" && env != "dev" {
+		log.Fatalf("FATAL -> devcerts is only available in dev (APP_ENV=%s)", env)
+	}
+
+	services, err := parseServices(*fs)
+	if err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+
+	if err := os.MkdirAll(*fo, 0o755); err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+
+	ca, caKey, err := loadOrCreateCA(*fo)
+	if err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+
+	for _, s := range services {
+		issued, err := ensureCert(*fo, s, ca, caKey, *fv, *fr)
+		if err != nil {
+			log.Fatalln("FATAL ->", err.Error())
+		}
+		if issued {
+			log.Printf("✅ [DEVCERTS] issued (identity=%s, hosts=%s)", s.identity, strings.Join(s.hosts, ","))
+		}
+	}
+
+	log.Printf("✅ [DEVCERTS] certificates are up to date (dir=%s)", *fo)
+}
+
+type service struct {
+	identity string
+	hosts    []string
+}
+
+func parseServices(value string) ([]service, error) {
+	services := make([]service, 0)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		identity, host, ok := strings.Cut(pair, "=")
+		if !ok || identity == "" || host == "" {
+			return nil, fmt.Errorf("invalid service %q, expected identity=host", pair)
+		}
+
+		// The identity and localhost are always covered, so services can also be reached outside of docker
+		hosts := []string{host}
+		for _, h := range []string{identity, "localhost"} {
+			if !slices.Contains(hosts, h) {
+				hosts = append(hosts, h)
+			}
+		}
+
+		services = append(services, service{identity: identity, hosts: hosts})
+	}
+
+	if len(services) == 0 {
+		return nil, errors.New("no services to issue certificates for")
+	}
+	return services, nil
+}
+
+func loadOrCreateCA(dir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certPath, keyPath := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+
+	if ca, key, err := loadPair(certPath, keyPath); err == nil && time.Now().Before(ca.NotAfter) {
+		return ca, key, nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create CA: %w", err)
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serialNumber(),
+		Subject:               pkix.Name{CommonName: "Pasarly Dev CA", Organization: []string{"Pasarly"}},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(5, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create CA: %w", err)
+	}
+
+	if err := writePair(certPath, keyPath, der, key); err != nil {
+		return nil, nil, fmt.Errorf("failed to create CA: %w", err)
+	}
+
+	ca, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create CA: %w", err)
+	}
+
+	log.Printf("✅ [DEVCERTS] CA created (expires_at=%s)", ca.NotAfter.UTC().Format(time.RFC3339))
+	return ca, key, nil
+}
+
+func ensureCert(
+	dir string,
+	s service,
+	ca *x509.Certificate,
+	caKey *ecdsa.PrivateKey,
+	validity, renewBefore time.Duration,
+) (bool, error) {
+	certPath, keyPath := filepath.Join(dir, s.identity+".crt"), filepath.Join(dir, s.identity+".key")
+
+	if cert, _, err := loadPair(certPath, keyPath); err == nil {
+		fresh := time.Now().Add(renewBefore).Before(cert.NotAfter)
+		trusted := cert.CheckSignatureFrom(ca) == nil
+		covered := cert.Subject.CommonName == s.identity && slices.Equal(cert.DNSNames, s.hosts)
+		if fresh && trusted && covered {
+			return false, nil
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return false, fmt.Errorf("failed to issue certificate for %s: %w", s.identity, err)
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serialNumber(),
+		Subject:      pkix.Name{CommonName: s.identity, Organization: []string{"Pasarly"}},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     s.hosts,
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return false, fmt.Errorf("failed to issue certificate for %s: %w", s.identity, err)
+	}
+
+	if err := writePair(certPath, keyPath, der, key); err != nil {
+		return false, fmt.Errorf("failed to issue certificate for %s: %w", s.identity, err)
+	}
+
+	return true, nil
+}
+
+func loadPair(certPath, keyPath string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cb, _ := pem.Decode(certPEM)
+	kb, _ := pem.Decode(keyPEM)
+	if cb == nil || kb == nil {
+		return nil, nil, errors.New("invalid PEM")
+	}
+
+	cert, err := x509.ParseCertificate(cb.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParseECPrivateKey(kb.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// writePair replaces the files through renames, so that a service reloading meanwhile never reads a partial file
+func writePair(certPath, keyPath string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := writeAtomic(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+	return writeAtomic(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func writeAtomic(path string, data []byte, perm os.FileMode) error {
+	if current, err := os.ReadFile(path); err == nil && bytes.Equal(current, data) {
+		return nil
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func serialNumber() *big.Int {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	if err != nil {
+		log.Fatalln("FATAL ->", err.Error())
+	}
+	return n
+}
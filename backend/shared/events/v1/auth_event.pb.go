@@ -29,6 +29,7 @@ type AuthCreated struct {
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Locale        string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,18 +99,250 @@ func (x *AuthCreated) GetCreatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *AuthCreated) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// Sent when an account signs in from a user agent and IP address pair it has never used before
+type NewDeviceSignIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	AuthId        int64                  `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Browser       string                 `protobuf:"bytes,7,opt,name=browser,proto3" json:"browser,omitempty"`
+	Os            string                 `protobuf:"bytes,8,opt,name=os,proto3" json:"os,omitempty"`
+	Location      string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Token         string                 `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`
+	SignedInAt    *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=signed_in_at,json=signedInAt,proto3" json:"signed_in_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewDeviceSignIn) Reset() {
+	*x = NewDeviceSignIn{}
+	mi := &file_v1_auth_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewDeviceSignIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewDeviceSignIn) ProtoMessage() {}
+
+func (x *NewDeviceSignIn) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewDeviceSignIn.ProtoReflect.Descriptor instead.
+func (*NewDeviceSignIn) Descriptor() ([]byte, []int) {
+	return file_v1_auth_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NewDeviceSignIn) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *NewDeviceSignIn) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NewDeviceSignIn) GetSignedInAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SignedInAt
+	}
+	return nil
+}
+
+type AuthDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	AuthId        int64                  `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DeletedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthDeleted) Reset() {
+	*x = AuthDeleted{}
+	mi := &file_v1_auth_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthDeleted) ProtoMessage() {}
+
+func (x *AuthDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_auth_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthDeleted.ProtoReflect.Descriptor instead.
+func (*AuthDeleted) Descriptor() ([]byte, []int) {
+	return file_v1_auth_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthDeleted) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AuthDeleted) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *AuthDeleted) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthDeleted) GetDeletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *AuthDeleted) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 var File_v1_auth_event_proto protoreflect.FileDescriptor
 
 const file_v1_auth_event_proto_rawDesc = "" +
 	"\n" +
-	"\x13v1/auth_event.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x01\n" +
+	"\x13v1/auth_event.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x01\n" +
 	"\vAuthCreated\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
 	"\aauth_id\x18\x02 \x01(\x03R\x06authId\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
 	"\x05token\x18\x04 \x01(\tR\x05token\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtBCZAgithub.com/ritchieridanko/pasarly/backend/shared/events/v1;eventsb\x06proto3"
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\"\xcb\x02\n" +
+	"\x0fNewDeviceSignIn\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aauth_id\x18\x02 \x01(\x03R\x06authId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x06 \x01(\tR\tipAddress\x12\x18\n" +
+	"\abrowser\x18\a \x01(\tR\abrowser\x12\x0e\n" +
+	"\x02os\x18\b \x01(\tR\x02os\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12\x14\n" +
+	"\x05token\x18\n" +
+	" \x01(\tR\x05token\x12<\n" +
+	"\fsigned_in_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"signedInAt\"\xaa\x01\n" +
+	"\vAuthDeleted\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aauth_id\x18\x02 \x01(\x03R\x06authId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06localeBCZAgithub.com/ritchieridanko/pasarly/backend/shared/events/v1;eventsb\x06proto3"
 
 var (
 	file_v1_auth_event_proto_rawDescOnce sync.Once
@@ -123,18 +356,22 @@ func file_v1_auth_event_proto_rawDescGZIP() []byte {
 	return file_v1_auth_event_proto_rawDescData
 }
 
-var file_v1_auth_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v1_auth_event_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_v1_auth_event_proto_goTypes = []any{
 	(*AuthCreated)(nil),         // 0: auth.v1.AuthCreated
-	(*timestamp.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*NewDeviceSignIn)(nil),     // 1: auth.v1.NewDeviceSignIn
+	(*AuthDeleted)(nil),         // 2: auth.v1.AuthDeleted
+	(*timestamp.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_v1_auth_event_proto_depIdxs = []int32{
-	1, // 0: auth.v1.AuthCreated.created_at:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: auth.v1.AuthCreated.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: auth.v1.NewDeviceSignIn.signed_in_at:type_name -> google.protobuf.Timestamp
+	3, // 2: auth.v1.AuthDeleted.deleted_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_v1_auth_event_proto_init() }
@@ -148,7 +385,7 @@ func file_v1_auth_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_auth_event_proto_rawDesc), len(file_v1_auth_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
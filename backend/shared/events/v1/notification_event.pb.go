@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/notification_event.proto
+
+package events
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Published over Redis pub/sub whenever a notification lands in a user's inbox
+type InboxNotificationCreated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuthId         int64                  `protobuf:"varint,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	NotificationId int64                  `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body           string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Url            string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Data           map[string]string      `protobuf:"bytes,7,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt      *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UnreadCount    int64                  `protobuf:"varint,9,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InboxNotificationCreated) Reset() {
+	*x = InboxNotificationCreated{}
+	mi := &file_v1_notification_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxNotificationCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxNotificationCreated) ProtoMessage() {}
+
+func (x *InboxNotificationCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxNotificationCreated.ProtoReflect.Descriptor instead.
+func (*InboxNotificationCreated) Descriptor() ([]byte, []int) {
+	return file_v1_notification_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InboxNotificationCreated) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *InboxNotificationCreated) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *InboxNotificationCreated) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InboxNotificationCreated) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxNotificationCreated) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *InboxNotificationCreated) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InboxNotificationCreated) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InboxNotificationCreated) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InboxNotificationCreated) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+var File_v1_notification_event_proto protoreflect.FileDescriptor
+
+const file_v1_notification_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1bv1/notification_event.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x03\n" +
+	"\x18InboxNotificationCreated\x12\x17\n" +
+	"\aauth_id\x18\x01 \x01(\x03R\x06authId\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12G\n" +
+	"\x04data\x18\a \x03(\v23.notification.v1.InboxNotificationCreated.DataEntryR\x04data\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\funread_count\x18\t \x01(\x03R\vunreadCount\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BCZAgithub.com/ritchieridanko/pasarly/backend/shared/events/v1;eventsb\x06proto3"
+
+var (
+	file_v1_notification_event_proto_rawDescOnce sync.Once
+	file_v1_notification_event_proto_rawDescData []byte
+)
+
+func file_v1_notification_event_proto_rawDescGZIP() []byte {
+	file_v1_notification_event_proto_rawDescOnce.Do(func() {
+		file_v1_notification_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_notification_event_proto_rawDesc), len(file_v1_notification_event_proto_rawDesc)))
+	})
+	return file_v1_notification_event_proto_rawDescData
+}
+
+var file_v1_notification_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_notification_event_proto_goTypes = []any{
+	(*InboxNotificationCreated)(nil), // 0: notification.v1.InboxNotificationCreated
+	nil,                              // 1: notification.v1.InboxNotificationCreated.DataEntry
+	(*timestamp.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+}
+var file_v1_notification_event_proto_depIdxs = []int32{
+	1, // 0: notification.v1.InboxNotificationCreated.data:type_name -> notification.v1.InboxNotificationCreated.DataEntry
+	2, // 1: notification.v1.InboxNotificationCreated.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_event_proto_init() }
+func file_v1_notification_event_proto_init() {
+	if File_v1_notification_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_notification_event_proto_rawDesc), len(file_v1_notification_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_notification_event_proto_goTypes,
+		DependencyIndexes: file_v1_notification_event_proto_depIdxs,
+		MessageInfos:      file_v1_notification_event_proto_msgTypes,
+	}.Build()
+	File_v1_notification_event_proto = out.File
+	file_v1_notification_event_proto_goTypes = nil
+	file_v1_notification_event_proto_depIdxs = nil
+}
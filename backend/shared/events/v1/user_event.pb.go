@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: v1/user_event.proto
+
+package events
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserDataExported struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	AuthId        int64                  `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,4,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Locale        string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExported) Reset() {
+	*x = UserDataExported{}
+	mi := &file_v1_user_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExported) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExported) ProtoMessage() {}
+
+func (x *UserDataExported) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExported.ProtoReflect.Descriptor instead.
+func (*UserDataExported) Descriptor() ([]byte, []int) {
+	return file_v1_user_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserDataExported) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UserDataExported) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+func (x *UserDataExported) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserDataExported) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *UserDataExported) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UserDataExported) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+var File_v1_user_event_proto protoreflect.FileDescriptor
+
+const file_v1_user_event_proto_rawDesc = "" +
+	"\n" +
+	"\x13v1/user_event.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x01\n" +
+	"\x10UserDataExported\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aauth_id\x18\x02 \x01(\x03R\x06authId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fdownload_url\x18\x04 \x01(\tR\vdownloadUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06localeBCZAgithub.com/ritchieridanko/pasarly/backend/shared/events/v1;eventsb\x06proto3"
+
+var (
+	file_v1_user_event_proto_rawDescOnce sync.Once
+	file_v1_user_event_proto_rawDescData []byte
+)
+
+func file_v1_user_event_proto_rawDescGZIP() []byte {
+	file_v1_user_event_proto_rawDescOnce.Do(func() {
+		file_v1_user_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_user_event_proto_rawDesc), len(file_v1_user_event_proto_rawDesc)))
+	})
+	return file_v1_user_event_proto_rawDescData
+}
+
+var file_v1_user_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v1_user_event_proto_goTypes = []any{
+	(*UserDataExported)(nil),    // 0: user.v1.UserDataExported
+	(*timestamp.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_v1_user_event_proto_depIdxs = []int32{
+	1, // 0: user.v1.UserDataExported.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_v1_user_event_proto_init() }
+func file_v1_user_event_proto_init() {
+	if File_v1_user_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_event_proto_rawDesc), len(file_v1_user_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_user_event_proto_goTypes,
+		DependencyIndexes: file_v1_user_event_proto_depIdxs,
+		MessageInfos:      file_v1_user_event_proto_msgTypes,
+	}.Build()
+	File_v1_user_event_proto = out.File
+	file_v1_user_event_proto_goTypes = nil
+	file_v1_user_event_proto_depIdxs = nil
+}
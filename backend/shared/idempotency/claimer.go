@@ -0,0 +1,181 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel"
+)
+
+const claimerErrTracer string = "idempotency.claimer"
+
+// Claimer makes event processing idempotent across replicas. A claim is a single upsert on the events table
+// that only succeeds when the event is new, or unfinished with an expired lease, so two replicas receiving the
+// same message can never both win it. The winner keeps its lease alive with a heartbeat while it works
+type Claimer struct {
+	pool  *pgxpool.Pool
+	lease time.Duration
+}
+
+// NewClaimer requires a positive lease, the heartbeat renews it at a third of its length
+func NewClaimer(p *pgxpool.Pool, lease time.Duration) (*Claimer, error) {
+	if lease <= 0 {
+		return nil, fmt.Errorf("failed to initialize claimer: lease must be positive, got %s", lease)
+	}
+	return &Claimer{pool: p, lease: lease}, nil
+}
+
+// Claim returns a nil lease when the event has already been completed, and ce.ErrEventOnProcess when another
+// replica holds it. The returned context is cancelled with ce.ErrEventLeaseLost if the lease cannot be renewed
+func (c *Claimer) Claim(ctx context.Context, eventID, eventType string, authID int64) (context.Context, *Lease, error) {
+	ctx, span := otel.Tracer(claimerErrTracer).Start(ctx, "Claim")
+	defer span.End()
+
+	token, err := newToken()
+	if err != nil {
+		return ctx, nil, fmt.Errorf("failed to claim event: %w", err)
+	}
+
+	query := `
+		INSERT INTO events (event_id, event_type, auth_id, lease_token, lease_expires_at)
+		VALUES ($1, $2, NULLIF($3, 0), $4, NOW() + make_interval(secs => $5))
+		ON CONFLICT (event_id) DO UPDATE
+		SET processed_at = NOW(), lease_token = EXCLUDED.lease_token, lease_expires_at = EXCLUDED.lease_expires_at
+		WHERE events.completed_at IS NULL AND (events.lease_expires_at IS NULL OR events.lease_expires_at < NOW())
+	`
+
+	tag, err := c.pool.Exec(ctx, query, eventID, eventType, authID, token, c.lease.Seconds())
+	if err != nil {
+		return ctx, nil, fmt.Errorf("failed to claim event: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		var completed bool
+		query := "SELECT completed_at IS NOT NULL FROM events WHERE event_id = $1"
+		if err := c.pool.QueryRow(ctx, query, eventID).Scan(&completed); err != nil && !errors.Is(err, ce.ErrDBReturnNoRows) {
+			return ctx, nil, fmt.Errorf("failed to claim event: %w", err)
+		}
+		if completed {
+			return ctx, nil, nil
+		}
+		return ctx, nil, fmt.Errorf("failed to claim event: %w", ce.ErrEventOnProcess)
+	}
+
+	lctx, cancel := context.WithCancelCause(ctx)
+	l := Lease{
+		claimer: c,
+		eventID: eventID,
+		token:   token,
+		cancel:  cancel,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go l.heartbeat(lctx)
+
+	return lctx, &l, nil
+}
+
+type Lease struct {
+	claimer *Claimer
+	eventID string
+	token   string
+	cancel  context.CancelCauseFunc
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+// Complete marks the event as processed for good, it fails when the lease was taken over in the meantime
+func (l *Lease) Complete(ctx context.Context) error {
+	ctx, span := otel.Tracer(claimerErrTracer).Start(ctx, "Complete")
+	defer span.End()
+
+	l.end()
+
+	query := `
+		UPDATE events
+		SET completed_at = NOW(), lease_token = NULL, lease_expires_at = NULL
+		WHERE event_id = $1 AND lease_token = $2
+	`
+
+	tag, err := l.claimer.pool.Exec(context.WithoutCancel(ctx), query, l.eventID, l.token)
+	if err != nil {
+		return fmt.Errorf("failed to complete event: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("failed to complete event: %w", ce.ErrEventLeaseLost)
+	}
+
+	return nil
+}
+
+// Release gives the event up without completing it, so a redelivery can claim it right away instead of
+// waiting for the lease to expire. It does nothing once the event is completed
+func (l *Lease) Release(ctx context.Context) {
+	ctx, span := otel.Tracer(claimerErrTracer).Start(ctx, "Release")
+	defer span.End()
+
+	l.end()
+
+	query := `
+		UPDATE events
+		SET lease_token = NULL, lease_expires_at = NULL
+		WHERE event_id = $1 AND lease_token = $2 AND completed_at IS NULL
+	`
+
+	_, _ = l.claimer.pool.Exec(context.WithoutCancel(ctx), query, l.eventID, l.token)
+}
+
+func (l *Lease) heartbeat(ctx context.Context) {
+	defer close(l.done)
+
+	t := time.NewTicker(l.claimer.lease / 3)
+	defer t.Stop()
+
+	query := `
+		UPDATE events
+		SET lease_expires_at = NOW() + make_interval(secs => $3)
+		WHERE event_id = $1 AND lease_token = $2
+	`
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		// A failed renewal is retried on the next beat, the work only stops once the lease is gone
+		tag, err := l.claimer.pool.Exec(ctx, query, l.eventID, l.token, l.claimer.lease.Seconds())
+		if err == nil && tag.RowsAffected() == 0 {
+			l.cancel(ce.ErrEventLeaseLost)
+			return
+		}
+	}
+}
+
+// end stops the heartbeat before the lease is settled, so a late renewal cannot race the final update
+func (l *Lease) end() {
+	l.once.Do(func() {
+		close(l.stop)
+		<-l.done
+		l.cancel(nil)
+	})
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,195 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+)
+
+// The claimer is only meaningful against a real Postgres, these tests run when IDEMPOTENCY_TEST_DSN points at one
+// and work inside a throwaway schema so they never touch existing tables
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("IDEMPOTENCY_TEST_DSN")
+	if dsn == "" {
+		t.Skip("IDEMPOTENCY_TEST_DSN is not set")
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("idempotency_test_%d", time.Now().UnixNano())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(admin.Close)
+
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("failed to parse dsn: %v", err)
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	p, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(p.Close)
+
+	query := `
+		CREATE TABLE events (
+			event_id VARCHAR PRIMARY KEY,
+			event_type VARCHAR NOT NULL,
+			auth_id BIGINT,
+			lease_token VARCHAR,
+			lease_expires_at TIMESTAMPTZ,
+			processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			completed_at TIMESTAMPTZ
+		)
+	`
+	if _, err := p.Exec(ctx, query); err != nil {
+		t.Fatalf("failed to create events table: %v", err)
+	}
+
+	return p
+}
+
+func TestNewClaimerRejectsNonPositiveLease(t *testing.T) {
+	for _, lease := range []time.Duration{0, -time.Second} {
+		if _, err := NewClaimer(nil, lease); err == nil {
+			t.Errorf("NewClaimer(%s) returned no error", lease)
+		}
+	}
+}
+
+func TestClaimIsWonOnceUnderConcurrency(t *testing.T) {
+	p := testPool(t)
+	ctx := context.Background()
+
+	const replicas = 8
+
+	claimers := make([]*Claimer, replicas)
+	for i := range claimers {
+		c, err := NewClaimer(p, 30*time.Second)
+		if err != nil {
+			t.Fatalf("NewClaimer: %v", err)
+		}
+		claimers[i] = c
+	}
+
+	var (
+		wg     sync.WaitGroup
+		start  = make(chan struct{})
+		leases = make(chan *Lease, replicas)
+		errs   = make(chan error, replicas)
+	)
+	for _, c := range claimers {
+		wg.Add(1)
+		go func(c *Claimer) {
+			defer wg.Done()
+			<-start
+			_, l, err := c.Claim(ctx, "event-concurrent", "test.event", 1)
+			if err != nil {
+				errs <- err
+				return
+			}
+			leases <- l
+		}(c)
+	}
+	close(start)
+	wg.Wait()
+	close(leases)
+	close(errs)
+
+	var won []*Lease
+	for l := range leases {
+		won = append(won, l)
+	}
+	if len(won) != 1 || won[0] == nil {
+		t.Fatalf("expected exactly one winning lease, got %d", len(won))
+	}
+	for err := range errs {
+		if !errors.Is(err, ce.ErrEventOnProcess) {
+			t.Errorf("expected ErrEventOnProcess for a losing replica, got %v", err)
+		}
+	}
+
+	if err := won[0].Complete(ctx); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+
+	_, l, err := claimers[0].Claim(ctx, "event-concurrent", "test.event", 1)
+	if err != nil || l != nil {
+		t.Fatalf("expected a completed event to return a nil lease, got lease %v and error %v", l, err)
+	}
+}
+
+func TestHeartbeatKeepsLeaseAlive(t *testing.T) {
+	p := testPool(t)
+	ctx := context.Background()
+
+	c, err := NewClaimer(p, time.Second)
+	if err != nil {
+		t.Fatalf("NewClaimer: %v", err)
+	}
+
+	_, l, err := c.Claim(ctx, "event-heartbeat", "test.event", 1)
+	if err != nil || l == nil {
+		t.Fatalf("expected the first claim to win, got lease %v and error %v", l, err)
+	}
+
+	time.Sleep(3 * time.Second)
+
+	if _, _, err := c.Claim(ctx, "event-heartbeat", "test.event", 1); !errors.Is(err, ce.ErrEventOnProcess) {
+		t.Fatalf("expected a renewed lease to block other claims, got %v", err)
+	}
+	if err := l.Complete(ctx); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+}
+
+func TestExpiredLeaseIsTakenOver(t *testing.T) {
+	p := testPool(t)
+	ctx := context.Background()
+
+	c, err := NewClaimer(p, time.Second)
+	if err != nil {
+		t.Fatalf("NewClaimer: %v", err)
+	}
+
+	_, crashed, err := c.Claim(ctx, "event-takeover", "test.event", 1)
+	if err != nil || crashed == nil {
+		t.Fatalf("expected the first claim to win, got lease %v and error %v", crashed, err)
+	}
+
+	// Stopping the heartbeat without settling the lease is what a crashed replica leaves behind
+	crashed.end()
+	time.Sleep(1500 * time.Millisecond)
+
+	_, l, err := c.Claim(ctx, "event-takeover", "test.event", 1)
+	if err != nil || l == nil {
+		t.Fatalf("expected the expired lease to be taken over, got lease %v and error %v", l, err)
+	}
+
+	if err := crashed.Complete(ctx); !errors.Is(err, ce.ErrEventLeaseLost) {
+		t.Fatalf("expected the stale lease to fail with ErrEventLeaseLost, got %v", err)
+	}
+	if err := l.Complete(ctx); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+}
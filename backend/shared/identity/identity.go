@@ -0,0 +1,22 @@
+package identity
+
+import "context"
+
+type ctxKey string
+
+const ctxKeyIdentity ctxKey = "x-identity"
+
+// Identity is the end user a request is made on behalf of, as verified by the gateway
+type Identity struct {
+	AuthID int64  `json:"sub"`
+	Role   string `json:"role"`
+}
+
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, ctxKeyIdentity, id)
+}
+
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(ctxKeyIdentity).(Identity)
+	return id, ok
+}
@@ -0,0 +1,54 @@
+package identity
+
+import (
+	"context"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey carries the signed identity token between services
+const MetadataKey string = "x-identity"
+
+// UnaryClientInterceptor signs the identity of the context, if any, into the outgoing metadata of every call
+func UnaryClientInterceptor(secret string, ttl time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		id, ok := FromContext(ctx)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		token, err := Sign(secret, id, ttl)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryServerInterceptor verifies the identity token of incoming calls and exposes the identity through
+// FromContext. Calls without one pass through anonymous, handlers acting on an account must reject them.
+func UnaryServerInterceptor(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		tokens := metadata.ValueFromIncomingContext(ctx, MetadataKey)
+		if len(tokens) == 0 {
+			return handler(ctx, req)
+		}
+		if len(tokens) > 1 {
+			return nil, status.Error(codes.Unauthenticated, ce.MsgUnauthenticated)
+		}
+
+		id, err := Verify(secret, tokens[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, ce.MsgUnauthenticated)
+		}
+
+		return handler(NewContext(ctx, id), req)
+	}
+}
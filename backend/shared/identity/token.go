@@ -0,0 +1,73 @@
+package identity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+)
+
+type claims struct {
+	Identity
+	ExpiresAt int64 `json:"exp"`
+}
+
+// Sign issues a short-lived token carrying the identity, signed with HMAC-SHA256 over its encoded payload
+func Sign(secret string, id Identity, ttl time.Duration) (string, error) {
+	if secret == "" {
+		return "", fmt.Errorf("failed to sign identity: %w", ce.ErrSecretNotConfigured)
+	}
+
+	payload, err := json.Marshal(claims{Identity: id, ExpiresAt: time.Now().Add(ttl).Unix()})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign identity: %w", err)
+	}
+
+	p := base64.RawURLEncoding.EncodeToString(payload)
+	return p + "." + base64.RawURLEncoding.EncodeToString(mac(secret, p)), nil
+}
+
+func Verify(secret, token string) (Identity, error) {
+	if secret == "" {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrSecretNotConfigured)
+	}
+
+	p, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrInvalidToken)
+	}
+
+	s, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(s, mac(secret, p)) {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrInvalidToken)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(p)
+	if err != nil {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrInvalidToken)
+	}
+
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrInvalidToken)
+	}
+	if c.AuthID <= 0 {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrInvalidToken)
+	}
+	if time.Now().Unix() > c.ExpiresAt {
+		return Identity{}, fmt.Errorf("failed to verify identity: %w", ce.ErrTokenExpired)
+	}
+
+	return c.Identity, nil
+}
+
+func mac(secret, payload string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,19 @@
+package interceptors
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Unary chains the interceptors every gRPC server runs, outermost first. Errors sits outside Logging so access
+// logs keep the internal cause of a ce.Error, Recovery sits inside it so panics are logged as the failed call.
+func Unary(l *zap.Logger) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(
+		RequestID(),
+		Tracing(),
+		Errors(),
+		Logging(l),
+		Recovery(l),
+		Validation(),
+	)
+}
@@ -0,0 +1,47 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors converts what handlers return into a gRPC status, a ce.Error carries its code as error details and
+// anything unrecognized is reported as internal without leaking its message
+func Errors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, toStatus(err).Err()
+		}
+		return resp, nil
+	}
+}
+
+func toStatus(err error) *status.Status {
+	var e *ce.Error
+	if errors.As(err, &e) {
+		return e.GRPCStatus()
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err)
+	}
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	return status.New(codes.Internal, ce.MsgInternalServer)
+}
+
+// serverFault tells apart the codes caused by the service from those caused by the request
+func serverFault(c codes.Code) bool {
+	switch c {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
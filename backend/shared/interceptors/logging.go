@@ -0,0 +1,48 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+// Logging writes one access log per call, failures caused by the service are logged as errors
+func Logging(l *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := toStatus(err).Code()
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.String("code", code.String()),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("request_id", CtxRequestID(ctx)),
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+			fields = append(fields, zap.String("trace_id", sc.TraceID().String()))
+		}
+		if p, ok := peer.FromContext(ctx); ok {
+			fields = append(fields, zap.String("peer", p.Addr.String()))
+		}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		}
+
+		switch {
+		case code == codes.OK:
+			l.Info("[GRPC] request handled", fields...)
+		case serverFault(code):
+			l.Error("[GRPC] request failed", fields...)
+		default:
+			l.Warn("[GRPC] request rejected", fields...)
+		}
+
+		return resp, err
+	}
+}
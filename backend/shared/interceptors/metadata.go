@@ -0,0 +1,89 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type ctxKey string
+
+const ctxKeyRequestID ctxKey = "x-request-id"
+
+// MetadataKeyRequestID carries the ID the gateway assigns to every request
+const MetadataKeyRequestID string = "x-request-id"
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKeyRequestID, id)
+}
+
+func CtxRequestID(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKeyRequestID).(string)
+	return id
+}
+
+// RequestID keeps the request ID of incoming calls in the context, calls without one get a fresh ID
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		id := ""
+		if ids := metadata.ValueFromIncomingContext(ctx, MetadataKeyRequestID); len(ids) > 0 {
+			id = ids[0]
+		}
+		if id == "" {
+			id = newRequestID()
+		}
+
+		return handler(WithRequestID(ctx, id), req)
+	}
+}
+
+// UnaryClientPropagation forwards the trace context and request ID of the context to downstream services
+func UnaryClientPropagation() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+
+		otel.GetTextMapPropagator().Inject(ctx, carrier(md))
+		if id := CtxRequestID(ctx); id != "" && len(md.Get(MetadataKeyRequestID)) == 0 {
+			md.Set(MetadataKeyRequestID, id)
+		}
+
+		return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+	}
+}
+
+// carrier adapts gRPC metadata to the OTel propagators
+type carrier metadata.MD
+
+func (c carrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c carrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c carrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
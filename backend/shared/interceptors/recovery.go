@@ -0,0 +1,27 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Recovery turns a panicking handler into an internal error instead of crashing the process
+func Recovery(l *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				l.Error("[GRPC] recovered from panic", zap.String("method", info.FullMethod), zap.Any("panic", r), zap.Stack("stack"))
+
+				e := fmt.Errorf("failed to handle %s: panic: %v", info.FullMethod, r)
+				resp, err = nil, ce.NewError(trace.SpanFromContext(ctx), ce.CodeInternal, ce.MsgInternalServer, e)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// Validation rejects request messages that fail their registered check before they reach the handler
+func Validation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if check, ok := validators[reflect.TypeOf(req)]; ok {
+			if err := check(req); err != nil {
+				e := fmt.Errorf("failed to validate %s: %w", info.FullMethod, err)
+				ne := ce.NewError(trace.SpanFromContext(ctx), ce.CodeInvalidPayload, ce.MsgInvalidPayload, e)
+
+				var fv ce.FieldViolation
+				if errors.As(err, &fv) {
+					ne.Fields = append(ne.Fields, fv)
+				}
+				return nil, ne
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,70 @@
+package interceptors
+
+import (
+	"reflect"
+
+	apis "github.com/ritchieridanko/pasarly/backend/shared/apis/v1"
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+)
+
+// validators holds the checks the Validation interceptor runs before the handlers, keyed by request message type.
+// They only cover what can be checked on the message alone, and live here so the generated apis package stays
+// regenerable
+var validators = map[reflect.Type]func(req any) error{}
+
+func register[T any](check func(T) error) {
+	validators[reflect.TypeFor[T]()] = func(req any) error { return check(req.(T)) }
+}
+
+func init() {
+	register(func(x *apis.GetPublicProfileRequest) error {
+		return required("user_id", x.GetUserId())
+	})
+	register(func(x *apis.UpdateProfilePictureRequest) error {
+		if len(x.GetImage()) == 0 {
+			return ce.FieldViolation{Field: "image", Description: "is required"}
+		}
+		return nil
+	})
+	register(func(x *apis.UpdateUserAddressRequest) error {
+		return positive("address_id", x.GetAddressId())
+	})
+	register(func(x *apis.SetPrimaryAddressRequest) error {
+		return positive("address_id", x.GetAddressId())
+	})
+	register(func(x *apis.DeleteAddressRequest) error {
+		return positive("address_id", x.GetAddressId())
+	})
+	register(func(x *apis.RestoreAddressRequest) error {
+		return positive("address_id", x.GetAddressId())
+	})
+	register(func(x *apis.GetAddressDistanceRequest) error {
+		if err := positive("from_address_id", x.GetFromAddressId()); err != nil {
+			return err
+		}
+		return positive("to_address_id", x.GetToAddressId())
+	})
+	register(func(x *apis.GetDataExportRequest) error {
+		return required("export_id", x.GetExportId())
+	})
+	register(func(x *apis.MarkNotificationReadRequest) error {
+		return positive("notification_id", x.GetNotificationId())
+	})
+	register(func(x *apis.ArchiveNotificationRequest) error {
+		return positive("notification_id", x.GetNotificationId())
+	})
+}
+
+func positive(field string, v int64) error {
+	if v <= 0 {
+		return ce.FieldViolation{Field: field, Description: "must be positive"}
+	}
+	return nil
+}
+
+func required(field, v string) error {
+	if v == "" {
+		return ce.FieldViolation{Field: field, Description: "is required"}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package mtls
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ritchieridanko/pasarly/backend/shared/ce"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Identity returns the name of the calling service, which is the common name of its verified certificate
+func Identity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	identity := info.State.VerifiedChains[0][0].Subject.CommonName
+	return identity, identity != ""
+}
+
+// AllowInterceptor rejects callers that are not allowed to call the method. The allow-list maps an identity
+// to the methods it may call, either in full ("/auth.v1.AuthService/SignIn"), by service
+// ("/auth.v1.AuthService/*"), or all of them ("*").
+func AllowInterceptor(allow map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		identity, ok := Identity(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, ce.MsgUnauthenticated)
+		}
+
+		if !allowed(allow[identity], info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", identity, info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func allowed(patterns []string, method string) bool {
+	for _, p := range patterns {
+		switch {
+		case p == "*", p == method:
+			return true
+		case strings.HasSuffix(p, "/*") && strings.HasPrefix(method, strings.TrimSuffix(p, "*")):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Reloader holds the key pair and the CA pool in memory and swaps them once the files change on disk,
+// so that rotated certificates are picked up by new handshakes without restarting the service
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	bundle atomic.Pointer[bundle]
+	stop   chan struct{}
+	once   sync.Once
+}
+
+type bundle struct {
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	version string
+}
+
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile, stop: make(chan struct{})}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the files again if any of them changed since the last successful load. A failed load keeps
+// the previous bundle in place, so a rotation caught halfway through is simply retried on the next call.
+func (r *Reloader) Reload() (bool, error) {
+	version, err := r.version()
+	if err != nil {
+		return false, fmt.Errorf("failed to reload certificates: %w", err)
+	}
+	if b := r.bundle.Load(); b != nil && b.version == version {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to reload certificates: %w", err)
+	}
+
+	ca, err := os.ReadFile(r.caFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to reload certificates: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return false, fmt.Errorf("failed to reload certificates: %w", errors.New("no CA certificate found"))
+	}
+
+	r.bundle.Store(&bundle{cert: &cert, pool: pool, version: version})
+	return true, nil
+}
+
+// Watch polls the files every interval until Close is called. The callback is invoked after every reload
+// attempt that found changes, with a nil error when the new bundle is in use.
+func (r *Reloader) Watch(interval time.Duration, callback func(err error)) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-t.C:
+				changed, err := r.Reload()
+				if (changed || err != nil) && callback != nil {
+					callback(err)
+				}
+			}
+		}
+	}()
+}
+
+func (r *Reloader) Close() {
+	r.once.Do(func() { close(r.stop) })
+}
+
+func (r *Reloader) current() *bundle {
+	return r.bundle.Load()
+}
+
+// version fingerprints the files by their size and modification time
+func (r *Reloader) version() (string, error) {
+	var version string
+	for _, f := range []string{r.certFile, r.keyFile, r.caFile} {
+		info, err := os.Stat(f)
+		if err != nil {
+			return "", err
+		}
+		version += fmt.Sprintf("%d:%d;", info.Size(), info.ModTime().UnixNano())
+	}
+	return version, nil
+}